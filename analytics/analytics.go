@@ -0,0 +1,153 @@
+package analytics
+
+import (
+	"cmp"
+	"slices"
+	"strings"
+	"sync"
+
+	hqgourl "go.source.hueristiq.com/url"
+)
+
+// Aggregator consumes parsed URLs and aggregates statistics over them. An Aggregator is
+// safe for concurrent use.
+type Aggregator struct {
+	mu sync.Mutex
+
+	total      int
+	domains    map[string]int
+	schemes    map[string]int
+	depths     map[int]int
+	parameters map[string]int
+}
+
+// Count is the number of occurrences of a value.
+type Count struct {
+	Value string `json:"value"`
+	Count int    `json:"count"`
+}
+
+// Report holds the statistics aggregated over a set of URLs. Counts are sorted by
+// decreasing count, then by value.
+type Report struct {
+	// Total is the number of URLs.
+	Total int `json:"total"`
+	// Domains counts the URLs per registrable domain (e.g., "example.co.uk"), or host for
+	// hosts without a known TLD (e.g., IP addresses).
+	Domains []Count `json:"domains"`
+	// Schemes counts the URLs per (lowercased) scheme.
+	Schemes []Count `json:"schemes"`
+	// PathDepths counts the URLs per number of non-empty path segments.
+	PathDepths map[int]int `json:"path_depths"`
+	// Parameters counts the URLs per query parameter name; a URL repeating a parameter is
+	// counted once.
+	Parameters []Count `json:"parameters"`
+}
+
+// New creates an empty Aggregator.
+//
+// Returns:
+//   - aggregator (*Aggregator): The aggregator.
+func New() (aggregator *Aggregator) {
+	aggregator = &Aggregator{
+		domains:    map[string]int{},
+		schemes:    map[string]int{},
+		depths:     map[int]int{},
+		parameters: map[string]int{},
+	}
+
+	return
+}
+
+// Add adds a URL to the statistics. Nil URLs are ignored.
+//
+// Parameters:
+//   - URL (*hqgourl.URL): The URL, as returned by hqgourl.Parser.Parse.
+func (a *Aggregator) Add(URL *hqgourl.URL) {
+	if URL == nil || URL.URL == nil {
+		return
+	}
+
+	domain := strings.ToLower(URL.Hostname())
+
+	if URL.Domain != nil && URL.Domain.SLD != "" && URL.Domain.TLD != "" {
+		domain = URL.Domain.SLD + "." + URL.Domain.TLD
+	}
+
+	depth := 0
+
+	for _, segment := range strings.Split(URL.Path, "/") {
+		if segment != "" {
+			depth++
+		}
+	}
+
+	query := URL.Query()
+
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	a.total++
+
+	if domain != "" {
+		a.domains[domain]++
+	}
+
+	a.schemes[strings.ToLower(URL.Scheme)]++
+	a.depths[depth]++
+
+	for name := range query {
+		a.parameters[name]++
+	}
+}
+
+// Report returns the statistics aggregated so far.
+//
+// Parameters:
+//   - top (int): The maximum number of domains, schemes and parameters to report, or 0
+//     to report all.
+//
+// Returns:
+//   - report (*Report): The statistics.
+func (a *Aggregator) Report(top int) (report *Report) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	report = &Report{
+		Total:      a.total,
+		Domains:    sortedCounts(a.domains, top),
+		Schemes:    sortedCounts(a.schemes, top),
+		PathDepths: make(map[int]int, len(a.depths)),
+		Parameters: sortedCounts(a.parameters, top),
+	}
+
+	for depth, count := range a.depths {
+		report.PathDepths[depth] = count
+	}
+
+	return
+}
+
+// sortedCounts returns the counts of values, sorted by decreasing count then by value,
+// limited to top if it is positive.
+func sortedCounts(values map[string]int, top int) (counts []Count) {
+	counts = make([]Count, 0, len(values))
+
+	for value, count := range values {
+		counts = append(counts, Count{Value: value, Count: count})
+	}
+
+	slices.SortFunc(counts, func(a, b Count) int {
+		if order := cmp.Compare(b.Count, a.Count); order != 0 {
+			return order
+		}
+
+		return strings.Compare(a.Value, b.Value)
+	})
+
+	if top > 0 && len(counts) > top {
+		counts = counts[:top]
+	}
+
+	return
+}
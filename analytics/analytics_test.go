@@ -0,0 +1,73 @@
+package analytics_test
+
+import (
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	hqgourl "go.source.hueristiq.com/url"
+	"go.source.hueristiq.com/url/analytics"
+)
+
+func TestAggregator_Report(t *testing.T) {
+	t.Parallel()
+
+	parser := hqgourl.NewParser()
+
+	URLs := []string{
+		"https://www.example.com/",
+		"https://api.example.com/v1/users?id=1&page=2",
+		"http://example.com/v1/users?id=2&id=3",
+		"https://blog.example.co.uk/2024/01/post?utm_source=x",
+		"ftp://192.0.2.1/pub",
+	}
+
+	aggregator := analytics.New()
+
+	var wg sync.WaitGroup
+
+	for _, raw := range URLs {
+		parsed, err := parser.Parse(raw)
+
+		require.NoError(t, err)
+
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+
+			aggregator.Add(parsed)
+		}()
+	}
+
+	wg.Wait()
+
+	aggregator.Add(nil)
+
+	report := aggregator.Report(0)
+
+	assert.Equal(t, 5, report.Total)
+	assert.Equal(t, []analytics.Count{
+		{Value: "example.com", Count: 3},
+		{Value: "192.0.2.1", Count: 1},
+		{Value: "example.co.uk", Count: 1},
+	}, report.Domains)
+	assert.Equal(t, []analytics.Count{
+		{Value: "https", Count: 3},
+		{Value: "ftp", Count: 1},
+		{Value: "http", Count: 1},
+	}, report.Schemes)
+	assert.Equal(t, map[int]int{0: 1, 1: 1, 2: 2, 3: 1}, report.PathDepths)
+	assert.Equal(t, []analytics.Count{
+		{Value: "id", Count: 2},
+		{Value: "page", Count: 1},
+		{Value: "utm_source", Count: 1},
+	}, report.Parameters)
+
+	top := aggregator.Report(1)
+
+	assert.Equal(t, []analytics.Count{{Value: "example.com", Count: 3}}, top.Domains)
+	assert.Len(t, top.Schemes, 1)
+	assert.Len(t, top.Parameters, 1)
+}
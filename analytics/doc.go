@@ -0,0 +1,22 @@
+// Package analytics aggregates statistics over sets of parsed URLs, to summarize large
+// result sets (e.g., of reconnaissance) without external tools: the most frequent
+// registrable domains, the distribution of schemes, a histogram of path depths and the
+// most frequent query parameter names.
+//
+// ParameterMiner ranks query parameter names and their most frequent values, and exports
+// them as plain wordlists to seed parameter discovery tools.
+//
+// Example:
+//
+//	aggregator := analytics.New()
+//
+//	for _, URL := range URLs {
+//	    aggregator.Add(URL)
+//	}
+//
+//	report := aggregator.Report(10)
+//
+//	for _, domain := range report.Domains {
+//	    fmt.Println(domain.Value, domain.Count)
+//	}
+package analytics
@@ -0,0 +1,184 @@
+package analytics
+
+import (
+	"bufio"
+	"io"
+	"sync"
+
+	hqgourl "go.source.hueristiq.com/url"
+)
+
+// maxParameterValues is the maximum number of distinct values counted per parameter name,
+// bounding the memory used by parameters taking unbounded values (e.g., IDs or tokens).
+// Values first seen once the limit is reached are ignored.
+const maxParameterValues = 1000
+
+// ParameterMiner consumes parsed URLs and ranks the query parameter names and values they
+// use, to seed parameter discovery tools (e.g., x8 or Param Miner) with the parameters of
+// a target. A ParameterMiner is safe for concurrent use.
+type ParameterMiner struct {
+	mu sync.Mutex
+
+	names  map[string]int
+	values map[string]map[string]int
+}
+
+// Parameter is a query parameter name with its number of occurrences and its most
+// frequent values.
+type Parameter struct {
+	Name  string `json:"name"`
+	Count int    `json:"count"`
+	// Values counts the occurrences of the values of the parameter, excluding empty ones.
+	Values []Count `json:"values"`
+}
+
+// NewParameterMiner creates an empty ParameterMiner.
+//
+// Returns:
+//   - miner (*ParameterMiner): The miner.
+func NewParameterMiner() (miner *ParameterMiner) {
+	miner = &ParameterMiner{
+		names:  map[string]int{},
+		values: map[string]map[string]int{},
+	}
+
+	return
+}
+
+// Add adds the query parameters of a URL to the statistics. Each occurrence of a name is
+// counted, including repeated ones (e.g., "id=1&id=2"). Nil URLs are ignored.
+//
+// Parameters:
+//   - URL (*hqgourl.URL): The URL, as returned by hqgourl.Parser.Parse.
+func (m *ParameterMiner) Add(URL *hqgourl.URL) {
+	if URL == nil || URL.URL == nil {
+		return
+	}
+
+	query := URL.Query()
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	for name, values := range query {
+		if name == "" {
+			continue
+		}
+
+		m.names[name] += len(values)
+
+		counts, ok := m.values[name]
+		if !ok {
+			counts = map[string]int{}
+
+			m.values[name] = counts
+		}
+
+		for _, value := range values {
+			if value == "" {
+				continue
+			}
+
+			if _, ok := counts[value]; ok || len(counts) < maxParameterValues {
+				counts[value]++
+			}
+		}
+	}
+}
+
+// Parameters returns the parameters seen so far, sorted by decreasing count then by name.
+//
+// Parameters:
+//   - top (int): The maximum number of parameters to return, or 0 to return all.
+//   - values (int): The maximum number of values to return per parameter, or 0 to
+//     return all.
+//
+// Returns:
+//   - parameters ([]Parameter): The parameters, with their most frequent values.
+func (m *ParameterMiner) Parameters(top, values int) (parameters []Parameter) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	names := sortedCounts(m.names, top)
+
+	parameters = make([]Parameter, 0, len(names))
+
+	for _, name := range names {
+		parameters = append(parameters, Parameter{
+			Name:   name.Value,
+			Count:  name.Count,
+			Values: sortedCounts(m.values[name.Value], values),
+		})
+	}
+
+	return
+}
+
+// WriteNames writes the parameter names seen so far to w as a plain wordlist, one name per
+// line, by decreasing count.
+//
+// Parameters:
+//   - w (io.Writer): The writer of the wordlist (e.g., a file).
+//   - top (int): The maximum number of names to write, or 0 to write all.
+//
+// Returns:
+//   - err (error): An error if writing fails.
+func (m *ParameterMiner) WriteNames(w io.Writer, top int) (err error) {
+	words := []string{}
+
+	for _, parameter := range m.Parameters(top, 0) {
+		words = append(words, parameter.Name)
+	}
+
+	err = writeWordlist(w, words)
+
+	return
+}
+
+// WriteValues writes the values seen so far to w as a plain wordlist, one value per line,
+// by decreasing count across all parameters, without duplicates.
+//
+// Parameters:
+//   - w (io.Writer): The writer of the wordlist (e.g., a file).
+//   - top (int): The maximum number of values to write, or 0 to write all.
+//
+// Returns:
+//   - err (error): An error if writing fails.
+func (m *ParameterMiner) WriteValues(w io.Writer, top int) (err error) {
+	m.mu.Lock()
+
+	totals := map[string]int{}
+
+	for _, counts := range m.values {
+		for value, count := range counts {
+			totals[value] += count
+		}
+	}
+
+	m.mu.Unlock()
+
+	words := []string{}
+
+	for _, value := range sortedCounts(totals, top) {
+		words = append(words, value.Value)
+	}
+
+	err = writeWordlist(w, words)
+
+	return
+}
+
+// writeWordlist writes words to w, one per line.
+func writeWordlist(w io.Writer, words []string) (err error) {
+	buffered := bufio.NewWriter(w)
+
+	for _, word := range words {
+		if _, err = buffered.WriteString(word + "\n"); err != nil {
+			return
+		}
+	}
+
+	err = buffered.Flush()
+
+	return
+}
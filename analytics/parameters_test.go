@@ -0,0 +1,58 @@
+package analytics_test
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	hqgourl "go.source.hueristiq.com/url"
+	"go.source.hueristiq.com/url/analytics"
+)
+
+func TestParameterMiner(t *testing.T) {
+	t.Parallel()
+
+	parser := hqgourl.NewParser()
+
+	URLs := []string{
+		"https://example.com/search?q=shoes&page=2",
+		"https://example.com/search?q=hats&page=2&sort=",
+		"https://example.com/item?id=1&id=2&debug=true",
+		"https://example.com/item?id=1",
+	}
+
+	miner := analytics.NewParameterMiner()
+
+	for _, raw := range URLs {
+		parsed, err := parser.Parse(raw)
+
+		require.NoError(t, err)
+
+		miner.Add(parsed)
+	}
+
+	miner.Add(nil)
+
+	assert.Equal(t, []analytics.Parameter{
+		{Name: "id", Count: 3, Values: []analytics.Count{{Value: "1", Count: 2}, {Value: "2", Count: 1}}},
+		{Name: "page", Count: 2, Values: []analytics.Count{{Value: "2", Count: 2}}},
+		{Name: "q", Count: 2, Values: []analytics.Count{{Value: "hats", Count: 1}, {Value: "shoes", Count: 1}}},
+		{Name: "debug", Count: 1, Values: []analytics.Count{{Value: "true", Count: 1}}},
+		{Name: "sort", Count: 1, Values: []analytics.Count{}},
+	}, miner.Parameters(0, 0))
+
+	top := miner.Parameters(1, 1)
+
+	assert.Equal(t, []analytics.Parameter{
+		{Name: "id", Count: 3, Values: []analytics.Count{{Value: "1", Count: 2}}},
+	}, top)
+
+	var names, values bytes.Buffer
+
+	require.NoError(t, miner.WriteNames(&names, 3))
+	require.NoError(t, miner.WriteValues(&values, 0))
+
+	assert.Equal(t, "id\npage\nq\n", names.String())
+	assert.Equal(t, "2\n1\nhats\nshoes\ntrue\n", values.String())
+}
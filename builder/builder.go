@@ -0,0 +1,270 @@
+package builder
+
+import (
+	"fmt"
+	"net"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+
+	hqgourl "go.source.hueristiq.com/url"
+	"golang.org/x/net/idna"
+)
+
+// Builder constructs a URL through chained calls. A Builder is not safe for concurrent
+// use; create one per URL.
+type Builder struct {
+	scheme   string
+	userinfo *url.Userinfo
+	host     string // ASCII.
+	domain   *hqgourl.Domain
+	port     string
+	path     string // Escaped.
+	params   []param
+	fragment string
+
+	dp *hqgourl.DomainParser
+
+	err error
+}
+
+// param is a query parameter, kept in insertion order.
+type param struct {
+	key, value string
+}
+
+// Scheme sets the scheme (e.g., "https"). It must be a known scheme.
+func (b *Builder) Scheme(scheme string) *Builder {
+	if err := hqgourl.ValidateScheme(scheme); err != nil {
+		b.fail(err)
+
+		return b
+	}
+
+	b.scheme = strings.ToLower(scheme)
+
+	return b
+}
+
+// User sets the userinfo to a username without password.
+func (b *Builder) User(username string) *Builder {
+	b.userinfo = url.User(username)
+
+	return b
+}
+
+// UserPassword sets the userinfo to a username and password.
+func (b *Builder) UserPassword(username, password string) *Builder {
+	b.userinfo = url.UserPassword(username, password)
+
+	return b
+}
+
+// Host sets the host. Internationalized domain names are converted to punycode and must
+// end with a known TLD; "localhost" and IP addresses are accepted as is.
+func (b *Builder) Host(host string) *Builder {
+	if host == "" {
+		b.fail(fmt.Errorf("%w: host", hqgourl.ErrEmptyInput))
+
+		return b
+	}
+
+	trimmed := strings.TrimSuffix(strings.TrimPrefix(host, "["), "]")
+
+	if ip := net.ParseIP(trimmed); ip != nil {
+		b.host, b.domain = trimmed, nil
+
+		if ip.To4() == nil {
+			b.host = "[" + trimmed + "]"
+		}
+
+		return b
+	}
+
+	ASCII, err := idna.Lookup.ToASCII(host)
+	if err != nil {
+		b.fail(fmt.Errorf("%w: %w", hqgourl.ErrInvalidURL, err))
+
+		return b
+	}
+
+	b.host, b.domain = ASCII, nil
+
+	if ASCII == "localhost" {
+		return b
+	}
+
+	// The known TLDs include internationalized TLDs in their Unicode form.
+	for _, candidate := range []string{ASCII, unicodeHost(ASCII)} {
+		if err = b.dp.Validate(candidate); err == nil {
+			b.domain = b.dp.Parse(candidate)
+
+			return b
+		}
+	}
+
+	b.fail(err)
+
+	return b
+}
+
+// Domain sets the host from a parsed Domain, as Host does.
+func (b *Builder) Domain(domain *hqgourl.Domain) *Builder {
+	return b.Host(domain.String())
+}
+
+// Port sets the port. It must be between 1 and 65535.
+func (b *Builder) Port(port int) *Builder {
+	if port < 1 || port > 65535 {
+		b.fail(fmt.Errorf("%w: port %d out of range", hqgourl.ErrInvalidURL, port))
+
+		return b
+	}
+
+	b.port = strconv.Itoa(port)
+
+	return b
+}
+
+// Path sets the (unescaped) path. A leading "/" is added if missing.
+func (b *Builder) Path(path string) *Builder {
+	if path != "" && !strings.HasPrefix(path, "/") {
+		path = "/" + path
+	}
+
+	segments := strings.Split(path, "/")
+
+	for i, segment := range segments {
+		segments[i] = url.PathEscape(segment)
+	}
+
+	b.path = strings.Join(segments, "/")
+
+	return b
+}
+
+// Segment appends an (unescaped) path segment. Slashes in the segment are escaped, so
+// it always stays a single segment.
+func (b *Builder) Segment(segment string) *Builder {
+	b.path = strings.TrimSuffix(b.path, "/") + "/" + url.PathEscape(segment)
+
+	return b
+}
+
+// Param appends a query parameter. Parameters are encoded in the order they are added,
+// and repeated keys are kept.
+func (b *Builder) Param(key, value string) *Builder {
+	b.params = append(b.params, param{key: key, value: value})
+
+	return b
+}
+
+// Fragment sets the (unescaped) fragment.
+func (b *Builder) Fragment(fragment string) *Builder {
+	b.fragment = fragment
+
+	return b
+}
+
+// Build returns the constructed URL, with its Domain parsed, or the first error
+// encountered while setting its components.
+//
+// Returns:
+//   - URL (*hqgourl.URL): The constructed URL.
+//   - err (error): ErrEmptyInput, ErrUnsupportedScheme, ErrNoTLD or ErrInvalidURL (wrapped).
+func (b *Builder) Build() (URL *hqgourl.URL, err error) {
+	if b.err != nil {
+		err = b.err
+
+		return
+	}
+
+	if b.scheme == "" {
+		err = fmt.Errorf("%w: scheme", hqgourl.ErrEmptyInput)
+
+		return
+	}
+
+	u := &url.URL{
+		Scheme:   b.scheme,
+		User:     b.userinfo,
+		Host:     b.host,
+		Fragment: b.fragment,
+	}
+
+	if b.port != "" {
+		u.Host += ":" + b.port
+	}
+
+	// The path is kept escaped, so escaped slashes from Segment survive.
+	u.Path, _ = url.PathUnescape(b.path)
+	u.RawPath = b.path
+
+	u.RawQuery = b.query()
+
+	URL = &hqgourl.URL{URL: u, Domain: b.domain}
+
+	return
+}
+
+// String builds the URL and returns it as a string, or "" if building fails.
+func (b *Builder) String() (URL string) {
+	parsed, err := b.Build()
+	if err != nil {
+		return
+	}
+
+	URL = parsed.String()
+
+	return
+}
+
+// query encodes the query parameters in insertion order.
+func (b *Builder) query() (query string) {
+	parts := make([]string, 0, len(b.params))
+
+	for _, p := range b.params {
+		parts = append(parts, url.QueryEscape(p.key)+"="+url.QueryEscape(p.value))
+	}
+
+	query = strings.Join(parts, "&")
+
+	return
+}
+
+// unicodeHost returns the Unicode form of an ASCII host, or the host itself if it cannot
+// be converted.
+func unicodeHost(ASCII string) (host string) {
+	host, err := idna.Lookup.ToUnicode(ASCII)
+	if err != nil {
+		host = ASCII
+	}
+
+	return
+}
+
+// fail records err unless an earlier error was already recorded.
+func (b *Builder) fail(err error) {
+	if b.err == nil {
+		b.err = err
+	}
+}
+
+// New creates an empty Builder.
+//
+// Returns:
+//   - builder (*Builder): A pointer to the initialized Builder.
+func New() (builder *Builder) {
+	builder = &Builder{
+		dp: sharedDomainParser(),
+	}
+
+	return
+}
+
+// sharedDomainParser returns the DomainParser shared by all Builders. It is read-only
+// once created, and creating it is comparatively expensive.
+var sharedDomainParser = sync.OnceValue(func() *hqgourl.DomainParser {
+	return hqgourl.NewDomainParser()
+})
@@ -0,0 +1,17 @@
+// Package builder provides a fluent API for constructing URLs component by component.
+// Each component is validated and encoded as it is set: schemes are checked against the
+// known schemes, internationalized hosts are converted to punycode and checked for a
+// known TLD, and paths, query parameters and fragments are percent-encoded as needed.
+// The first error encountered is reported by Build.
+//
+// Example:
+//
+//	URL, err := builder.New().
+//	    Scheme("https").
+//	    Host("例子.公司").
+//	    Path("/api/search").
+//	    Param("q", "go urls").
+//	    Build()
+//
+//	fmt.Println(URL) // https://xn--fsqu00a.xn--55qx5d/api/search?q=go+urls
+package builder
@@ -0,0 +1,396 @@
+// Command hq-url exposes the extraction, parsing, normalization and deduplication of URLs
+// to shell pipelines, on top of the public APIs of the package, reading from the files
+// given as arguments or, if none (or "-"), from the standard input, and writing one result
+// per line, as plain text or, with -json, as JSON.
+//
+// Usage:
+//
+//	hq-url extract [-json] [-scheme] [-host] [-known-schemes] [-known-tlds] [FILE...]
+//	hq-url parse [-json] [-default-scheme SCHEME] [-relative] [FILE...]
+//	hq-url normalize [-json] [FILE...]
+//	hq-url dedupe [-json] [FILE...]
+//
+// For example:
+//
+//	curl -s https://example.com | hq-url extract -scheme | hq-url dedupe | hq-url parse -json
+package main
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"os/signal"
+	"strings"
+
+	hqgourl "go.source.hueristiq.com/url"
+	"go.source.hueristiq.com/url/seen"
+)
+
+// errUsage reports an invalid command line, after its usage was printed.
+var errUsage = errors.New("invalid usage")
+
+// command describes a subcommand.
+type command struct {
+	name        string
+	description string
+
+	// run runs the subcommand with its arguments, reading from stdin and writing results
+	// to stdout and warnings to stderr.
+	run func(ctx context.Context, args []string, stdin io.Reader, stdout, stderr io.Writer) error
+}
+
+// commands lists the subcommands, in the order they are documented.
+var commands = []command{
+	{name: "extract", description: "Extract URLs from text.", run: extract},
+	{name: "parse", description: "Parse URLs, one per line, into their components.", run: parse},
+	{name: "normalize", description: "Print the canonical form of URLs, one per line.", run: normalize},
+	{name: "dedupe", description: "Print URLs, one per line, skipping those equivalent to a previous one.", run: dedupe},
+}
+
+func main() {
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+
+	err := run(ctx, os.Args[1:], os.Stdin, os.Stdout, os.Stderr)
+
+	stop()
+
+	switch {
+	case errors.Is(err, errUsage):
+		os.Exit(2)
+	case err != nil:
+		fmt.Fprintln(os.Stderr, "hq-url:", err)
+
+		os.Exit(1)
+	}
+}
+
+// run runs the subcommand named by the first argument.
+func run(ctx context.Context, args []string, stdin io.Reader, stdout, stderr io.Writer) (err error) {
+	if len(args) == 0 {
+		usage(stderr)
+
+		err = errUsage
+
+		return
+	}
+
+	if args[0] == "-h" || args[0] == "-help" || args[0] == "--help" || args[0] == "help" {
+		usage(stdout)
+
+		return
+	}
+
+	for _, c := range commands {
+		if c.name != args[0] {
+			continue
+		}
+
+		// The usage of the subcommand was printed on request.
+		if err = c.run(ctx, args[1:], stdin, stdout, stderr); errors.Is(err, flag.ErrHelp) {
+			err = nil
+		}
+
+		return
+	}
+
+	fmt.Fprintf(stderr, "hq-url: unknown command %q\n\n", args[0])
+
+	usage(stderr)
+
+	err = errUsage
+
+	return
+}
+
+// usage prints the usage message listing the subcommands.
+func usage(w io.Writer) {
+	h := "USAGE:\n"
+	h += "  hq-url <command> [OPTIONS] [FILE...]\n"
+
+	h += "\nCOMMANDS:\n"
+
+	for _, c := range commands {
+		h += fmt.Sprintf("  %-10s %s\n", c.name, c.description)
+	}
+
+	h += "\nInputs are read from the files given, or from the standard input if none (or \"-\").\n"
+	h += "Run \"hq-url <command> -h\" for the options of a command.\n"
+
+	fmt.Fprint(w, h)
+}
+
+// newFlagSet returns the flag set of the named subcommand, with the -json flag defined.
+func newFlagSet(name string, stderr io.Writer, JSON *bool) (flags *flag.FlagSet) {
+	flags = flag.NewFlagSet("hq-url "+name, flag.ContinueOnError)
+
+	flags.SetOutput(stderr)
+
+	flags.BoolVar(JSON, "json", false, "Write results as newline-delimited JSON.")
+
+	return
+}
+
+// parseFlags parses the arguments of a subcommand, mapping invalid flags to errUsage. It
+// returns flag.ErrHelp if the usage of the subcommand was requested.
+func parseFlags(flags *flag.FlagSet, args []string) (err error) {
+	if err = flags.Parse(args); err != nil && !errors.Is(err, flag.ErrHelp) {
+		err = errUsage
+	}
+
+	return
+}
+
+// extract runs the extract subcommand, writing the value of each match or, with -json,
+// its JSON encoding.
+func extract(ctx context.Context, args []string, stdin io.Reader, stdout, stderr io.Writer) (err error) {
+	var JSON, scheme, host, knownSchemes, knownTLDs bool
+
+	flags := newFlagSet("extract", stderr, &JSON)
+
+	flags.BoolVar(&scheme, "scheme", false, "Only extract URLs with a scheme.")
+	flags.BoolVar(&host, "host", false, "Only extract URLs with a host.")
+	flags.BoolVar(&knownSchemes, "known-schemes", false, "Only extract URLs with a known scheme (implies -scheme).")
+	flags.BoolVar(&knownTLDs, "known-tlds", false, "Only extract URLs whose host has a known TLD (implies -host).")
+
+	if err = parseFlags(flags, args); err != nil {
+		return
+	}
+
+	var opts []hqgourl.ExtractorOptionFunc
+
+	if scheme {
+		opts = append(opts, hqgourl.ExtractorWithScheme())
+	}
+
+	if knownSchemes {
+		opts = append(opts, hqgourl.ExtractorWithKnownSchemes())
+	}
+
+	if host || knownTLDs {
+		opts = append(opts, hqgourl.ExtractorWithHost())
+	}
+
+	if knownTLDs {
+		opts = append(opts, hqgourl.ExtractorWithKnownTLDOnly())
+	}
+
+	extractor, err := hqgourl.NewExtractor(opts...).Compile()
+	if err != nil {
+		return
+	}
+
+	w := bufio.NewWriter(stdout)
+
+	var writeErr error
+
+	err = forEachInput(flags.Args(), stdin, func(r io.Reader) error {
+		return extractor.ExtractReader(ctx, r, func(match hqgourl.Match) {
+			if writeErr != nil {
+				return
+			}
+
+			writeErr = writeResult(w, match.Value, match, JSON)
+		})
+	})
+
+	err = errors.Join(err, writeErr, w.Flush())
+
+	return
+}
+
+// parse runs the parse subcommand, writing the components of each URL tab-separated
+// (scheme, host, port, path, subdomain, SLD and TLD) or, with -json, its JSON encoding.
+// URLs that cannot be parsed are reported on stderr and skipped.
+func parse(_ context.Context, args []string, stdin io.Reader, stdout, stderr io.Writer) (err error) {
+	var JSON, relative bool
+
+	var defaultScheme string
+
+	flags := newFlagSet("parse", stderr, &JSON)
+
+	flags.StringVar(&defaultScheme, "default-scheme", "", "Specify the scheme added to URLs without one.")
+	flags.BoolVar(&relative, "relative", false, "Parse relative URLs (e.g., \"/path\").")
+
+	if err = parseFlags(flags, args); err != nil {
+		return
+	}
+
+	var opts []hqgourl.ParserOptionFunc
+
+	if defaultScheme != "" {
+		opts = append(opts, hqgourl.ParserWithDefaultScheme(defaultScheme))
+	}
+
+	if relative {
+		opts = append(opts, hqgourl.ParserWithRelativeSupport())
+	}
+
+	parser := hqgourl.NewParser(opts...)
+
+	err = forEachLine(flags.Args(), stdin, stdout, func(w *bufio.Writer, line string) (err error) {
+		parsed, err := parser.Parse(line)
+		if err != nil {
+			fmt.Fprintln(stderr, "hq-url: parse:", err)
+
+			err = nil
+
+			return
+		}
+
+		var subdomain, SLD, TLD string
+
+		if parsed.Domain != nil {
+			subdomain, SLD, TLD = parsed.Domain.Subdomain, parsed.Domain.SLD, parsed.Domain.TLD
+		}
+
+		components := []string{parsed.Scheme, parsed.Hostname(), parsed.Port(), parsed.Path, subdomain, SLD, TLD}
+
+		err = writeResult(w, strings.Join(components, "\t"), parsed, JSON)
+
+		return
+	})
+
+	return
+}
+
+// canonicalJSON is the JSON encoding of the results of the normalize and dedupe
+// subcommands.
+type canonicalJSON struct {
+	URL       string `json:"url"`
+	Canonical string `json:"canonical"`
+}
+
+// normalize runs the normalize subcommand, writing the canonical form of each URL (see
+// seen.Canonical).
+func normalize(_ context.Context, args []string, stdin io.Reader, stdout, stderr io.Writer) (err error) {
+	var JSON bool
+
+	flags := newFlagSet("normalize", stderr, &JSON)
+
+	if err = parseFlags(flags, args); err != nil {
+		return
+	}
+
+	err = forEachLine(flags.Args(), stdin, stdout, func(w *bufio.Writer, line string) error {
+		canonical := seen.Canonical(line)
+
+		return writeResult(w, canonical, canonicalJSON{URL: line, Canonical: canonical}, JSON)
+	})
+
+	return
+}
+
+// dedupe runs the dedupe subcommand, writing each URL whose canonical form was not seen
+// before, as given. The canonical forms are kept in memory.
+func dedupe(_ context.Context, args []string, stdin io.Reader, stdout, stderr io.Writer) (err error) {
+	var JSON bool
+
+	flags := newFlagSet("dedupe", stderr, &JSON)
+
+	if err = parseFlags(flags, args); err != nil {
+		return
+	}
+
+	visited := map[string]struct{}{}
+
+	err = forEachLine(flags.Args(), stdin, stdout, func(w *bufio.Writer, line string) (err error) {
+		canonical := seen.Canonical(line)
+
+		if _, ok := visited[canonical]; ok {
+			return
+		}
+
+		visited[canonical] = struct{}{}
+
+		err = writeResult(w, line, canonicalJSON{URL: line, Canonical: canonical}, JSON)
+
+		return
+	})
+
+	return
+}
+
+// writeResult writes the plain result or, with JSON, the JSON encoding of record.
+func writeResult(w io.Writer, plain string, record any, JSON bool) (err error) {
+	if JSON {
+		err = json.NewEncoder(w).Encode(record)
+
+		return
+	}
+
+	_, err = fmt.Fprintln(w, plain)
+
+	return
+}
+
+// forEachLine calls fn for each non-blank line of the inputs, trimmed, with a buffered
+// writer to stdout, flushed once done.
+func forEachLine(paths []string, stdin io.Reader, stdout io.Writer, fn func(w *bufio.Writer, line string) error) (err error) {
+	w := bufio.NewWriter(stdout)
+
+	err = forEachInput(paths, stdin, func(r io.Reader) (err error) {
+		scanner := bufio.NewScanner(r)
+
+		scanner.Buffer(make([]byte, 64*1024), 16*1024*1024)
+
+		for scanner.Scan() {
+			line := strings.TrimSpace(scanner.Text())
+			if line == "" {
+				continue
+			}
+
+			if err = fn(w, line); err != nil {
+				return
+			}
+		}
+
+		err = scanner.Err()
+
+		return
+	})
+
+	err = errors.Join(err, w.Flush())
+
+	return
+}
+
+// forEachInput calls fn with each of the files named by paths, in order, or with stdin if
+// there are none; "-" also names stdin.
+func forEachInput(paths []string, stdin io.Reader, fn func(r io.Reader) error) (err error) {
+	if len(paths) == 0 {
+		paths = []string{"-"}
+	}
+
+	for _, path := range paths {
+		if path == "-" {
+			if err = fn(stdin); err != nil {
+				return
+			}
+
+			continue
+		}
+
+		var file *os.File
+
+		if file, err = os.Open(path); err != nil {
+			return
+		}
+
+		err = fn(file)
+
+		file.Close()
+
+		if err != nil {
+			err = fmt.Errorf("%s: %w", path, err)
+
+			return
+		}
+	}
+
+	return
+}
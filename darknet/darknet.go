@@ -0,0 +1,125 @@
+package darknet
+
+import (
+	"bytes"
+	"encoding/base32"
+	"errors"
+	"fmt"
+	"strings"
+
+	"golang.org/x/crypto/sha3"
+)
+
+var (
+	// ErrInvalidOnion is returned when a host is not a valid Tor v3 onion address.
+	ErrInvalidOnion = errors.New("invalid onion address")
+	// ErrInvalidI2P is returned when a host is not a valid I2P base32 address.
+	ErrInvalidI2P = errors.New("invalid I2P address")
+)
+
+// encoding is the base32 encoding of onion and I2P addresses, without padding.
+var encoding = base32.StdEncoding.WithPadding(base32.NoPadding)
+
+// onionVersion is the version byte of v3 onion addresses.
+const onionVersion = 3
+
+// IsOnion reports whether host is in the ".onion" pseudo-TLD, valid or not.
+func IsOnion(host string) bool {
+	return hasSuffixFold(host, ".onion")
+}
+
+// IsI2P reports whether host is in the ".i2p" pseudo-TLD, valid or not.
+func IsI2P(host string) bool {
+	return hasSuffixFold(host, ".i2p")
+}
+
+// ValidateOnionV3 checks that host is a Tor v3 onion address: 56 base32 characters
+// (optionally preceded by subdomains, e.g. "www.") followed by ".onion", encoding an
+// ed25519 public key, the checksum of the key and the version byte 3.
+//
+// Parameters:
+//   - host (string): The host (e.g., "duckduckgogg42xjoc72x3sjasowoarfbgcmvfimaftt6twagswzczad.onion").
+//
+// Returns:
+//   - err (error): ErrInvalidOnion (wrapped) if host is not a valid v3 onion address.
+func ValidateOnionV3(host string) (err error) {
+	label, ok := addressLabel(host, ".onion")
+	if !ok || len(label) != 56 {
+		err = fmt.Errorf("%w: %q is not 56 base32 characters followed by .onion", ErrInvalidOnion, host)
+
+		return
+	}
+
+	decoded, err := encoding.DecodeString(strings.ToUpper(label))
+	if err != nil {
+		err = fmt.Errorf("%w: %w", ErrInvalidOnion, err)
+
+		return
+	}
+
+	publicKey, checksum, version := decoded[:32], decoded[32:34], decoded[34]
+
+	if version != onionVersion {
+		err = fmt.Errorf("%w: unsupported version %d", ErrInvalidOnion, version)
+
+		return
+	}
+
+	// checksum = SHA3-256(".onion checksum" || public key || version)[:2]
+	digest := sha3.Sum256(append(append([]byte(".onion checksum"), publicKey...), version))
+
+	if !bytes.Equal(checksum, digest[:2]) {
+		err = fmt.Errorf("%w: checksum mismatch", ErrInvalidOnion)
+	}
+
+	return
+}
+
+// ValidateI2P checks that host is an I2P base32 address: 52 base32 characters (a
+// SHA-256 hash), or 56 or more (an encrypted lease set), optionally preceded by
+// subdomains, followed by ".b32.i2p". Human-readable I2P hosts (e.g., "example.i2p"),
+// which depend on an address book, are not accepted.
+//
+// Parameters:
+//   - host (string): The host.
+//
+// Returns:
+//   - err (error): ErrInvalidI2P (wrapped) if host is not a valid I2P base32 address.
+func ValidateI2P(host string) (err error) {
+	label, ok := addressLabel(host, ".b32.i2p")
+	if !ok || (len(label) != 52 && len(label) < 56) {
+		err = fmt.Errorf("%w: %q is not 52 or 56+ base32 characters followed by .b32.i2p", ErrInvalidI2P, host)
+
+		return
+	}
+
+	if _, err = encoding.DecodeString(strings.ToUpper(label)); err != nil {
+		err = fmt.Errorf("%w: %w", ErrInvalidI2P, err)
+	}
+
+	return
+}
+
+// addressLabel returns the label preceding suffix in host, lowercased.
+func addressLabel(host, suffix string) (label string, ok bool) {
+	host = strings.TrimSuffix(host, ".")
+
+	if !hasSuffixFold(host, suffix) {
+		return
+	}
+
+	host = strings.ToLower(host[:len(host)-len(suffix)])
+
+	label = host[strings.LastIndexByte(host, '.')+1:]
+
+	ok = label != ""
+
+	return
+}
+
+// hasSuffixFold reports whether s ends with suffix, ignoring case and a trailing dot.
+func hasSuffixFold(s, suffix string) bool {
+	s = strings.TrimSuffix(s, ".")
+
+	return len(s) > len(suffix) && strings.EqualFold(s[len(s)-len(suffix):], suffix)
+}
@@ -0,0 +1,48 @@
+package darknet_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.source.hueristiq.com/url/darknet"
+)
+
+const onion = "duckduckgogg42xjoc72x3sjasowoarfbgcmvfimaftt6twagswzczad.onion"
+
+func TestValidateOnionV3(t *testing.T) {
+	t.Parallel()
+
+	require.NoError(t, darknet.ValidateOnionV3(onion))
+	require.NoError(t, darknet.ValidateOnionV3("www."+onion))
+	require.NoError(t, darknet.ValidateOnionV3("DUCKDUCKGOGG42XJOC72X3SJASOWOARFBGCMVFIMAFTT6TWAGSWZCZAD.ONION"))
+
+	for _, host := range []string{
+		"3g2upl4pq6kufc4m.onion", // v2
+		"duckduckgogg42xjoc72x3sjasowoarfbgcmvfimaftt6twagswzczae.onion", // Checksum (SHA3-256) mismatch.
+		"duckduckgogg42xjoc72x3sjasowoarfbgcmvfimaftt6twagswzcza1.onion", // Not base32.
+		"example.com",
+		".onion",
+	} {
+		require.ErrorIs(t, darknet.ValidateOnionV3(host), darknet.ErrInvalidOnion, host)
+	}
+}
+
+func TestValidateI2P(t *testing.T) {
+	t.Parallel()
+
+	require.NoError(t, darknet.ValidateI2P("ukeu3k5oycgaauneqgtnvselmt4yemvoilkln7jpvamvfx7dnkdq.b32.i2p"))
+	require.ErrorIs(t, darknet.ValidateI2P("ukeu3k5oycgaauneqgtnvselmt4yemvoilkln7jpvamvfx7dnkd.b32.i2p"), darknet.ErrInvalidI2P)
+	require.ErrorIs(t, darknet.ValidateI2P("ukeu3k5oycgaauneqgtnvselmt4yemvoilkln7jpvamvfx7dnk18.b32.i2p"), darknet.ErrInvalidI2P)
+	require.ErrorIs(t, darknet.ValidateI2P("example.i2p"), darknet.ErrInvalidI2P)
+}
+
+func TestIsOnion(t *testing.T) {
+	t.Parallel()
+
+	assert.True(t, darknet.IsOnion(onion))
+	assert.True(t, darknet.IsOnion("anything.ONION."))
+	assert.False(t, darknet.IsOnion("onion"))
+	assert.True(t, darknet.IsI2P("example.i2p"))
+	assert.False(t, darknet.IsI2P("example.com"))
+}
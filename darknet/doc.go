@@ -0,0 +1,17 @@
+// Package darknet validates the addresses of anonymity networks, whose pseudo-TLDs
+// (".onion" for Tor, ".i2p" for I2P) are matched like any other TLD by the extractors
+// but follow strict formats of their own:
+//
+//   - Tor v3 onion addresses are 56 base32 characters encoding a 32-byte ed25519 public
+//     key, a 2-byte checksum and a version byte (3), e.g.
+//     "duckduckgogg42xjoc72x3sjasowoarfbgcmvfimaftt6twagswzczad.onion". The deprecated v2
+//     addresses (16 characters) are not accepted.
+//   - I2P base32 addresses are 52 base32 characters encoding a SHA-256 hash, or 56 or more
+//     for encrypted lease sets, followed by ".b32.i2p".
+//
+// Example:
+//
+//	if err := darknet.ValidateOnionV3(host); err != nil {
+//	    // Malformed onion address.
+//	}
+package darknet
@@ -1,6 +1,9 @@
 package url
 
-import "strings"
+import (
+	"encoding/json"
+	"strings"
+)
 
 // Domain represents a parsed domain name, broken down into three main components:
 //   - Subdomain: The subdomain part of the domain (e.g., "www" in "www.example.com").
@@ -28,9 +31,9 @@ import "strings"
 //	// Output: "www.example.com"
 //	fmt.Println(domain.String())
 type Domain struct {
-	Subdomain string
-	SLD       string
-	TLD       string
+	Subdomain string `json:"subdomain,omitempty"`
+	SLD       string `json:"sld,omitempty"`
+	TLD       string `json:"tld,omitempty"`
 }
 
 // String reassembles the components of the domain (Subdomain, SLD, and TLD) back into a complete
@@ -64,10 +67,99 @@ func (d *Domain) String() (domain string) {
 	return
 }
 
+// NewDomain creates a Domain from its components. It lets code that names the components
+// differently (e.g., SecondLevelDomain/TopLevelDomain) construct a Domain without depending
+// on its field names.
+//
+// Parameters:
+//   - subdomain (string): The subdomain, e.g. "www".
+//   - SLD (string): The second-level domain, e.g. "example".
+//   - TLD (string): The top-level domain, e.g. "com".
+//
+// Returns:
+//   - domain (*Domain): The domain.
+func NewDomain(subdomain, SLD, TLD string) (domain *Domain) {
+	domain = &Domain{
+		Subdomain: subdomain,
+		SLD:       SLD,
+		TLD:       TLD,
+	}
+
+	return
+}
+
+// SecondLevelDomain returns the second-level domain (SLD), e.g. "example" in "www.example.com".
+// It is an accessor for the SLD field under its long name, for code written against that naming.
+func (d *Domain) SecondLevelDomain() (SLD string) {
+	SLD = d.SLD
+
+	return
+}
+
+// TopLevelDomain returns the top-level domain (TLD), e.g. "com" in "www.example.com".
+// It is an accessor for the TLD field under its long name, for code written against that naming.
+func (d *Domain) TopLevelDomain() (TLD string) {
+	TLD = d.TLD
+
+	return
+}
+
+// UnmarshalJSON decodes a Domain from JSON. Besides the canonical "subdomain", "sld" and
+// "tld" keys, it accepts the long names "second_level_domain" and "top_level_domain" (and
+// their camel case forms), so that documents produced with either naming can be read.
+// Canonical keys take precedence.
+func (d *Domain) UnmarshalJSON(data []byte) (err error) {
+	var fields struct {
+		Subdomain              string `json:"subdomain"`
+		SLD                    string `json:"sld"`
+		TLD                    string `json:"tld"`
+		SecondLevelDomain      string `json:"second_level_domain"`
+		TopLevelDomain         string `json:"top_level_domain"`
+		SecondLevelDomainCamel string `json:"secondLevelDomain"`
+		TopLevelDomainCamel    string `json:"topLevelDomain"`
+	}
+
+	if err = json.Unmarshal(data, &fields); err != nil {
+		return
+	}
+
+	*d = Domain{
+		Subdomain: fields.Subdomain,
+		SLD:       firstNonEmpty(fields.SLD, fields.SecondLevelDomain, fields.SecondLevelDomainCamel),
+		TLD:       firstNonEmpty(fields.TLD, fields.TopLevelDomain, fields.TopLevelDomainCamel),
+	}
+
+	return
+}
+
+// firstNonEmpty returns the first of values that is not empty.
+func firstNonEmpty(values ...string) (value string) {
+	for _, value = range values {
+		if value != "" {
+			return
+		}
+	}
+
+	return
+}
+
 // DomainInterface defines an interface for domain representations.
 type DomainInterface interface {
 	String() (domain string)
 }
 
-// Ensure type compatibility with the DomainInterface.
-var _ DomainInterface = &Domain{}
+// DomainComponentsInterface defines an interface for domain representations that expose
+// their second-level and top-level domains. It is separate from DomainInterface, so that
+// existing implementations of DomainInterface keep satisfying it.
+type DomainComponentsInterface interface {
+	DomainInterface
+
+	SecondLevelDomain() (SLD string)
+	TopLevelDomain() (TLD string)
+}
+
+// Ensure type compatibility with the DomainInterface and DomainComponentsInterface.
+var (
+	_ DomainInterface           = &Domain{}
+	_ DomainComponentsInterface = &Domain{}
+)
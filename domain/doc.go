@@ -0,0 +1,15 @@
+// Package domain scores domain names for signs of algorithmic generation, so that
+// pipelines can flag the domains of domain generation algorithms (DGAs), used by malware
+// to reach its command and control servers, among the domains found during extraction.
+//
+// EntropyScore measures the character entropy of a host; DGAScore combines the likelihood
+// of its labels under a character bigram model of English words (generated from words.txt
+// by gen/bigrams and embedded), their digit ratio and their length into a single score.
+// Both only look at the labels below the public suffix, so that "example.co.uk" is scored
+// on "example".
+//
+// Example:
+//
+//	domain.DGAScore("wikipedia.org")       // ~0.25
+//	domain.DGAScore("xjwqkzvbnpl7r3t.com") // ~1
+package domain
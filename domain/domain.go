@@ -0,0 +1,149 @@
+package domain
+
+import (
+	"math"
+	"strings"
+	"sync"
+
+	hqgourl "go.source.hueristiq.com/url"
+)
+
+// sharedDomainParser is the DomainParser used to strip the public suffix of hosts,
+// created on first use.
+var sharedDomainParser = sync.OnceValue(func() *hqgourl.DomainParser {
+	return hqgourl.NewDomainParser()
+})
+
+// labels returns the lowercased labels of host below its public suffix (e.g., ["www",
+// "example"] for "www.example.co.uk"). Hosts without a known TLD keep all their labels.
+func labels(host string) (labels []string) {
+	host = strings.TrimSuffix(strings.ToLower(host), ".")
+
+	if host == "" {
+		return
+	}
+
+	parsed := sharedDomainParser().Parse(host)
+
+	if parsed.TLD != "" && parsed.SLD != "" {
+		host = strings.TrimSuffix(host, "."+parsed.TLD)
+	}
+
+	labels = strings.Split(host, ".")
+
+	return
+}
+
+// EntropyScore returns the Shannon entropy, in bits per character, of the characters of
+// host below its public suffix (dots excluded). Natural names score around 2.5 to 3.5;
+// random strings over letters and digits approach log2(36) ≈ 5.2 as they get longer.
+//
+// Parameters:
+//   - host (string): The host (e.g., "www.example.com").
+//
+// Returns:
+//   - entropy (float64): The entropy, or 0 for an empty host.
+func EntropyScore(host string) (entropy float64) {
+	counts := map[rune]int{}
+
+	total := 0
+
+	for _, label := range labels(host) {
+		for _, r := range label {
+			counts[r]++
+			total++
+		}
+	}
+
+	for _, count := range counts {
+		p := float64(count) / float64(total)
+
+		entropy -= p * math.Log2(p)
+	}
+
+	return
+}
+
+// DGAScore returns how likely host is to have been generated by a domain generation
+// algorithm, between 0 (natural) and 1 (generated). It scores the longest label below the
+// public suffix (e.g., "example" in "www.example.com"), combining:
+//
+//   - its average negative log-likelihood under the bigram model of English words, which
+//     is high for unpronounceable strings;
+//   - its ratio of digits;
+//   - its length, as short labels (e.g., "xkcd") carry too little signal and are damped.
+//
+// Scores above 0.5 are worth flagging; the heuristic is meant for triage, not as proof.
+//
+// Parameters:
+//   - host (string): The host (e.g., "www.example.com").
+//
+// Returns:
+//   - score (float64): The score, between 0 and 1.
+func DGAScore(host string) (score float64) {
+	label := ""
+
+	for _, candidate := range labels(host) {
+		if len(candidate) > len(label) {
+			label = candidate
+		}
+	}
+
+	if label == "" {
+		return
+	}
+
+	digits := 0
+
+	for _, r := range label {
+		if r >= '0' && r <= '9' {
+			digits++
+		}
+	}
+
+	x := 1.6*(negativeLogLikelihood(label)-5.2) + 4*float64(digits)/float64(len(label))
+
+	score = 1 / (1 + math.Exp(-x))
+
+	// Damp short labels, whose likelihood is noisy.
+	if length := len(label); length < 8 {
+		score *= float64(length) / 8
+	}
+
+	return
+}
+
+// negativeLogLikelihood returns the average negative base 2 log-likelihood per bigram of
+// label under the bigram model, including the bigrams with the start and end markers.
+// Characters outside of the model's alphabet (e.g., in internationalized labels) are
+// scored as the least likely character.
+func negativeLogLikelihood(label string) (nll float64) {
+	label = "^" + label + "$"
+
+	for i := 1; i < len(label); i++ {
+		previous, next := strings.IndexByte(alphabet, label[i-1]), strings.IndexByte(alphabet, label[i])
+
+		if previous < 0 || next < 0 {
+			nll += -minLog2Probability
+
+			continue
+		}
+
+		nll -= float64(bigramLog2Probabilities[previous][next])
+	}
+
+	nll /= float64(len(label) - 1)
+
+	return
+}
+
+// minLog2Probability is the lowest log-probability of the bigram model.
+var minLog2Probability = func() (minimum float64) {
+	for _, row := range bigramLog2Probabilities {
+		for _, value := range row {
+			minimum = math.Min(minimum, float64(value))
+		}
+	}
+
+	return
+}()
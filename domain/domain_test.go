@@ -0,0 +1,32 @@
+package domain_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.source.hueristiq.com/url/domain"
+)
+
+func TestEntropyScore(t *testing.T) {
+	t.Parallel()
+
+	assert.InDelta(t, 0, domain.EntropyScore("aaaa.com"), 1e-9)
+	assert.InDelta(t, 2, domain.EntropyScore("abcd.com"), 1e-9)
+	assert.InDelta(t, 2, domain.EntropyScore("ab.cd.co.uk"), 1e-9)
+	assert.InDelta(t, 0, domain.EntropyScore(""), 1e-9)
+	assert.Greater(t, domain.EntropyScore("xjwqkzvbnpl7r3t.com"), domain.EntropyScore("google.com"))
+}
+
+func TestDGAScore(t *testing.T) {
+	t.Parallel()
+
+	for _, host := range []string{"google.com", "www.facebook.com", "wikipedia.org", "stackoverflow.com", "amazon.co.uk", "linkedin.com", "weather.gov", "xkcd.com"} {
+		assert.Less(t, domain.DGAScore(host), 0.5, host)
+	}
+
+	for _, host := range []string{"xjwqkzvbnpl7r3t.com", "qwpzkxmvbnr.net", "a8f3k2l9x1.info", "bxhjtqnmvzrp.com", "hdjsk29dk3la.top"} {
+		assert.Greater(t, domain.DGAScore(host), 0.5, host)
+	}
+
+	assert.InDelta(t, 0, domain.DGAScore(""), 1e-9)
+}
@@ -2,6 +2,8 @@ package url
 
 import (
 	"regexp"
+	"strings"
+	"unicode"
 	"unicode/utf8"
 
 	"go.source.hueristiq.com/url/tlds"
@@ -13,6 +15,9 @@ import (
 type DomainExtractor struct {
 	RootDomainPattern     string // Custom regex pattern for matching the root domain (e.g., "example").
 	TopLevelDomainPattern string // Custom regex pattern for matching the TLD (e.g., "com").
+	EmojiDomains          bool   // Whether emoji are allowed in the labels of domains (e.g., "i❤.ws").
+	Anchored              bool   // Whether the regex only matches inputs that are entirely a domain.
+	Suffix                string // A domain whose subdomains (and itself) are the only domains matched (e.g., "example.com").
 }
 
 // CompileRegex compiles a regular expression based on the configured DomainExtractor.
@@ -23,8 +28,30 @@ type DomainExtractor struct {
 // Returns:
 //   - regex: The compiled regular expression for matching domain names.
 func (e *DomainExtractor) CompileRegex() (regex *regexp.Regexp) {
+	regex = regexp.MustCompile(e.pattern())
+
+	regex.Longest()
+
+	return
+}
+
+// pattern constructs the regular expression pattern compiled by CompileRegex.
+func (e *DomainExtractor) pattern() (pattern string) {
+	// With a suffix, match any labels followed by the suffix (case-insensitively) only.
+	if e.Suffix != "" {
+		suffix := strings.TrimPrefix(strings.TrimSuffix(e.Suffix, "."), ".")
+
+		pattern = `(?:(?:` + labelPattern(e.EmojiDomains) + `\.)*(?i:` + regexp.QuoteMeta(suffix) + `))`
+
+		if e.Anchored {
+			pattern = `^` + pattern + `$`
+		}
+
+		return
+	}
+
 	// Default root domain pattern or use a user-specified one.
-	RootDomainPattern := _subdomainPattern
+	RootDomainPattern := subdomainPattern(e.EmojiDomains)
 
 	if e.RootDomainPattern != "" {
 		RootDomainPattern = `(?:\w+[.])*` + e.RootDomainPattern + `\.`
@@ -52,16 +79,79 @@ func (e *DomainExtractor) CompileRegex() (regex *regexp.Regexp) {
 	}
 
 	// Combine the root domain and TLD patterns to form the complete domain pattern.
-	pattern := `(?:` + RootDomainPattern + TopLevelDomainPattern + `)`
+	pattern = `(?:` + RootDomainPattern + TopLevelDomainPattern + `)`
 
 	if e.RootDomainPattern == "" && e.TopLevelDomainPattern == "" {
 		pattern = `(?:` + RootDomainPattern + TopLevelDomainPattern + `|localhost)`
 	}
 
-	// Compile the regex and set it to find the longest possible match.
-	regex = regexp.MustCompile(pattern)
+	// Anchor the pattern to match whole inputs only, for validation.
+	if e.Anchored {
+		pattern = `^` + pattern + `$`
+	}
 
-	regex.Longest()
+	return
+}
+
+// Extract finds the domains in text with the compiled regex, keeping only matches that
+// are whole hostnames: a match preceded by a label character, "-" or "." (e.g.,
+// "example.com" in "my-example.com") or followed by one, or by a "." and a label
+// character (e.g., in "example.com.evil.net"), is part of a longer hostname and is
+// dropped. This is the way to search text for the subdomains of a suffix.
+//
+// Parameters:
+//   - text (string): The text to extract domains from.
+//
+// Returns:
+//   - domains ([]string): The domains found in text, in order.
+func (e *DomainExtractor) Extract(text string) (domains []string) {
+	for _, loc := range e.CompileRegex().FindAllStringIndex(text, -1) {
+		if isHostnameBounded(text, loc[0], loc[1]) {
+			domains = append(domains, text[loc[0]:loc[1]])
+		}
+	}
+
+	return
+}
+
+// isHostnameBounded reports whether text[start:end] is not part of a longer hostname.
+func isHostnameBounded(text string, start, end int) bool {
+	if before, _ := utf8.DecodeLastRuneInString(text[:start]); start > 0 && (isLabelRune(before) || before == '.') {
+		return false
+	}
+
+	after, size := utf8.DecodeRuneInString(text[end:])
+
+	if end < len(text) && isLabelRune(after) {
+		return false
+	}
+
+	if after == '.' {
+		if next, _ := utf8.DecodeRuneInString(text[end+size:]); end+size < len(text) && isLabelRune(next) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// isLabelRune reports whether r can be part of a label of a domain.
+func isLabelRune(r rune) bool {
+	return r == '-' || unicode.IsLetter(r) || unicode.IsMark(r) || unicode.IsNumber(r)
+}
+
+// Validate checks that the custom root domain and TLD patterns, if any, are valid regular
+// expressions, so that configuration errors can be reported instead of causing
+// CompileRegex to panic.
+//
+// Returns:
+//   - err (error): ErrInvalidPattern (wrapped) if a custom pattern does not compile, or nil.
+func (e *DomainExtractor) Validate() (err error) {
+	for _, pattern := range []string{e.RootDomainPattern, e.TopLevelDomainPattern} {
+		if err = validatePattern(pattern); err != nil {
+			return
+		}
+	}
 
 	return
 }
@@ -76,8 +166,11 @@ type DomainExtractorInterface interface {
 	CompileRegex() (regex *regexp.Regexp)
 }
 
-// Ensure that DomainExtractor implements the DomainExtractorInterface.
-var _ DomainExtractorInterface = &DomainExtractor{}
+// Ensure that DomainExtractor implements the DomainExtractorInterface and PatternValidator.
+var (
+	_ DomainExtractorInterface = &DomainExtractor{}
+	_ PatternValidator         = &DomainExtractor{}
+)
 
 // NewDomainExtractor creates and initializes a DomainExtractor with optional configurations.
 // By default, it uses pre-defined patterns for extracting root domains and TLDs, but custom
@@ -123,3 +216,44 @@ func DomainExtractorWithTLDPattern(pattern string) DomainExtractorOptionFunc {
 		e.TopLevelDomainPattern = pattern
 	}
 }
+
+// DomainExtractorWithEmojiDomains returns an option function to configure the DomainExtractor
+// to match emoji in the labels of domains (e.g., "i❤.ws").
+//
+// Returns:
+//   - A function that enables emoji domains in the DomainExtractor.
+func DomainExtractorWithEmojiDomains() DomainExtractorOptionFunc {
+	return func(e *DomainExtractor) {
+		e.EmojiDomains = true
+	}
+}
+
+// DomainExtractorWithAnchored returns an option function to configure the DomainExtractor
+// to compile a regex anchored at both ends, which only matches inputs that are entirely a
+// domain (e.g., "www.example.com", but not "http://www.example.com"). It is meant for
+// validation, while the default unanchored regex finds domains within text.
+//
+// Returns:
+//   - A function that enables anchoring in the DomainExtractor.
+func DomainExtractorWithAnchored() DomainExtractorOptionFunc {
+	return func(e *DomainExtractor) {
+		e.Anchored = true
+	}
+}
+
+// DomainExtractorWithSuffix returns an option function to configure the DomainExtractor
+// to only match a domain and its subdomains (e.g., "example.com" and "api.example.com",
+// but not "example.net"), for recon use. Root domain and TLD patterns are ignored. Use
+// Extract to search text for properly bounded matches, or DomainExtractorWithAnchored
+// to validate inputs.
+//
+// Parameters:
+//   - suffix: The domain (e.g., "example.com").
+//
+// Returns:
+//   - A function that applies the suffix to the DomainExtractor.
+func DomainExtractorWithSuffix(suffix string) DomainExtractorOptionFunc {
+	return func(e *DomainExtractor) {
+		e.Suffix = suffix
+	}
+}
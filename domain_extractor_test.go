@@ -47,6 +47,25 @@ func TestDomainExtractor_CompileRegex_Default(t *testing.T) {
 	}
 }
 
+// Test that the trie-derived TLD alternation matches every known TLD. This test is
+// intentionally not parallel: TestDomainExtractor_CompileRegex_TLDSeparation swaps
+// tlds.Official while it runs.
+//
+//nolint:paralleltest // Reads the package-level TLD lists.
+func TestDomainExtractor_CompileRegex_AllKnownTLDs(t *testing.T) {
+	extractor := hqgourl.NewDomainExtractor()
+
+	regex := extractor.CompileRegex()
+
+	require.NotNil(t, regex)
+
+	for _, TLD := range append(append([]string{}, tlds.Official...), tlds.Pseudo...) {
+		domain := "example." + TLD
+
+		assert.Equalf(t, domain, regex.FindString(domain), "failed on TLD: %s", TLD)
+	}
+}
+
 func TestDomainExtractor_CompileRegex_CustomRootDomainPattern(t *testing.T) {
 	t.Parallel()
 
@@ -194,6 +213,18 @@ func TestDomainExtractor_CustomPatterns_Failures(t *testing.T) {
 	}, "Expected panic with invalid regex patterns")
 }
 
+func TestDomainExtractor_Validate(t *testing.T) {
+	t.Parallel()
+
+	require.NoError(t, hqgourl.NewDomainExtractor().Validate())
+
+	extractor := hqgourl.NewDomainExtractor(
+		hqgourl.DomainExtractorWithTLDPattern(`(`),
+	)
+
+	require.ErrorIs(t, extractor.Validate(), hqgourl.ErrInvalidPattern)
+}
+
 func TestDomainExtractor_CustomPatterns_Empty(t *testing.T) {
 	t.Parallel()
 
@@ -223,3 +254,83 @@ func TestDomainExtractor_CustomPatterns_Empty(t *testing.T) {
 		assert.Equalf(t, tt.expected, regex.MatchString(tt.input), "failed on input: %s", tt.input)
 	}
 }
+
+func TestDomainExtractor_CompileRegex_EmojiDomains(t *testing.T) {
+	t.Parallel()
+
+	assert.False(t, hqgourl.NewDomainExtractor().CompileRegex().MatchString("☃.net"))
+
+	regex := hqgourl.NewDomainExtractor(hqgourl.DomainExtractorWithEmojiDomains()).CompileRegex()
+
+	assert.Equal(t, "i❤.ws", regex.FindString("visit i❤.ws now"))
+	assert.Equal(t, "☃.net", regex.FindString("☃.net"))
+	assert.Equal(t, "🏳️\u200d🌈.ws", regex.FindString("🏳️\u200d🌈.ws"))
+}
+
+func TestDomainExtractor_CompileRegex_Anchored(t *testing.T) {
+	t.Parallel()
+
+	regex := hqgourl.NewDomainExtractor(hqgourl.DomainExtractorWithAnchored()).CompileRegex()
+
+	tests := []struct {
+		input    string
+		expected bool
+	}{
+		{"example.com", true},
+		{"www.example.co.uk", true},
+		{"localhost", true},
+		{"http://www.example.com", false},
+		{"www.example.com/path", false},
+		{" example.com", false},
+		{"visit example.com", false},
+		{"example.invalidtld", false},
+	}
+
+	for _, tt := range tests {
+		assert.Equalf(t, tt.expected, regex.MatchString(tt.input), "input: %s", tt.input)
+	}
+
+	regex = hqgourl.NewDomainExtractor(
+		hqgourl.DomainExtractorWithAnchored(),
+		hqgourl.DomainExtractorWithTLDPattern(`(?:com|net)`),
+	).CompileRegex()
+
+	assert.True(t, regex.MatchString("example.net"))
+	assert.False(t, regex.MatchString("example.org"))
+	assert.False(t, regex.MatchString("http://example.net"))
+}
+
+func TestDomainExtractor_Extract_Suffix(t *testing.T) {
+	t.Parallel()
+
+	extractor := hqgourl.NewDomainExtractor(hqgourl.DomainExtractorWithSuffix("example.com"))
+
+	text := `Found api.example.com, https://WWW.Example.COM/login, example.com. and dev-1.eu.example.com:8443; ` +
+		`but not my-example.com, example.community, example.com.evil.net, notexample.com or example.net. ` +
+		`Also a.example.com.b.example.com.`
+
+	assert.Equal(t, []string{
+		"api.example.com",
+		"WWW.Example.COM",
+		"example.com",
+		"dev-1.eu.example.com",
+		"a.example.com.b.example.com",
+	}, extractor.Extract(text))
+
+	anchored := hqgourl.NewDomainExtractor(
+		hqgourl.DomainExtractorWithSuffix(".example.com"),
+		hqgourl.DomainExtractorWithAnchored(),
+	).CompileRegex()
+
+	assert.True(t, anchored.MatchString("api.example.com"))
+	assert.True(t, anchored.MatchString("example.com"))
+	assert.False(t, anchored.MatchString("api.example.com.evil.net"))
+	assert.False(t, anchored.MatchString("exampleXcom"))
+}
+
+func TestDomainExtractor_Extract(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, []string{"example.com", "www.example.co.uk"},
+		hqgourl.NewDomainExtractor().Extract("see example.com and https://www.example.co.uk/x"))
+}
@@ -1,8 +1,10 @@
 package url
 
 import (
+	"fmt"
 	"index/suffixarray"
 	"strings"
+	"sync"
 
 	"go.source.hueristiq.com/url/tlds"
 )
@@ -19,6 +21,12 @@ import (
 //   - sa (*suffixarray.Index):
 //   - The suffix array index used for efficiently searching through known TLDs.
 //   - This allows for rapid identification of the TLD in the domain string.
+//   - It is built on first use, from customTLDs (or the known TLDs, if nil), unless provided.
+//   - customTLDs ([]string):
+//   - The TLDs set with DomainParserWithTLDs, indexed on first use.
+//   - private (map[string]struct{}):
+//   - The set of the private suffixes registered with AddPrivateSuffix (lowercase), which
+//     take precedence over the known TLDs.
 //
 // Example Usage:
 //
@@ -29,7 +37,12 @@ import (
 //	fmt.Println(parsedDomain.SLD)        // Output: "example"
 //	fmt.Println(parsedDomain.TLD)        // Output: "com"
 type DomainParser struct {
-	sa *suffixarray.Index
+	sa         *suffixarray.Index
+	saOnce     sync.Once
+	customTLDs []string
+
+	private   map[string]struct{}
+	privateMu sync.RWMutex
 }
 
 // Parse takes a full domain string (e.g., "www.example.com") and splits it into three main components:
@@ -67,11 +80,95 @@ func (p *DomainParser) Parse(domain string) (parsed *Domain) {
 	return
 }
 
+// Validate checks that domain is not empty and ends with a known TLD, i.e. that Parse
+// would split it into a non-empty SLD and TLD.
+//
+// Parameters:
+//   - domain (string): The domain to validate.
+//
+// Returns:
+//   - err (error): ErrEmptyInput or ErrNoTLD (wrapped), or nil if the domain is valid.
+func (p *DomainParser) Validate(domain string) (err error) {
+	if domain == "" {
+		err = fmt.Errorf("%w: domain", ErrEmptyInput)
+
+		return
+	}
+
+	parsed := p.Parse(domain)
+
+	if parsed.TLD == "" {
+		err = fmt.Errorf("%w: %q", ErrNoTLD, domain)
+	}
+
+	return
+}
+
+// AddPrivateSuffix registers private suffixes at runtime, i.e. multi-label suffixes of
+// private DNS namespaces (e.g., "corp.mycompany" for "internal.corp.mycompany") that are
+// unknown to the public suffix list. They take precedence over the known TLDs: a domain
+// ending with a private suffix is split with it as TLD, the longest one if several match,
+// so that "a.internal.corp.mycompany" has the subdomain "a", the SLD "internal" and the
+// TLD "corp.mycompany". Suffixes are lowercased and stripped of leading and trailing dots;
+// empty ones are ignored. It is safe for concurrent use with Parse.
+//
+// Parameters:
+//   - suffixes (variadic string): The private suffixes to register (e.g., "corp.mycompany").
+func (p *DomainParser) AddPrivateSuffix(suffixes ...string) {
+	p.privateMu.Lock()
+
+	defer p.privateMu.Unlock()
+
+	for _, suffix := range suffixes {
+		suffix = strings.ToLower(strings.Trim(suffix, "."))
+
+		if suffix == "" {
+			continue
+		}
+
+		if p.private == nil {
+			p.private = map[string]struct{}{}
+		}
+
+		p.private[suffix] = struct{}{}
+	}
+}
+
+// findPrivateSuffixOffset searches the domain parts for the longest private suffix
+// registered with AddPrivateSuffix.
+//
+// Parameters:
+//   - parts ([]string): A slice of domain components split by '.' (e.g., ["www", "example", "com"]).
+//
+// Returns:
+//   - offset (int): The index of the root domain (SLD), or -1 if the domain is a private suffix.
+//   - found (bool): Whether the domain ends with a private suffix.
+func (p *DomainParser) findPrivateSuffixOffset(parts []string) (offset int, found bool) {
+	p.privateMu.RLock()
+
+	defer p.privateMu.RUnlock()
+
+	if len(p.private) == 0 {
+		return
+	}
+
+	for i := range parts {
+		if _, found = p.private[strings.ToLower(strings.Join(parts[i:], "."))]; found {
+			offset = i - 1
+
+			return
+		}
+	}
+
+	return
+}
+
 // findTLDOffset searches the domain parts to find the position where the TLD starts.
 // It works backward through the domain parts, from right (TLD) to left (subdomain),
 // to handle complex cases where subdomains might appear similar to TLDs.
 //
-// This method uses the suffix array to efficiently identify known TLDs.
+// This method uses the suffix array to efficiently identify known TLDs, after looking for
+// the private suffixes, which take precedence.
 //
 // Parameters:
 //   - parts ([]string): A slice of domain components split by '.' (e.g., ["www", "example", "com"]).
@@ -79,6 +176,10 @@ func (p *DomainParser) Parse(domain string) (parsed *Domain) {
 // Returns:
 //   - offset (int): The index of the root domain (SLD) or -1 if no valid TLD is found.
 func (p *DomainParser) findTLDOffset(parts []string) (offset int) {
+	if offset, found := p.findPrivateSuffixOffset(parts); found {
+		return offset
+	}
+
 	offset = -1
 
 	partsLength := len(parts)
@@ -87,7 +188,7 @@ func (p *DomainParser) findTLDOffset(parts []string) (offset int) {
 	for i := partsLastIndex; i >= 0; i-- {
 		TLD := strings.Join(parts[i:], ".")
 
-		indices := p.sa.Lookup([]byte(TLD), -1)
+		indices := p.index().Lookup([]byte(TLD), -1)
 
 		if len(indices) > 0 {
 			offset = i - 1
@@ -102,10 +203,18 @@ func (p *DomainParser) findTLDOffset(parts []string) (offset int) {
 // DomainParserInterface defines the interface for domain parsing functionality.
 type DomainParserInterface interface {
 	Parse(domain string) (parsed *Domain)
+	AddPrivateSuffix(suffixes ...string)
 
 	findTLDOffset(parts []string) (offset int)
 }
 
+// DomainValidator defines the interface of domain validation, reporting why a domain is
+// invalid with the package's sentinel errors. It is separate from DomainParserInterface so
+// that the latter is not widened.
+type DomainValidator interface {
+	Validate(domain string) (err error)
+}
+
 // DomainParserOptionFunc defines a function type for configuring a DomainParser instance.
 // This allows customization options like specifying custom TLDs.
 //
@@ -114,12 +223,16 @@ type DomainParserInterface interface {
 //	parser := NewDomainParser(DomainParserWithTLDs("custom", "tld"))
 type DomainParserOptionFunc func(*DomainParser)
 
-// Ensure type compatibility with the DomainParserInterface.
-var _ DomainParserInterface = &DomainParser{}
+// Ensure type compatibility with the DomainParserInterface and DomainValidator.
+var (
+	_ DomainParserInterface = &DomainParser{}
+	_ DomainValidator       = &DomainParser{}
+)
 
 // NewDomainParser creates a new DomainParser instance and initializes it with a comprehensive list
 // of TLDs, including both standard TLDs and pseudo-TLDs. Additional options can be passed to customize
-// the parser, such as using a custom set of TLDs.
+// the parser, such as using a custom set of TLDs. The suffix array index of the TLDs is built
+// on the first lookup, so creating a parser that never looks a domain up is cheap.
 //
 // Parameters:
 //   - opts (variadic DomainParserOptionFunc): Optional configuration options.
@@ -129,13 +242,6 @@ var _ DomainParserInterface = &DomainParser{}
 func NewDomainParser(opts ...DomainParserOptionFunc) (parser *DomainParser) {
 	parser = &DomainParser{}
 
-	TLDs := []string{}
-
-	TLDs = append(TLDs, tlds.Official...)
-	TLDs = append(TLDs, tlds.Pseudo...)
-
-	parser.sa = suffixarray.New([]byte("\x00" + strings.Join(TLDs, "\x00") + "\x00"))
-
 	for _, opt := range opts {
 		opt(parser)
 	}
@@ -143,6 +249,41 @@ func NewDomainParser(opts ...DomainParserOptionFunc) (parser *DomainParser) {
 	return
 }
 
+// index returns the suffix array index of the TLDs, building it on first use: it is
+// comparatively expensive to build, and not needed until a domain is looked up.
+func (p *DomainParser) index() *suffixarray.Index {
+	p.saOnce.Do(func() {
+		if p.sa != nil {
+			return
+		}
+
+		TLDs := p.customTLDs
+
+		if TLDs == nil {
+			TLDs = append(TLDs, tlds.Official...)
+			TLDs = append(TLDs, tlds.Pseudo...)
+		}
+
+		p.sa = suffixarray.New([]byte("\x00" + strings.Join(TLDs, "\x00") + "\x00"))
+	})
+
+	return p.sa
+}
+
+// DomainParserWithPrivateSuffixes registers private suffixes when the DomainParser is
+// initialized, as AddPrivateSuffix does at runtime.
+//
+// Parameters:
+//   - suffixes ([]string): A slice of private suffixes (e.g., "corp.mycompany").
+//
+// Returns:
+//   - A DomainParserOptionFunc that registers the private suffixes with the parser.
+func DomainParserWithPrivateSuffixes(suffixes ...string) DomainParserOptionFunc {
+	return func(p *DomainParser) {
+		p.AddPrivateSuffix(suffixes...)
+	}
+}
+
 // DomainParserWithTLDs allows the DomainParser to be initialized with a custom set of TLDs.
 // This option is useful for handling non-standard or niche TLDs that may not be included
 // in the default set.
@@ -154,6 +295,33 @@ func NewDomainParser(opts ...DomainParserOptionFunc) (parser *DomainParser) {
 //   - A DomainParserOptionFunc that applies the custom TLDs to the parser.
 func DomainParserWithTLDs(TLDs ...string) DomainParserOptionFunc {
 	return func(p *DomainParser) {
-		p.sa = suffixarray.New([]byte("\x00" + strings.Join(TLDs, "\x00") + "\x00"))
+		p.sa, p.customTLDs = nil, append([]string{}, TLDs...)
+	}
+}
+
+// DomainParserWithTLDIndex initializes the DomainParser with a prebuilt suffix array index
+// of TLDs, as restored by tlds.IndexFromBinary from the binary encoding of a tlds.TLDSet,
+// instead of building one. Services with many worker processes can so share one index,
+// built once.
+//
+// Example:
+//
+//	data, _ := os.ReadFile("tlds.bin") // Written from tlds.TLDSet.MarshalBinary.
+//
+//	index, err := tlds.IndexFromBinary(data)
+//	if err != nil {
+//	    log.Fatal(err)
+//	}
+//
+//	parser := NewDomainParser(DomainParserWithTLDIndex(index))
+//
+// Parameters:
+//   - index (*suffixarray.Index): The index of the TLDs, separated and surrounded by NUL bytes.
+//
+// Returns:
+//   - A DomainParserOptionFunc that applies the index to the parser.
+func DomainParserWithTLDIndex(index *suffixarray.Index) DomainParserOptionFunc {
+	return func(p *DomainParser) {
+		p.sa, p.customTLDs = index, nil
 	}
 }
@@ -4,7 +4,9 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	hqgourl "go.source.hueristiq.com/url"
+	"go.source.hueristiq.com/url/tlds"
 )
 
 // Test parsing of a valid domain with subdomain, SLD, and TLD.
@@ -103,6 +105,41 @@ func TestDomainParserWithCustomTLDs(t *testing.T) {
 	assert.Equal(t, "custom", parsed.TLD) // Recognizes custom TLD.
 }
 
+// Test parsing with a prebuilt TLD index.
+func TestDomainParserWithTLDIndex(t *testing.T) {
+	t.Parallel()
+
+	data, err := tlds.NewTLDSet("custom", "co.custom").MarshalBinary()
+
+	require.NoError(t, err)
+
+	index, err := tlds.IndexFromBinary(data)
+
+	require.NoError(t, err)
+
+	parser := hqgourl.NewDomainParser(hqgourl.DomainParserWithTLDIndex(index))
+
+	parsed := parser.Parse("www.example.co.custom")
+
+	assert.Equal(t, "www", parsed.Subdomain)
+	assert.Equal(t, "example", parsed.SLD)
+	assert.Equal(t, "co.custom", parsed.TLD)
+
+	require.ErrorIs(t, parser.Validate("example.com"), hqgourl.ErrNoTLD)
+}
+
+// Test validating domains.
+func TestDomainParser_Validate(t *testing.T) {
+	t.Parallel()
+
+	parser := hqgourl.NewDomainParser()
+
+	require.NoError(t, parser.Validate("www.example.com"))
+	require.ErrorIs(t, parser.Validate(""), hqgourl.ErrEmptyInput)
+	require.ErrorIs(t, parser.Validate("example.invalidtld"), hqgourl.ErrNoTLD)
+	require.ErrorIs(t, parser.Validate("localhost"), hqgourl.ErrNoTLD)
+}
+
 // Test parsing an empty domain string.
 func TestDomainParser_Parse_EmptyString(t *testing.T) {
 	t.Parallel()
@@ -118,3 +155,60 @@ func TestDomainParser_Parse_EmptyString(t *testing.T) {
 	assert.Equal(t, "", parsed.SLD) // No SLD for an empty domain.
 	assert.Equal(t, "", parsed.TLD)
 }
+
+// Test parsing with private suffixes registered at runtime.
+func TestDomainParser_AddPrivateSuffix(t *testing.T) {
+	t.Parallel()
+
+	parser := hqgourl.NewDomainParser()
+
+	parsed := parser.Parse("a.internal.corp.mycompany")
+
+	assert.Equal(t, "", parsed.TLD)
+
+	parser.AddPrivateSuffix(".Corp.MyCompany.", "")
+
+	tests := []struct {
+		domain    string
+		subdomain string
+		SLD       string
+		TLD       string
+	}{
+		{"internal.corp.mycompany", "", "internal", "corp.mycompany"},
+		{"a.internal.corp.mycompany", "a", "internal", "corp.mycompany"},
+		{"A.Internal.CORP.mycompany", "A", "Internal", "CORP.mycompany"},
+		{"corp.mycompany", "", "corp.mycompany", ""},
+		{"www.example.com", "www", "example", "com"},
+	}
+
+	for _, tt := range tests {
+		parsed := parser.Parse(tt.domain)
+
+		assert.Equal(t, tt.subdomain, parsed.Subdomain, tt.domain)
+		assert.Equal(t, tt.SLD, parsed.SLD, tt.domain)
+		assert.Equal(t, tt.TLD, parsed.TLD, tt.domain)
+	}
+
+	require.NoError(t, parser.Validate("internal.corp.mycompany"))
+
+	// Private suffixes take precedence over the known TLDs.
+	parser = hqgourl.NewDomainParser(hqgourl.DomainParserWithPrivateSuffixes("example.com", "dev.example.com"))
+
+	parsed = parser.Parse("api.staging.dev.example.com")
+
+	assert.Equal(t, "api", parsed.Subdomain)
+	assert.Equal(t, "staging", parsed.SLD)
+	assert.Equal(t, "dev.example.com", parsed.TLD)
+}
+
+func BenchmarkNewDomainParser(b *testing.B) {
+	for range b.N {
+		hqgourl.NewDomainParser()
+	}
+}
+
+func BenchmarkNewDomainParser_FirstParse(b *testing.B) {
+	for range b.N {
+		hqgourl.NewDomainParser().Parse("www.example.co.uk")
+	}
+}
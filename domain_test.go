@@ -0,0 +1,68 @@
+package url_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	hqgourl "go.source.hueristiq.com/url"
+)
+
+func TestDomain_Accessors(t *testing.T) {
+	t.Parallel()
+
+	domain := hqgourl.NewDomain("www", "example", "com")
+
+	assert.Equal(t, "www.example.com", domain.String())
+	assert.Equal(t, "example", domain.SecondLevelDomain())
+	assert.Equal(t, "com", domain.TopLevelDomain())
+	assert.Equal(t, domain.SLD, domain.SecondLevelDomain())
+	assert.Equal(t, domain.TLD, domain.TopLevelDomain())
+}
+
+func TestDomain_Interfaces(t *testing.T) {
+	t.Parallel()
+
+	var domain hqgourl.DomainInterface = hqgourl.NewDomain("www", "example", "com")
+
+	components, ok := domain.(hqgourl.DomainComponentsInterface)
+
+	require.True(t, ok)
+	assert.Equal(t, "example", components.SecondLevelDomain())
+	assert.Equal(t, "com", components.TopLevelDomain())
+}
+
+func TestDomain_JSON(t *testing.T) {
+	t.Parallel()
+
+	domain := hqgourl.NewDomain("www", "example", "co.uk")
+
+	data, err := json.Marshal(domain)
+
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"subdomain":"www","sld":"example","tld":"co.uk"}`, string(data))
+
+	tests := []struct {
+		name     string
+		input    string
+		expected *hqgourl.Domain
+	}{
+		{"Canonical", `{"subdomain":"www","sld":"example","tld":"co.uk"}`, domain},
+		{"Long Names", `{"subdomain":"www","second_level_domain":"example","top_level_domain":"co.uk"}`, domain},
+		{"Camel Case Long Names", `{"subdomain":"www","secondLevelDomain":"example","topLevelDomain":"co.uk"}`, domain},
+		{"Previous Field Names", `{"Subdomain":"www","SLD":"example","TLD":"co.uk"}`, domain},
+		{"Canonical Takes Precedence", `{"sld":"example","second_level_domain":"other","tld":"com"}`, hqgourl.NewDomain("", "example", "com")},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			decoded := &hqgourl.Domain{}
+
+			require.NoError(t, json.Unmarshal([]byte(tt.input), decoded))
+			assert.Equal(t, tt.expected, decoded)
+		})
+	}
+}
@@ -0,0 +1,78 @@
+package url
+
+import (
+	"fmt"
+	"strings"
+
+	"golang.org/x/net/idna"
+)
+
+// EmailToASCII converts an internationalized email address (EAI, RFC 6531), such as
+// "用户@例子.公司", into its ASCII-compatible form by converting the domain part to
+// punycode (e.g., "用户@xn--fsqu00a.xn--55qx5d").
+//
+// The local part is returned unchanged: RFC 6531 allows UTF-8 local parts and defines
+// no ASCII-compatible encoding for them. Use EmailHasASCIILocalPart to check whether
+// the result can be delivered over a non-SMTPUTF8 transport.
+//
+// Parameters:
+//   - email (string): The email address to convert.
+//
+// Returns:
+//   - converted (string): The email address with an ASCII-compatible domain part.
+//   - err (error): ErrEmptyInput, or ErrInvalidEmail (wrapped) if the address has no "@" or
+//     the domain cannot be converted.
+func EmailToASCII(email string) (converted string, err error) {
+	if email == "" {
+		err = fmt.Errorf("%w: email address", ErrEmptyInput)
+
+		return
+	}
+
+	i := strings.LastIndexByte(email, '@')
+
+	if i <= 0 || i == len(email)-1 {
+		err = fmt.Errorf("%w: %q", ErrInvalidEmail, email)
+
+		return
+	}
+
+	local, domain := email[:i], email[i+1:]
+
+	domain, err = idna.Lookup.ToASCII(domain)
+	if err != nil {
+		err = fmt.Errorf("%w: %w", ErrInvalidEmail, err)
+
+		return
+	}
+
+	converted = local + "@" + domain
+
+	return
+}
+
+// EmailHasASCIILocalPart reports whether the local part of the given email address
+// (the part before the last "@") consists only of ASCII characters.
+//
+// Parameters:
+//   - email (string): The email address to check.
+//
+// Returns:
+//   - ok (bool): True if the local part is pure ASCII.
+func EmailHasASCIILocalPart(email string) (ok bool) {
+	local := email
+
+	if i := strings.LastIndexByte(email, '@'); i >= 0 {
+		local = email[:i]
+	}
+
+	for i := range len(local) {
+		if local[i] >= 0x80 {
+			return
+		}
+	}
+
+	ok = true
+
+	return
+}
@@ -0,0 +1,51 @@
+package url_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	hqgourl "go.source.hueristiq.com/url"
+)
+
+func TestEmailToASCII(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		input    string
+		expected string
+	}{
+		{"user@example.com", "user@example.com"},
+		{"用户@例子.公司", "用户@xn--fsqu00a.xn--55qx5d"},
+		{"ü.ser@bücher.de", "ü.ser@xn--bcher-kva.de"},
+	}
+
+	for _, tt := range tests {
+		converted, err := hqgourl.EmailToASCII(tt.input)
+
+		require.NoErrorf(t, err, "failed on input: %s", tt.input)
+
+		assert.Equalf(t, tt.expected, converted, "failed on input: %s", tt.input)
+	}
+}
+
+func TestEmailToASCII_Invalid(t *testing.T) {
+	t.Parallel()
+
+	_, err := hqgourl.EmailToASCII("")
+
+	require.ErrorIs(t, err, hqgourl.ErrEmptyInput)
+
+	for _, input := range []string{"user", "@example.com", "user@"} {
+		_, err = hqgourl.EmailToASCII(input)
+
+		require.ErrorIsf(t, err, hqgourl.ErrInvalidEmail, "failed on input: %s", input)
+	}
+}
+
+func TestEmailHasASCIILocalPart(t *testing.T) {
+	t.Parallel()
+
+	assert.True(t, hqgourl.EmailHasASCIILocalPart("user@例子.公司"))
+	assert.False(t, hqgourl.EmailHasASCIILocalPart("用户@example.com"))
+}
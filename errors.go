@@ -0,0 +1,34 @@
+package url
+
+import "errors"
+
+// Sentinel errors returned (wrapped) by the parsers, extractors and validators of this
+// package. Callers can branch on them with errors.Is instead of matching error strings:
+//
+//	parsed, err := parser.Parse(raw)
+//	if errors.Is(err, hqgourl.ErrInvalidURL) {
+//	    // ...
+//	}
+var (
+	// ErrEmptyInput is returned when an empty string is passed where a URL, domain,
+	// scheme or email address is expected.
+	ErrEmptyInput = errors.New("empty input")
+	// ErrInvalidURL is returned when a URL cannot be parsed.
+	ErrInvalidURL = errors.New("error parsing URL")
+	// ErrInvalidEmail is returned when an email address is malformed.
+	ErrInvalidEmail = errors.New("invalid email address")
+	// ErrUnsupportedScheme is returned when a scheme is not a known (official, unofficial
+	// or no-authority) scheme.
+	ErrUnsupportedScheme = errors.New("unsupported scheme")
+	// ErrNoTLD is returned when a domain does not end with a known TLD.
+	ErrNoTLD = errors.New("no known TLD")
+	// ErrInvalidPattern is returned when a user-supplied regular expression pattern
+	// does not compile.
+	ErrInvalidPattern = errors.New("invalid pattern")
+	// ErrExtractorCompiled is returned when options are applied to an Extractor whose
+	// regex (or scanner) has already been compiled by Extract.
+	ErrExtractorCompiled = errors.New("extractor already compiled")
+	// ErrUnsafeURL is returned by Sanitize when a URL contains control characters, embedded
+	// newlines or overlong components its SanitizePolicy doesn't allow.
+	ErrUnsafeURL = errors.New("unsafe URL")
+)
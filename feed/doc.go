@@ -0,0 +1,24 @@
+// Package feed emits URLs to workers with per-host rate limiting and concurrency caps, so
+// that crawlers built on this module stay polite to the hosts they visit. URLs are grouped
+// by host (or, optionally, by registrable domain, using the parsed Domain), queued per
+// group, and released on a channel when their group's rate limit and concurrency allow.
+//
+// Every Item received from the feeder must be released with Done once processed, which
+// frees its concurrency slots.
+//
+// Example:
+//
+//	feeder := feed.New(
+//	    feed.WithPerHostInterval(500*time.Millisecond),
+//	    feed.WithPerHostConcurrency(2),
+//	    feed.WithConcurrency(32),
+//	)
+//
+//	for item := range feeder.Feed(ctx, URLs) {
+//	    go func() {
+//	        defer item.Done()
+//
+//	        fetch(item.URL)
+//	    }()
+//	}
+package feed
@@ -0,0 +1,345 @@
+package feed
+
+import (
+	"context"
+	"strings"
+	"sync"
+	"time"
+
+	hqgourl "go.source.hueristiq.com/url"
+)
+
+// Item is a URL released by a Feeder. Done must be called once the URL is processed.
+type Item struct {
+	URL *hqgourl.URL
+
+	done func()
+}
+
+// Done releases the concurrency slots held by the item. It is safe to call more than once.
+func (i Item) Done() {
+	if i.done != nil {
+		i.done()
+	}
+}
+
+// Feeder releases URLs with per-host rate limiting and concurrency caps. A Feeder can run
+// several feeds; the global concurrency cap is shared between them.
+type Feeder struct {
+	perHostInterval    time.Duration
+	perHostConcurrency int
+	groupByDomain      bool
+
+	global chan struct{} // Nil if the global concurrency is not capped.
+}
+
+// OptionFunc defines a function type for configuring a Feeder.
+type OptionFunc func(*Feeder)
+
+// New creates a Feeder. By default, URLs are grouped by host, each host has at most one
+// URL in flight, and URLs are released as soon as that allows.
+//
+// Parameters:
+//   - opts (variadic OptionFunc): Options configuring the feeder.
+//
+// Returns:
+//   - feeder (*Feeder): The feeder.
+func New(opts ...OptionFunc) (feeder *Feeder) {
+	feeder = &Feeder{
+		perHostConcurrency: 1,
+	}
+
+	for _, opt := range opts {
+		opt(feeder)
+	}
+
+	return
+}
+
+// WithPerHostInterval returns an option function that sets the minimum interval between
+// the releases of two URLs of the same host.
+func WithPerHostInterval(interval time.Duration) OptionFunc {
+	return func(f *Feeder) {
+		f.perHostInterval = interval
+	}
+}
+
+// WithPerHostConcurrency returns an option function that sets the maximum number of URLs
+// of the same host in flight (released but not Done). A non-positive value removes the cap.
+func WithPerHostConcurrency(concurrency int) OptionFunc {
+	return func(f *Feeder) {
+		f.perHostConcurrency = concurrency
+	}
+}
+
+// WithConcurrency returns an option function that sets the maximum number of URLs in
+// flight across all hosts. A non-positive value removes the cap, which is the default.
+func WithConcurrency(concurrency int) OptionFunc {
+	return func(f *Feeder) {
+		f.global = nil
+
+		if concurrency > 0 {
+			f.global = make(chan struct{}, concurrency)
+		}
+	}
+}
+
+// WithGroupByDomain returns an option function that groups URLs by registrable domain
+// (e.g., "example.co.uk" for "a.example.co.uk" and "b.example.co.uk"), as parsed into
+// their Domain, rather than by host, so that the limits apply to a site as a whole. URLs
+// without a parsed Domain are grouped by host.
+func WithGroupByDomain() OptionFunc {
+	return func(f *Feeder) {
+		f.groupByDomain = true
+	}
+}
+
+// Feed releases the URLs received on URLs on the returned channel, which is closed once
+// URLs is closed and all its URLs were released, or ctx is done. Nil URLs are skipped.
+//
+// Parameters:
+//   - ctx (context.Context): The context; once done, no more URLs are released.
+//   - URLs (<-chan *hqgourl.URL): The URLs to release, e.g. deduplicated and in scope.
+//
+// Returns:
+//   - items (<-chan Item): The released URLs.
+func (f *Feeder) Feed(ctx context.Context, URLs <-chan *hqgourl.URL) (items <-chan Item) {
+	out := make(chan Item)
+
+	go func() {
+		defer close(out)
+
+		var wg sync.WaitGroup
+
+		queues := map[string]*queue{}
+
+		defer func() {
+			for _, q := range queues {
+				q.close()
+			}
+
+			wg.Wait()
+		}()
+
+		for {
+			var URL *hqgourl.URL
+
+			var ok bool
+
+			select {
+			case <-ctx.Done():
+				return
+			case URL, ok = <-URLs:
+			}
+
+			if !ok {
+				return
+			}
+
+			if URL == nil || URL.URL == nil {
+				continue
+			}
+
+			key := f.group(URL)
+
+			q, exists := queues[key]
+			if !exists {
+				q = newQueue()
+
+				queues[key] = q
+
+				wg.Add(1)
+
+				go func() {
+					defer wg.Done()
+
+					f.release(ctx, q, out)
+				}()
+			}
+
+			q.push(URL)
+		}
+	}()
+
+	items = out
+
+	return
+}
+
+// group returns the key of the group of URL.
+func (f *Feeder) group(URL *hqgourl.URL) (key string) {
+	key = strings.ToLower(URL.Hostname())
+
+	if f.groupByDomain && URL.Domain != nil && URL.Domain.SLD != "" {
+		key = strings.ToLower(URL.Domain.SLD + "." + URL.Domain.TLD)
+	}
+
+	return
+}
+
+// release releases the URLs of a group's queue, honoring the group's rate limit and
+// concurrency cap and the global concurrency cap.
+func (f *Feeder) release(ctx context.Context, q *queue, out chan<- Item) {
+	var slots chan struct{}
+
+	if f.perHostConcurrency > 0 {
+		slots = make(chan struct{}, f.perHostConcurrency)
+	}
+
+	var last time.Time
+
+	for {
+		URL, ok := q.pop(ctx)
+		if !ok {
+			return
+		}
+
+		if wait := f.perHostInterval - time.Since(last); !last.IsZero() && wait > 0 {
+			timer := time.NewTimer(wait)
+
+			select {
+			case <-ctx.Done():
+				timer.Stop()
+
+				return
+			case <-timer.C:
+			}
+		}
+
+		if !acquire(ctx, slots) {
+			return
+		}
+
+		if !acquire(ctx, f.global) {
+			release(slots)
+
+			return
+		}
+
+		var once sync.Once
+
+		item := Item{
+			URL: URL,
+			done: func() {
+				once.Do(func() {
+					release(f.global)
+					release(slots)
+				})
+			},
+		}
+
+		select {
+		case <-ctx.Done():
+			item.Done()
+
+			return
+		case out <- item:
+			last = time.Now()
+		}
+	}
+}
+
+// acquire takes a slot of slots, a nil slots being uncapped. It reports false if ctx is
+// done first.
+func acquire(ctx context.Context, slots chan struct{}) (acquired bool) {
+	if slots == nil {
+		acquired = true
+
+		return
+	}
+
+	select {
+	case <-ctx.Done():
+	case slots <- struct{}{}:
+		acquired = true
+	}
+
+	return
+}
+
+// release frees a slot of slots.
+func release(slots chan struct{}) {
+	if slots != nil {
+		<-slots
+	}
+}
+
+// queue is an unbounded FIFO queue of URLs, so that a slow group doesn't hold up the
+// others.
+type queue struct {
+	mu      sync.Mutex
+	pending []*hqgourl.URL
+	closed  bool
+	signal  chan struct{}
+}
+
+// newQueue creates an empty queue.
+func newQueue() (q *queue) {
+	q = &queue{
+		signal: make(chan struct{}, 1),
+	}
+
+	return
+}
+
+// push appends a URL.
+func (q *queue) push(URL *hqgourl.URL) {
+	q.mu.Lock()
+
+	q.pending = append(q.pending, URL)
+
+	q.mu.Unlock()
+
+	q.notify()
+}
+
+// close marks the queue as closed: pop returns false once it is empty.
+func (q *queue) close() {
+	q.mu.Lock()
+
+	q.closed = true
+
+	q.mu.Unlock()
+
+	q.notify()
+}
+
+// notify wakes up a pending pop.
+func (q *queue) notify() {
+	select {
+	case q.signal <- struct{}{}:
+	default:
+	}
+}
+
+// pop removes the first URL, waiting for one if the queue is empty. It reports false if
+// the queue is closed and empty, or ctx is done.
+func (q *queue) pop(ctx context.Context) (URL *hqgourl.URL, ok bool) {
+	for {
+		q.mu.Lock()
+
+		if len(q.pending) > 0 {
+			URL, q.pending = q.pending[0], q.pending[1:]
+
+			q.mu.Unlock()
+
+			ok = true
+
+			return
+		}
+
+		closed := q.closed
+
+		q.mu.Unlock()
+
+		if closed {
+			return
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-q.signal:
+		}
+	}
+}
@@ -0,0 +1,132 @@
+package feed_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	hqgourl "go.source.hueristiq.com/url"
+	"go.source.hueristiq.com/url/feed"
+)
+
+func parse(t *testing.T, raws ...string) (URLs chan *hqgourl.URL) {
+	t.Helper()
+
+	parser := hqgourl.NewParser()
+
+	URLs = make(chan *hqgourl.URL, len(raws)+1)
+
+	for _, raw := range raws {
+		parsed, err := parser.Parse(raw)
+
+		require.NoError(t, err)
+
+		URLs <- parsed
+	}
+
+	URLs <- nil
+
+	close(URLs)
+
+	return
+}
+
+func TestFeeder_Feed_PerHostInterval(t *testing.T) {
+	t.Parallel()
+
+	const interval = 30 * time.Millisecond
+
+	feeder := feed.New(feed.WithPerHostInterval(interval), feed.WithPerHostConcurrency(0))
+
+	URLs := parse(t, "https://a.example.com/1", "https://a.example.com/2", "https://a.example.com/3", "https://b.example.org/1")
+
+	released := map[string][]time.Time{}
+
+	for item := range feeder.Feed(context.Background(), URLs) {
+		released[item.URL.Hostname()] = append(released[item.URL.Hostname()], time.Now())
+
+		item.Done()
+	}
+
+	require.Len(t, released["a.example.com"], 3)
+	require.Len(t, released["b.example.org"], 1)
+
+	for i := 1; i < 3; i++ {
+		assert.GreaterOrEqual(t, released["a.example.com"][i].Sub(released["a.example.com"][i-1]), interval-5*time.Millisecond)
+	}
+
+	// Other hosts are not held up by the rate limit.
+	assert.Less(t, released["b.example.org"][0].Sub(released["a.example.com"][0]), interval)
+}
+
+func TestFeeder_Feed_PerHostConcurrency(t *testing.T) {
+	t.Parallel()
+
+	feeder := feed.New(feed.WithGroupByDomain())
+
+	URLs := parse(t, "https://a.example.com/1", "https://b.example.com/2")
+
+	items := feeder.Feed(context.Background(), URLs)
+
+	first := <-items
+
+	select {
+	case <-items:
+		t.Fatal("released a second URL of the same domain before the first was done")
+	case <-time.After(50 * time.Millisecond):
+	}
+
+	first.Done()
+	first.Done()
+
+	second, ok := <-items
+
+	require.True(t, ok)
+	assert.Equal(t, "b.example.com", second.URL.Hostname())
+
+	second.Done()
+
+	_, ok = <-items
+
+	assert.False(t, ok)
+}
+
+func TestFeeder_Feed_Concurrency(t *testing.T) {
+	t.Parallel()
+
+	feeder := feed.New(feed.WithConcurrency(1))
+
+	items := feeder.Feed(context.Background(), parse(t, "https://a.example.com/", "https://b.example.com/"))
+
+	first := <-items
+
+	select {
+	case <-items:
+		t.Fatal("released a second URL beyond the global concurrency cap")
+	case <-time.After(50 * time.Millisecond):
+	}
+
+	first.Done()
+
+	second := <-items
+
+	second.Done()
+}
+
+func TestFeeder_Feed_Cancel(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithCancel(context.Background())
+
+	URLs := make(chan *hqgourl.URL)
+
+	items := feed.New().Feed(ctx, URLs)
+
+	cancel()
+
+	_, ok := <-items
+
+	assert.False(t, ok)
+}
@@ -0,0 +1,95 @@
+package formats
+
+import (
+	"encoding/json"
+	"io"
+	"regexp"
+	"strconv"
+	"strings"
+
+	hqgourl "go.source.hueristiq.com/url"
+)
+
+// burpScope is the JSON document of a Burp Suite target scope, as exported and imported
+// from the "Target > Scope settings" of Burp.
+type burpScope struct {
+	Target struct {
+		Scope struct {
+			AdvancedMode bool            `json:"advanced_mode"`
+			Include      []burpScopeRule `json:"include"`
+			Exclude      []burpScopeRule `json:"exclude"`
+		} `json:"scope"`
+	} `json:"target"`
+}
+
+// burpScopeRule is a rule of a Burp Suite advanced target scope. Host, port and file
+// are regular expressions.
+type burpScopeRule struct {
+	Enabled  bool   `json:"enabled"`
+	Protocol string `json:"protocol"`
+	Host     string `json:"host"`
+	Port     string `json:"port"`
+	File     string `json:"file"`
+}
+
+// BurpScope writes a Burp Suite advanced target scope including the URLs, as JSON that
+// can be loaded in "Target > Scope settings". Each URL becomes an include rule matching
+// its protocol, host and port (the default port of its scheme if it has none), and its
+// path and everything below it. HTTP(S) URLs only are included, without duplicates.
+//
+// Parameters:
+//   - w (io.Writer): The writer to write the scope to.
+//   - urls ([]*hqgourl.URL): The parsed URLs.
+//
+// Returns:
+//   - err (error): The error returned by w, if any.
+func BurpScope(w io.Writer, urls []*hqgourl.URL) (err error) {
+	var scope burpScope
+
+	scope.Target.Scope.AdvancedMode = true
+	scope.Target.Scope.Include = []burpScopeRule{}
+	scope.Target.Scope.Exclude = []burpScopeRule{}
+
+	seen := map[burpScopeRule]struct{}{}
+
+	for _, u := range urls {
+		if u == nil || u.URL == nil || u.Hostname() == "" {
+			continue
+		}
+
+		protocol := strings.ToLower(u.Scheme)
+		if protocol != "http" && protocol != "https" {
+			continue
+		}
+
+		path := u.EscapedPath()
+		if path == "" {
+			path = "/"
+		}
+
+		rule := burpScopeRule{
+			Enabled:  true,
+			Protocol: protocol,
+			Host:     "^" + regexp.QuoteMeta(strings.ToLower(u.Hostname())) + "$",
+			Port:     "^" + strconv.Itoa(u.PortOrDefault()) + "$",
+			File:     "^" + regexp.QuoteMeta(path) + ".*",
+		}
+
+		if _, ok := seen[rule]; ok {
+			continue
+		}
+
+		seen[rule] = struct{}{}
+
+		scope.Target.Scope.Include = append(scope.Target.Scope.Include, rule)
+	}
+
+	encoder := json.NewEncoder(w)
+
+	encoder.SetEscapeHTML(false)
+	encoder.SetIndent("", "    ")
+
+	err = encoder.Encode(scope)
+
+	return
+}
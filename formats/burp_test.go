@@ -0,0 +1,58 @@
+package formats_test
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.source.hueristiq.com/url/formats"
+)
+
+func TestBurpScope(t *testing.T) {
+	t.Parallel()
+
+	urls := parse(t,
+		"https://Example.com",
+		"https://example.com/",
+		"http://api.example.com:8080/v1/users?id=1",
+		"ftp://files.example.com/",
+	)
+
+	var b strings.Builder
+
+	require.NoError(t, formats.BurpScope(&b, urls))
+
+	var scope struct {
+		Target struct {
+			Scope struct {
+				AdvancedMode bool                `json:"advanced_mode"`
+				Include      []map[string]any    `json:"include"`
+				Exclude      []map[string]string `json:"exclude"`
+			} `json:"scope"`
+		} `json:"target"`
+	}
+
+	require.NoError(t, json.Unmarshal([]byte(b.String()), &scope))
+
+	assert.True(t, scope.Target.Scope.AdvancedMode)
+	assert.Empty(t, scope.Target.Scope.Exclude)
+	require.Len(t, scope.Target.Scope.Include, 2)
+
+	assert.Equal(t, map[string]any{
+		"enabled":  true,
+		"protocol": "https",
+		"host":     `^example\.com$`,
+		"port":     "^443$",
+		"file":     "^/.*",
+	}, scope.Target.Scope.Include[0])
+
+	assert.Equal(t, map[string]any{
+		"enabled":  true,
+		"protocol": "http",
+		"host":     `^api\.example\.com$`,
+		"port":     "^8080$",
+		"file":     "^/v1/users.*",
+	}, scope.Target.Scope.Include[1])
+}
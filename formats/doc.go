@@ -0,0 +1,16 @@
+// Package formats writes lists of hosts and URLs, as parsed or extracted with this module,
+// in formats consumed by common tools:
+//
+//   - Hosts: /etc/hosts lines (e.g., to sinkhole domains).
+//   - Dnsmasq: dnsmasq "address" directives.
+//   - NginxMap: an nginx map block, keyed on the host of requests.
+//   - BurpScope: a Burp Suite advanced target scope, as JSON.
+//
+// Example:
+//
+//	hosts := formats.Hostnames(urls)
+//
+//	if err := formats.Hosts(os.Stdout, hosts, "0.0.0.0"); err != nil {
+//	    log.Fatal(err)
+//	}
+package formats
@@ -0,0 +1,132 @@
+package formats
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"strings"
+
+	hqgourl "go.source.hueristiq.com/url"
+)
+
+// Hostnames returns the hostnames of urls, lowercased, without port, brackets or
+// duplicates, in the order of their first occurrence. URLs without host are skipped.
+//
+// Parameters:
+//   - urls ([]*hqgourl.URL): The parsed URLs.
+//
+// Returns:
+//   - hostnames ([]string): The hostnames.
+func Hostnames(urls []*hqgourl.URL) (hostnames []string) {
+	seen := map[string]struct{}{}
+
+	for _, u := range urls {
+		if u == nil || u.URL == nil {
+			continue
+		}
+
+		hostname := strings.ToLower(u.Hostname())
+		if hostname == "" {
+			continue
+		}
+
+		if _, ok := seen[hostname]; ok {
+			continue
+		}
+
+		seen[hostname] = struct{}{}
+
+		hostnames = append(hostnames, hostname)
+	}
+
+	return
+}
+
+// Hosts writes an /etc/hosts line mapping each host to ip, e.g. "0.0.0.0 example.com" to
+// sinkhole it.
+//
+// Parameters:
+//   - w (io.Writer): The writer to write the lines to.
+//   - hosts ([]string): The hosts (e.g., as returned by Hostnames).
+//   - ip (string): The IP address the hosts resolve to (e.g., "0.0.0.0" or "127.0.0.1").
+//
+// Returns:
+//   - err (error): The error returned by w, if any.
+func Hosts(w io.Writer, hosts []string, ip string) (err error) {
+	err = writeLines(w, hosts, func(host string) string {
+		return ip + " " + host
+	})
+
+	return
+}
+
+// Dnsmasq writes a dnsmasq "address" directive mapping each host, and its subdomains, to
+// ip, e.g. "address=/example.com/0.0.0.0". With an empty ip, the directives make dnsmasq
+// answer NXDOMAIN ("address=/example.com/").
+//
+// Parameters:
+//   - w (io.Writer): The writer to write the directives to.
+//   - hosts ([]string): The hosts (e.g., as returned by Hostnames).
+//   - ip (string): The IP address the hosts resolve to, or an empty string.
+//
+// Returns:
+//   - err (error): The error returned by w, if any.
+func Dnsmasq(w io.Writer, hosts []string, ip string) (err error) {
+	err = writeLines(w, hosts, func(host string) string {
+		return "address=/" + host + "/" + ip
+	})
+
+	return
+}
+
+// NginxMap writes an nginx map block setting variable to value for requests to the hosts,
+// and to an empty string otherwise:
+//
+//	map $host $blocked {
+//	    default "";
+//	    example.com "1";
+//	}
+//
+// Parameters:
+//   - w (io.Writer): The writer to write the block to.
+//   - variable (string): The name of the variable set by the map, with or without "$".
+//   - hosts ([]string): The hosts (e.g., as returned by Hostnames).
+//   - value (string): The value of the variable for requests to the hosts.
+//
+// Returns:
+//   - err (error): The error returned by w, if any.
+func NginxMap(w io.Writer, variable string, hosts []string, value string) (err error) {
+	buf := bufio.NewWriter(w)
+
+	fmt.Fprintf(buf, "map $host $%s {\n", strings.TrimPrefix(variable, "$"))
+	fmt.Fprintf(buf, "    default %s;\n", nginxQuote(""))
+
+	for _, host := range hosts {
+		fmt.Fprintf(buf, "    %s %s;\n", host, nginxQuote(value))
+	}
+
+	fmt.Fprintln(buf, "}")
+
+	err = buf.Flush()
+
+	return
+}
+
+// nginxQuote quotes s as an nginx string.
+func nginxQuote(s string) string {
+	return `"` + strings.NewReplacer(`\`, `\\`, `"`, `\"`).Replace(s) + `"`
+}
+
+// writeLines writes the line format returns for each host.
+func writeLines(w io.Writer, hosts []string, format func(host string) string) (err error) {
+	buf := bufio.NewWriter(w)
+
+	for _, host := range hosts {
+		buf.WriteString(format(host))
+		buf.WriteByte('\n')
+	}
+
+	err = buf.Flush()
+
+	return
+}
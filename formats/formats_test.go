@@ -0,0 +1,78 @@
+package formats_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	hqgourl "go.source.hueristiq.com/url"
+	"go.source.hueristiq.com/url/formats"
+)
+
+func parse(t *testing.T, raws ...string) (urls []*hqgourl.URL) {
+	t.Helper()
+
+	parser := hqgourl.NewParser()
+
+	for _, raw := range raws {
+		parsed, err := parser.Parse(raw)
+
+		require.NoError(t, err)
+
+		urls = append(urls, parsed)
+	}
+
+	return
+}
+
+func TestHostnames(t *testing.T) {
+	t.Parallel()
+
+	urls := parse(t, "https://Example.com/a", "http://example.com:8080/b", "https://api.example.com", "/relative", "http://[::1]/")
+
+	assert.Equal(t, []string{"example.com", "api.example.com", "::1"}, formats.Hostnames(append(urls, nil)))
+}
+
+func TestHosts(t *testing.T) {
+	t.Parallel()
+
+	var b strings.Builder
+
+	require.NoError(t, formats.Hosts(&b, []string{"example.com", "api.example.com"}, "0.0.0.0"))
+
+	assert.Equal(t, "0.0.0.0 example.com\n0.0.0.0 api.example.com\n", b.String())
+}
+
+func TestDnsmasq(t *testing.T) {
+	t.Parallel()
+
+	var b strings.Builder
+
+	require.NoError(t, formats.Dnsmasq(&b, []string{"example.com"}, "127.0.0.1"))
+	require.NoError(t, formats.Dnsmasq(&b, []string{"evil.com"}, ""))
+
+	assert.Equal(t, "address=/example.com/127.0.0.1\naddress=/evil.com/\n", b.String())
+}
+
+func TestNginxMap(t *testing.T) {
+	t.Parallel()
+
+	var b strings.Builder
+
+	require.NoError(t, formats.NginxMap(&b, "$blocked", []string{"example.com", "api.example.com"}, "1"))
+
+	assert.Equal(t, `map $host $blocked {
+    default "";
+    example.com "1";
+    api.example.com "1";
+}
+`, b.String())
+
+	b.Reset()
+
+	require.NoError(t, formats.NginxMap(&b, "upstream", []string{"example.com"}, `say "no"`))
+
+	assert.Contains(t, b.String(), "map $host $upstream {\n")
+	assert.Contains(t, b.String(), `example.com "say \"no\"";`)
+}
@@ -2,20 +2,50 @@ package main
 
 import (
 	"bufio"
+	"bytes"
+	"encoding/json"
+	"errors"
 	"flag"
 	"fmt"
+	"go/format"
 	"log"
-	"net/http"
 	"os"
 	"regexp"
+	"slices"
 	"sort"
+	"strconv"
 	"strings"
 	"text/template"
+	"time"
+
+	"go.source.hueristiq.com/url/gen/internal/genutil"
+	"go.source.hueristiq.com/url/tlds"
+	"golang.org/x/net/idna"
 )
 
 var (
 	// Output file path for the generated Go source file.
 	output string
+	// Output file path for the generated Go source file with the categories of the TLDs.
+	categoriesOutput string
+	// Output file path for the generated Go source file with the countries of the ccTLDs.
+	countriesOutput string
+	// Input file path for the curated list of abuse-prone TLDs with their risk scores.
+	riskInput string
+	// Output file path for the generated Go source file with the risk scores of the TLDs.
+	riskOutput string
+	// Output file path for the generated Go source file with the generation metadata.
+	metadataOutput string
+	// Directory holding the snapshots of the sources, read instead of fetching them.
+	snapshotDir string
+	// Whether to fetch the sources and update their snapshots.
+	updateSnapshot bool
+	// Whether to report the differences between the embedded list and the sources instead
+	// of generating files.
+	diff bool
+
+	// sourceETags maps the URLs of the fetched sources to the ETags they were served with.
+	sourceETags = map[string]string{}
 
 	// Template for the autogenerated Go file containing the list of TLDs.
 	tmpl = template.Must(template.New("schemes").Parse(`// This file is autogenerated by the TLDs generator. Please do not edit manually.
@@ -37,12 +67,81 @@ var Official = []string{
 	"{{$TLD}}",
 {{- end}}
 }
+`))
+
+	// Template for the autogenerated Go file containing the categories of the TLDs.
+	categoriesTmpl = template.Must(template.New("categories").Parse(`// This file is autogenerated by the TLDs generator. Please do not edit manually.
+package tlds
+
+// categories maps each TLD of the IANA root zone database (in Unicode form) to its type,
+// as listed in:
+//   - https://www.iana.org/domains/root/db
+var categories = map[string]Type{
+{{- range .Categories}}
+	"{{.TLD}}": {{.Type}},
+{{- end}}
+}
+`))
+
+	// Template for the autogenerated Go file containing the countries of the ccTLDs.
+	countriesTmpl = template.Must(template.New("countries").Parse(`// This file is autogenerated by the TLDs generator. Please do not edit manually.
+package tlds
+
+// countries maps each country-code TLD of the IANA root zone database (in Unicode form) to
+// its ISO 3166-1 country, as listed in:
+//   - https://www.iana.org/domains/root/db
+//   - https://salsa.debian.org/iso-codes-team/iso-codes/-/raw/main/data/iso_3166-1.json
+var countries = map[string]CountryInfo{
+{{- range .Countries}}
+	"{{.TLD}}": {Code: "{{.Code}}", Name: {{printf "%q" .Name}}},
+{{- end}}
+}
+`))
+
+	// Template for the autogenerated Go file containing the risk scores of the TLDs.
+	riskTmpl = template.Must(template.New("risk").Parse(`// This file is autogenerated by the TLDs generator. Please do not edit manually.
+package tlds
+
+// DefaultRiskScores is the built-in RiskSource, mapping abuse-prone TLDs (in Unicode form)
+// to their risk scores, curated from published abuse statistics in tlds_risk.txt.
+var DefaultRiskScores = RiskScores{
+{{- range .Risks}}
+	"{{.TLD}}": {{.Score}},
+{{- end}}
+}
+`))
+
+	// Template for the autogenerated Go file containing the generation metadata of the list of TLDs.
+	metadataTmpl = template.Must(template.New("metadata").Parse(`// This file is autogenerated by the TLDs generator. Please do not edit manually.
+package tlds
+
+import "time"
+
+// init sets the generation metadata of Official: the time it was generated at, and the
+// ETags its sources were served with.
+func init() {
+	GeneratedAt = time.Unix({{.GeneratedAt.Unix}}, 0).UTC()
+
+	SourceETags = map[string]string{
+{{- range $URL, $ETag := .SourceETags}}
+		"{{$URL}}": {{printf "%q" $ETag}},
+{{- end}}
+	}
+}
 `))
 )
 
 func init() {
 	// Define the command-line flag for output file path
 	flag.StringVar(&output, "output", "", "Specify the output file path for the generated Go source file.")
+	flag.StringVar(&categoriesOutput, "categories-output", "", "Specify the output file path for the generated Go source file with TLD categories.")
+	flag.StringVar(&countriesOutput, "countries-output", "", "Specify the output file path for the generated Go source file with ccTLD countries.")
+	flag.StringVar(&riskInput, "risk-input", "", "Specify the input file path for the curated list of abuse-prone TLDs with their risk scores.")
+	flag.StringVar(&riskOutput, "risk-output", "", "Specify the output file path for the generated Go source file with TLD risk scores.")
+	flag.StringVar(&metadataOutput, "metadata-output", "", "Specify the output file path for the generated Go source file with generation metadata.")
+	flag.StringVar(&snapshotDir, "snapshot-dir", "", "Specify the directory holding the snapshots of the sources, read instead of fetching them.")
+	flag.BoolVar(&updateSnapshot, "update-snapshot", false, "Fetch the sources and update their snapshots in the snapshot directory.")
+	flag.BoolVar(&diff, "diff", false, "Report the TLDs added to and removed from the sources since the embedded list was generated, exiting with status 1 if any.")
 
 	// Custom usage message for the command-line flag
 	flag.Usage = func() {
@@ -50,7 +149,15 @@ func init() {
 		h += "  schemes [OPTIONS]\n"
 
 		h += "\nOPTIONS:\n"
-		h += " -output string    Specify the output file path for the generated Go source file.\n"
+		h += " -output string             Specify the output file path for the generated Go source file.\n"
+		h += " -categories-output string  Specify the output file path for the generated Go source file with TLD categories.\n"
+		h += " -countries-output string   Specify the output file path for the generated Go source file with ccTLD countries.\n"
+		h += " -risk-input string         Specify the input file path for the curated list of abuse-prone TLDs with their risk scores.\n"
+		h += " -risk-output string        Specify the output file path for the generated Go source file with TLD risk scores.\n"
+		h += " -metadata-output string    Specify the output file path for the generated Go source file with generation metadata.\n"
+		h += " -snapshot-dir string       Specify the directory holding the snapshots of the sources, read instead of fetching them.\n"
+		h += " -update-snapshot           Fetch the sources and update their snapshots in the snapshot directory.\n"
+		h += " -diff                      Report the TLDs added to and removed from the sources since the embedded list was generated, exiting with status 1 if any.\n"
 
 		fmt.Fprintln(os.Stderr, h)
 	}
@@ -60,6 +167,15 @@ func init() {
 }
 
 func main() {
+	// Report the drift of the embedded list instead of generating files, if requested
+	if diff {
+		if err := reportDiff(); err != nil {
+			log.Fatalf("Failed to report the differences: %v\n", err)
+		}
+
+		return
+	}
+
 	// Ensure that an output file path is specified
 	if output == "" {
 		log.Fatalln("Output file path is required. Use -output to specify the output file path.")
@@ -67,16 +183,96 @@ func main() {
 
 	log.Printf("Generating %s...\n", output)
 
+	TLDs, err := getTLDs()
+	if err != nil {
+		log.Fatalln(err)
+	}
+
+	// Write the TLDs to the output file
+	if err := writeTLDsToFile(TLDs, output); err != nil {
+		log.Fatalf("Failed to write schemes to file: %v\n", err)
+	}
+
+	var categories []category
+
+	// Fetch the categories of the TLDs, needed by both the categories and the countries
+	if categoriesOutput != "" || countriesOutput != "" {
+		categories, err = getCategoriesFromIANA()
+		if err != nil {
+			log.Fatalf("Failed to get TLD categories from IANA: %v\n", err)
+		}
+	}
+
+	// Write the categories of the TLDs to the categories output file, if requested
+	if categoriesOutput != "" {
+		log.Printf("Generating %s...\n", categoriesOutput)
+
+		if err := writeCategoriesToFile(categories, categoriesOutput); err != nil {
+			log.Fatalf("Failed to write categories to file: %v\n", err)
+		}
+	}
+
+	// Write the countries of the ccTLDs to the countries output file, if requested
+	if countriesOutput != "" {
+		log.Printf("Generating %s...\n", countriesOutput)
+
+		countries, err := getCountries(categories)
+		if err != nil {
+			log.Fatalf("Failed to get ccTLD countries: %v\n", err)
+		}
+
+		if err := writeCountriesToFile(countries, countriesOutput); err != nil {
+			log.Fatalf("Failed to write countries to file: %v\n", err)
+		}
+	}
+
+	// Write the risk scores of the TLDs to the risk output file, if requested
+	if riskOutput != "" {
+		if riskInput == "" {
+			log.Fatalln("Risk input file path is required. Use -risk-input to specify the curated list of abuse-prone TLDs.")
+		}
+
+		log.Printf("Generating %s...\n", riskOutput)
+
+		risks, err := readRisks(riskInput)
+		if err != nil {
+			log.Fatalf("Failed to read TLD risk scores: %v\n", err)
+		}
+
+		if err := writeRisksToFile(risks, riskOutput); err != nil {
+			log.Fatalf("Failed to write risk scores to file: %v\n", err)
+		}
+	}
+
+	// Write the generation metadata to the metadata output file, if requested
+	if metadataOutput != "" {
+		log.Printf("Generating %s...\n", metadataOutput)
+
+		if err := writeMetadataToFile(metadataOutput); err != nil {
+			log.Fatalf("Failed to write metadata to file: %v\n", err)
+		}
+	}
+
+	log.Println("TLDs file generated successfully.")
+}
+
+// getTLDs fetches the TLDs from IANA and the effective TLDs from the Public Suffix List,
+// and returns them combined, sorted and without duplicates.
+func getTLDs() (TLDs []string, err error) {
 	// Fetch TLDs from IANA
-	TLDs, err := getTLDsFromIANA()
+	TLDs, err = getTLDsFromIANA()
 	if err != nil {
-		log.Fatalf("Failed to get TLDs from IANA: %v\n", err)
+		err = fmt.Errorf("failed to get TLDs from IANA: %w", err)
+
+		return
 	}
 
 	// Fetch effective TLDs from the Public Suffix list
 	eTLDs, err := getEffectiveTLDsFromPublicSuffix()
 	if err != nil {
-		log.Fatalf("Failed to get effective TLDs from Public Suffix: %v\n", err)
+		err = fmt.Errorf("failed to get effective TLDs from Public Suffix: %w", err)
+
+		return
 	}
 
 	// Combine both TLDs and eTLDs
@@ -88,33 +284,66 @@ func main() {
 	// Remove duplicate entries
 	TLDs = removeDuplicates(TLDs)
 
-	// Write the TLDs to the output file
-	if err := writeTLDsToFile(TLDs, output); err != nil {
-		log.Fatalf("Failed to write schemes to file: %v\n", err)
+	return
+}
+
+// reportDiff prints the TLDs added to and removed from the sources since the embedded list
+// was generated, one per line prefixed with "+" or "-", and exits with status 1 if there
+// are any, so that scheduled jobs can alert on drift.
+func reportDiff() (err error) {
+	TLDs, err := getTLDs()
+	if err != nil {
+		return
 	}
 
-	log.Println("TLDs file generated successfully.")
+	report := tlds.Diff(tlds.NewTLDSet(TLDs...))
+
+	for _, TLD := range report.Added {
+		fmt.Println("+" + TLD)
+	}
+
+	for _, TLD := range report.Removed {
+		fmt.Println("-" + TLD)
+	}
+
+	log.Printf("%d TLDs added and %d removed since %s.\n", len(report.Added), len(report.Removed), tlds.GeneratedAt.Format(time.DateOnly))
+
+	if !report.Empty() {
+		os.Exit(1)
+	}
+
+	return
+}
+
+// get fetches the source at URL (or reads its snapshot), recording its ETag in sourceETags.
+func get(URL string) (body *bytes.Reader, err error) {
+	data, ETag, err := genutil.Fetch(URL, snapshotDir, updateSnapshot)
+	if err != nil {
+		return
+	}
+
+	sourceETags[URL] = ETag
+
+	body = bytes.NewReader(data)
+
+	return
 }
 
 // getTLDsFromIANA fetches the list of TLDs from the IANA TLD list and returns them.
 func getTLDsFromIANA() (TLDs []string, err error) {
-	// Perform HTTP GET request to fetch the IANA TLD list
-	var res *http.Response
-
-	res, err = http.Get("https://data.iana.org/TLD/tlds-alpha-by-domain.txt")
+	// Fetch the IANA TLD list
+	body, err := get("https://data.iana.org/TLD/tlds-alpha-by-domain.txt")
 	if err != nil {
 		err = fmt.Errorf("failed to fetch IANA TLDs: %w", err)
 
 		return
 	}
 
-	defer res.Body.Close()
-
 	// Regular expression to match valid TLD entries (ignore comments)
 	re := regexp.MustCompile(`^[^#]+$`)
 
 	// Scan through the response body line by line
-	scanner := bufio.NewScanner(res.Body)
+	scanner := bufio.NewScanner(body)
 
 	for scanner.Scan() {
 		line := scanner.Text()
@@ -143,20 +372,16 @@ func getTLDsFromIANA() (TLDs []string, err error) {
 }
 
 func getEffectiveTLDsFromPublicSuffix() (eTLDs []string, err error) {
-	// Perform HTTP GET request to fetch the Public Suffix list
-	var res *http.Response
-
-	res, err = http.Get("https://publicsuffix.org/list/effective_tld_names.dat")
+	// Fetch the Public Suffix list
+	body, err := get("https://publicsuffix.org/list/effective_tld_names.dat")
 	if err != nil {
 		err = fmt.Errorf("failed to fetch Public Suffix TLDs: %w", err)
 
 		return
 	}
 
-	defer res.Body.Close()
-
 	// Scan through the response body line by line
-	scanner := bufio.NewScanner(res.Body)
+	scanner := bufio.NewScanner(body)
 
 	for scanner.Scan() {
 		line := scanner.Text()
@@ -196,6 +421,380 @@ func getEffectiveTLDsFromPublicSuffix() (eTLDs []string, err error) {
 	return
 }
 
+// rootZoneDatabaseURL is the URL of the IANA root zone database, listing the type of each TLD.
+const rootZoneDatabaseURL = "https://www.iana.org/domains/root/db"
+
+var (
+	// rootZoneDatabaseRowRegex matches the rows of the IANA root zone database, capturing
+	// the TLD (in A-label form, from its link) and its type.
+	rootZoneDatabaseRowRegex = regexp.MustCompile(`<a href="/domains/root/db/([^"]+)\.html">[^<]*</a>\s*</span>\s*</td>\s*<td>([^<]+)</td>`)
+
+	// typeConstants maps the TLD types of the IANA root zone database to the names of the
+	// corresponding constants of the tlds package.
+	typeConstants = map[string]string{
+		"generic":            "GTLD",
+		"country-code":       "CCTLD",
+		"sponsored":          "SponsoredTLD",
+		"generic-restricted": "GenericRestrictedTLD",
+		"infrastructure":     "InfrastructureTLD",
+		"test":               "TestTLD",
+	}
+)
+
+// category is a TLD, in Unicode form, with the name of the constant of its type.
+type category struct {
+	TLD  string
+	Type string
+}
+
+// getCategoriesFromIANA fetches the IANA root zone database and returns the categories of
+// the TLDs, sorted.
+func getCategoriesFromIANA() (categories []category, err error) {
+	body, err := get(rootZoneDatabaseURL)
+	if err != nil {
+		err = fmt.Errorf("failed to fetch IANA root zone database: %w", err)
+
+		return
+	}
+
+	var page bytes.Buffer
+
+	if _, err = page.ReadFrom(body); err != nil {
+		return
+	}
+
+	for _, row := range rootZoneDatabaseRowRegex.FindAllStringSubmatch(page.String(), -1) {
+		constant, ok := typeConstants[strings.TrimSpace(row[2])]
+		if !ok {
+			err = fmt.Errorf("unknown TLD type %q for %q", row[2], row[1])
+
+			return
+		}
+
+		TLD := strings.ToLower(row[1])
+
+		// Keep the A-label of TLDs that don't convert, rather than failing.
+		if unicodeTLD, err := idna.ToUnicode(TLD); err == nil {
+			TLD = unicodeTLD
+		}
+
+		categories = append(categories, category{TLD: TLD, Type: constant})
+	}
+
+	if len(categories) == 0 {
+		err = errors.New("no TLD found in IANA root zone database")
+
+		return
+	}
+
+	sort.Slice(categories, func(i, j int) bool { return categories[i].TLD < categories[j].TLD })
+
+	return
+}
+
+// writeCategoriesToFile writes the categories of the TLDs to the specified file using a
+// Go source file template.
+func writeCategoriesToFile(categories []category, output string) (err error) {
+	var buf bytes.Buffer
+
+	if err = categoriesTmpl.Execute(&buf, struct{ Categories []category }{Categories: categories}); err != nil {
+		err = fmt.Errorf("failed to execute template: %w", err)
+
+		return
+	}
+
+	// Format the source, e.g. to align map values
+	source, err := format.Source(buf.Bytes())
+	if err != nil {
+		err = fmt.Errorf("failed to format source: %w", err)
+
+		return
+	}
+
+	if err = os.WriteFile(output, source, 0o644); err != nil { //nolint:gosec // Generated source files are world-readable.
+		err = fmt.Errorf("failed to write output file: %w", err)
+	}
+
+	return
+}
+
+// iso3166URL is the URL of the ISO 3166-1 dataset of the iso-codes project, listing the
+// codes and names of the countries.
+const iso3166URL = "https://salsa.debian.org/iso-codes-team/iso-codes/-/raw/main/data/iso_3166-1.json"
+
+var (
+	// countryCodeExceptions maps the ASCII ccTLDs that are not the ISO 3166-1 alpha-2 code
+	// of their country (in lowercase) to that code.
+	countryCodeExceptions = map[string]string{
+		"uk": "GB",
+	}
+
+	// reservedCountryNames holds the names of the exceptionally reserved ISO 3166-1 codes
+	// delegated as ccTLDs, missing from the dataset.
+	reservedCountryNames = map[string]string{
+		"AC": "Ascension Island",
+		"EU": "European Union",
+		"SU": "Soviet Union",
+	}
+
+	// idnCountryCodes maps the internationalized ccTLDs (in Unicode form) to the ISO 3166-1
+	// alpha-2 code of their country. It must list every internationalized ccTLD.
+	idnCountryCodes = map[string]string{
+		"ελ":          "GR",
+		"ευ":          "EU",
+		"бг":          "BG",
+		"бел":         "BY",
+		"ею":          "EU",
+		"қаз":         "KZ",
+		"мкд":         "MK",
+		"мон":         "MN",
+		"рф":          "RU",
+		"срб":         "RS",
+		"укр":         "UA",
+		"հայ":         "AM",
+		"ישראל":       "IL",
+		"الاردن":      "JO",
+		"البحرين":     "BH",
+		"الجزائر":     "DZ",
+		"السعودية":    "SA",
+		"السعوديه":    "SA",
+		"السعودیة":    "SA",
+		"السعودیۃ":    "SA",
+		"المغرب":      "MA",
+		"اليمن":       "YE",
+		"امارات":      "AE",
+		"ايران":       "IR",
+		"ایران":       "IR",
+		"بارت":        "IN",
+		"بھارت":       "IN",
+		"تونس":        "TN",
+		"سودان":       "SD",
+		"سوريا":       "SY",
+		"سورية":       "SY",
+		"عراق":        "IQ",
+		"عمان":        "OM",
+		"فلسطين":      "PS",
+		"قطر":         "QA",
+		"مصر":         "EG",
+		"مليسيا":      "MY",
+		"موريتانيا":   "MR",
+		"پاكستان":     "PK",
+		"پاکستان":     "PK",
+		"ڀارت":        "IN",
+		"भारत":        "IN",
+		"भारतम्":      "IN",
+		"भारोत":       "IN",
+		"বাংলা":       "BD",
+		"ভারত":        "IN",
+		"ভাৰত":        "IN",
+		"ਭਾਰਤ":        "IN",
+		"ભારત":        "IN",
+		"ଭାରତ":        "IN",
+		"இந்தியா":     "IN",
+		"இலங்கை":      "LK",
+		"சிங்கப்பூர்": "SG",
+		"భారత్":       "IN",
+		"ಭಾರತ":        "IN",
+		"ഭാരതം":       "IN",
+		"ලංකා":        "LK",
+		"ไทย":         "TH",
+		"ລາວ":         "LA",
+		"გე":          "GE",
+		"中国":          "CN",
+		"中國":          "CN",
+		"台湾":          "TW",
+		"台灣":          "TW",
+		"新加坡":         "SG",
+		"澳門":          "MO",
+		"澳门":          "MO",
+		"香港":          "HK",
+		"한국":          "KR",
+	}
+)
+
+// country is a ccTLD, in Unicode form, with the ISO 3166-1 code and name of its country.
+type country struct {
+	TLD  string
+	Code string
+	Name string
+}
+
+// getCountries fetches the ISO 3166-1 dataset and returns the countries of the ccTLDs in
+// categories, sorted.
+func getCountries(categories []category) (countries []country, err error) {
+	body, err := get(iso3166URL)
+	if err != nil {
+		err = fmt.Errorf("failed to fetch ISO 3166-1 dataset: %w", err)
+
+		return
+	}
+
+	var dataset struct {
+		Countries []struct {
+			Code string `json:"alpha_2"`
+			Name string `json:"name"`
+		} `json:"3166-1"`
+	}
+
+	if err = json.NewDecoder(body).Decode(&dataset); err != nil {
+		err = fmt.Errorf("failed to decode ISO 3166-1 dataset: %w", err)
+
+		return
+	}
+
+	names := make(map[string]string, len(dataset.Countries)+len(reservedCountryNames))
+
+	for _, c := range dataset.Countries {
+		names[c.Code] = c.Name
+	}
+
+	for code, name := range reservedCountryNames {
+		names[code] = name
+	}
+
+	for _, c := range categories {
+		if c.Type != "CCTLD" {
+			continue
+		}
+
+		code, ok := idnCountryCodes[c.TLD]
+		if !ok {
+			if code, ok = countryCodeExceptions[c.TLD]; !ok {
+				code = strings.ToUpper(c.TLD)
+			}
+		}
+
+		name, ok := names[code]
+		if !ok {
+			err = fmt.Errorf("no ISO 3166-1 country for ccTLD %q (code %q)", c.TLD, code)
+
+			return
+		}
+
+		countries = append(countries, country{TLD: c.TLD, Code: code, Name: name})
+	}
+
+	return
+}
+
+// writeCountriesToFile writes the countries of the ccTLDs to the specified file using a Go
+// source file template.
+func writeCountriesToFile(countries []country, output string) (err error) {
+	var buf bytes.Buffer
+
+	if err = countriesTmpl.Execute(&buf, struct{ Countries []country }{Countries: countries}); err != nil {
+		err = fmt.Errorf("failed to execute template: %w", err)
+
+		return
+	}
+
+	// Format the source, e.g. to align map values
+	source, err := format.Source(buf.Bytes())
+	if err != nil {
+		err = fmt.Errorf("failed to format source: %w", err)
+
+		return
+	}
+
+	if err = os.WriteFile(output, source, 0o644); err != nil { //nolint:gosec // Generated source files are world-readable.
+		err = fmt.Errorf("failed to write output file: %w", err)
+	}
+
+	return
+}
+
+// risk is a TLD, in Unicode form, with its risk score.
+type risk struct {
+	TLD   string
+	Score string
+}
+
+// readRisks reads the curated list of abuse-prone TLDs from the input file, one "TLD score"
+// pair per line, optionally followed by "#" and a note, and returns them sorted.
+func readRisks(input string) (risks []risk, err error) {
+	file, err := os.Open(input)
+	if err != nil {
+		err = fmt.Errorf("failed to open input file: %w", err)
+
+		return
+	}
+
+	defer file.Close()
+
+	scanner := bufio.NewScanner(file)
+
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+
+		line, _, _ = strings.Cut(line, "#")
+
+		fields := strings.Fields(line)
+		if len(fields) != 2 {
+			err = fmt.Errorf("malformed line %q, expected \"TLD score\"", line)
+
+			return
+		}
+
+		var score float64
+
+		if score, err = strconv.ParseFloat(fields[1], 64); err != nil || score < 0 || score > 1 {
+			err = fmt.Errorf("invalid score %q for %q, expected a number between 0 and 1", fields[1], fields[0])
+
+			return
+		}
+
+		TLD := strings.ToLower(fields[0])
+
+		if unicodeTLD, err := idna.ToUnicode(TLD); err == nil {
+			TLD = unicodeTLD
+		}
+
+		risks = append(risks, risk{TLD: TLD, Score: strconv.FormatFloat(score, 'g', -1, 64)})
+	}
+
+	if err = scanner.Err(); err != nil {
+		err = fmt.Errorf("scanner error: %w", err)
+
+		return
+	}
+
+	slices.SortFunc(risks, func(a, b risk) int { return strings.Compare(a.TLD, b.TLD) })
+
+	risks = slices.CompactFunc(risks, func(a, b risk) bool { return a.TLD == b.TLD })
+
+	return
+}
+
+// writeRisksToFile writes the risk scores of the TLDs to the specified file using a Go
+// source file template.
+func writeRisksToFile(risks []risk, output string) (err error) {
+	var buf bytes.Buffer
+
+	if err = riskTmpl.Execute(&buf, struct{ Risks []risk }{Risks: risks}); err != nil {
+		err = fmt.Errorf("failed to execute template: %w", err)
+
+		return
+	}
+
+	// Format the source, e.g. to align map values
+	source, err := format.Source(buf.Bytes())
+	if err != nil {
+		err = fmt.Errorf("failed to format source: %w", err)
+
+		return
+	}
+
+	if err = os.WriteFile(output, source, 0o644); err != nil { //nolint:gosec // Generated source files are world-readable.
+		err = fmt.Errorf("failed to write output file: %w", err)
+	}
+
+	return
+}
+
 // removeDuplicates
 // removes duplicate elements from a slice of any type that satisfies the comparable constraint.
 func removeDuplicates[T comparable](slice []T) []T {
@@ -240,3 +839,42 @@ func writeTLDsToFile(TLDs []string, output string) (err error) {
 
 	return
 }
+
+// writeMetadataToFile writes the generation metadata of the list of TLDs to the specified
+// file using a Go source file template.
+func writeMetadataToFile(output string) (err error) {
+	at, err := genutil.GeneratedAt()
+	if err != nil {
+		return
+	}
+
+	data := struct {
+		GeneratedAt time.Time
+		SourceETags map[string]string
+	}{
+		GeneratedAt: at,
+		SourceETags: sourceETags,
+	}
+
+	var buf bytes.Buffer
+
+	if err = metadataTmpl.Execute(&buf, data); err != nil {
+		err = fmt.Errorf("failed to execute template: %w", err)
+
+		return
+	}
+
+	// Format the source, e.g. to align map values
+	source, err := format.Source(buf.Bytes())
+	if err != nil {
+		err = fmt.Errorf("failed to format source: %w", err)
+
+		return
+	}
+
+	if err = os.WriteFile(output, source, 0o644); err != nil { //nolint:gosec // Generated source files are world-readable.
+		err = fmt.Errorf("failed to write output file: %w", err)
+	}
+
+	return
+}
@@ -0,0 +1,174 @@
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"flag"
+	"fmt"
+	"go/format"
+	"log"
+	"math"
+	"os"
+	"strings"
+	"text/template"
+)
+
+var (
+	// Input file path for the list of words the model is trained on.
+	input string
+	// Output file path for the generated Go source file.
+	output string
+
+	// Template for the autogenerated Go file containing the bigram model.
+	tmpl = template.Must(template.New("bigrams").Parse(`// This file is autogenerated by the bigrams generator from words.txt. Please do not edit manually.
+package domain
+
+// alphabet lists the characters of the bigram model: "^" and "$" mark the start and the
+// end of a label, and the other characters are the ones allowed in ASCII domain labels.
+const alphabet = "{{.Alphabet}}"
+
+// bigramLog2Probabilities holds, for each character of alphabet, the base 2 logarithms of
+// the probabilities of each character of alphabet following it in a word, estimated with
+// add-one smoothing over the words of words.txt.
+var bigramLog2Probabilities = [{{.Size}}][{{.Size}}]float32{
+{{- range $row := .Rows}}
+	{ {{- $row -}} },
+{{- end}}
+}
+`))
+)
+
+// alphabet lists the characters of the model, with the start ("^") and end ("$") markers.
+const alphabet = "^abcdefghijklmnopqrstuvwxyz0123456789-$"
+
+func init() {
+	flag.StringVar(&input, "input", "", "Specify the input file path for the list of words.")
+	flag.StringVar(&output, "output", "", "Specify the output file path for the generated Go source file.")
+
+	flag.Usage = func() {
+		h := "USAGE:\n"
+		h += "  bigrams [OPTIONS]\n"
+
+		h += "\nOPTIONS:\n"
+		h += " -input string     Specify the input file path for the list of words.\n"
+		h += " -output string    Specify the output file path for the generated Go source file.\n"
+
+		fmt.Fprintln(os.Stderr, h)
+	}
+
+	flag.Parse()
+}
+
+func main() {
+	if input == "" || output == "" {
+		log.Fatalln("Input and output file paths are required. Use -input and -output to specify them.")
+	}
+
+	log.Printf("Generating %s...\n", output)
+
+	counts, err := countBigrams(input)
+	if err != nil {
+		log.Fatalf("Failed to count bigrams: %v\n", err)
+	}
+
+	if err := writeModelToFile(counts, output); err != nil {
+		log.Fatalf("Failed to write model to file: %v\n", err)
+	}
+
+	log.Println("Bigrams file generated successfully.")
+}
+
+// countBigrams counts the bigrams of the words of the input file, including the bigrams
+// with the start and end markers.
+func countBigrams(input string) (counts [][]int, err error) {
+	file, err := os.Open(input)
+	if err != nil {
+		err = fmt.Errorf("failed to open input file: %w", err)
+
+		return
+	}
+
+	defer file.Close()
+
+	counts = make([][]int, len(alphabet))
+
+	for i := range counts {
+		counts[i] = make([]int, len(alphabet))
+	}
+
+	scanner := bufio.NewScanner(file)
+
+	for scanner.Scan() {
+		word := strings.ToLower(strings.TrimSpace(scanner.Text()))
+
+		if word == "" || strings.HasPrefix(word, "#") {
+			continue
+		}
+
+		word = "^" + word + "$"
+
+		for i := 1; i < len(word); i++ {
+			previous, next := strings.IndexByte(alphabet, word[i-1]), strings.IndexByte(alphabet, word[i])
+
+			if previous < 0 || next < 0 {
+				continue
+			}
+
+			counts[previous][next]++
+		}
+	}
+
+	if err = scanner.Err(); err != nil {
+		err = fmt.Errorf("scanner error: %w", err)
+	}
+
+	return
+}
+
+// writeModelToFile writes the log-probabilities estimated from counts to the output file.
+func writeModelToFile(counts [][]int, output string) (err error) {
+	rows := make([]string, len(counts))
+
+	for i, row := range counts {
+		total := len(row)
+
+		for _, count := range row {
+			total += count
+		}
+
+		values := make([]string, len(row))
+
+		for j, count := range row {
+			values[j] = fmt.Sprintf("%.3f", math.Log2(float64(count+1)/float64(total)))
+		}
+
+		rows[i] = strings.Join(values, ", ")
+	}
+
+	data := map[string]any{
+		"Alphabet": alphabet,
+		"Size":     len(alphabet),
+		"Rows":     rows,
+	}
+
+	var buf bytes.Buffer
+
+	if err = tmpl.Execute(&buf, data); err != nil {
+		err = fmt.Errorf("failed to execute template: %w", err)
+
+		return
+	}
+
+	source, err := format.Source(buf.Bytes())
+	if err != nil {
+		err = fmt.Errorf("failed to format source: %w", err)
+
+		return
+	}
+
+	if err = os.WriteFile(output, source, 0o644); err != nil { //nolint:gosec // Generated source files are world-readable.
+		err = fmt.Errorf("failed to write output file: %w", err)
+	}
+
+	return
+}
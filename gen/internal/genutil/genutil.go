@@ -0,0 +1,128 @@
+// Package genutil provides the helpers shared by the data generators: fetching sources,
+// optionally from (or into) a snapshot directory for deterministic generation, and
+// determining the generation time.
+package genutil
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"net/http"
+	"os"
+	"path"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Fetch returns the body of the source at URL and the ETag it was served with (empty if
+// none). If snapshotDir is set, the source is read from its snapshot in that directory
+// instead, a file named after the last element of URL, with the ETag in a ".etag"
+// sidecar file; if update is also set, the source is fetched and its snapshot written.
+//
+// Parameters:
+//   - URL (string): The URL of the source.
+//   - snapshotDir (string): The snapshot directory, or an empty string to always fetch.
+//   - update (bool): Whether to fetch the source and update its snapshot.
+//
+// Returns:
+//   - body ([]byte): The body of the source.
+//   - ETag (string): The ETag of the source.
+//   - err (error): An error if the source cannot be fetched, read or snapshotted.
+func Fetch(URL, snapshotDir string, update bool) (body []byte, ETag string, err error) {
+	name := filepath.Join(snapshotDir, path.Base(URL))
+
+	if snapshotDir != "" && !update {
+		if body, err = os.ReadFile(name); err != nil {
+			err = fmt.Errorf("failed to read snapshot of %s: %w", URL, err)
+
+			return
+		}
+
+		var tag []byte
+
+		tag, err = os.ReadFile(name + ".etag")
+		if err != nil && !errors.Is(err, fs.ErrNotExist) {
+			err = fmt.Errorf("failed to read ETag snapshot of %s: %w", URL, err)
+
+			return
+		}
+
+		ETag, err = strings.TrimSpace(string(tag)), nil
+
+		return
+	}
+
+	res, err := http.Get(URL)
+	if err != nil {
+		err = fmt.Errorf("failed to fetch %s: %w", URL, err)
+
+		return
+	}
+
+	defer res.Body.Close()
+
+	if res.StatusCode != http.StatusOK {
+		err = fmt.Errorf("failed to fetch %s: %s", URL, res.Status)
+
+		return
+	}
+
+	if body, err = io.ReadAll(res.Body); err != nil {
+		err = fmt.Errorf("failed to read %s: %w", URL, err)
+
+		return
+	}
+
+	ETag = res.Header.Get("ETag")
+
+	if snapshotDir == "" {
+		return
+	}
+
+	if err = os.MkdirAll(snapshotDir, 0o755); err != nil {
+		err = fmt.Errorf("failed to create snapshot directory: %w", err)
+
+		return
+	}
+
+	if err = os.WriteFile(name, body, 0o644); err != nil { //nolint:gosec // Snapshots are world-readable.
+		err = fmt.Errorf("failed to write snapshot of %s: %w", URL, err)
+
+		return
+	}
+
+	if err = os.WriteFile(name+".etag", []byte(ETag+"\n"), 0o644); err != nil { //nolint:gosec // Snapshots are world-readable.
+		err = fmt.Errorf("failed to write ETag snapshot of %s: %w", URL, err)
+	}
+
+	return
+}
+
+// GeneratedAt returns the generation time: the SOURCE_DATE_EPOCH environment variable, if
+// set, for reproducible output, or the current time.
+//
+// Returns:
+//   - t (time.Time): The generation time, in UTC.
+//   - err (error): An error if SOURCE_DATE_EPOCH is not a number of seconds.
+func GeneratedAt() (t time.Time, err error) {
+	epoch := os.Getenv("SOURCE_DATE_EPOCH")
+	if epoch == "" {
+		t = time.Now().UTC().Truncate(time.Second)
+
+		return
+	}
+
+	seconds, err := strconv.ParseInt(epoch, 10, 64)
+	if err != nil {
+		err = fmt.Errorf("invalid SOURCE_DATE_EPOCH: %w", err)
+
+		return
+	}
+
+	t = time.Unix(seconds, 0).UTC()
+
+	return
+}
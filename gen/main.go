@@ -0,0 +1,351 @@
+// Command gen regenerates the data packages (tlds, schemes, mimetypes, unicodes, shorteners
+// and the bigram model of domain) together, with consistent flags, by running their
+// generators. It must be run from the repository root, as go generate does.
+//
+// With a snapshot directory, the sources are read from snapshots instead of being fetched,
+// so that, with SOURCE_DATE_EPOCH set, the output is deterministic. With dry run, the data
+// is generated in a temporary directory and the differences with the current files are
+// printed instead of being written.
+package main
+
+import (
+	"bytes"
+	"errors"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"slices"
+	"strings"
+)
+
+var (
+	// Directory holding the data packages, i.e. the repository root.
+	outputDir string
+	// Directory holding the snapshots of the sources, read instead of fetching them.
+	snapshotDir string
+	// Whether to fetch the sources and update their snapshots.
+	updateSnapshots bool
+	// Whether to print the differences with the current files instead of writing them.
+	dryRun bool
+	// Comma-separated list of the data packages to regenerate.
+	only string
+)
+
+// generator describes the generator of a data package.
+type generator struct {
+	name      string   // The name of the data package (e.g., "tlds").
+	source    string   // The path of the generator, relative to the repository root.
+	files     []string // The generated files, relative to the output directory.
+	snapshots bool     // Whether the generator fetches sources, and takes the snapshot flags.
+	toolchain string   // The Go toolchain the generator is run with (e.g., "go1.27.1"), if pinned.
+
+	// args returns the arguments of the generator, reading its inputs from inputDir and
+	// writing its outputs to outputDir.
+	args func(inputDir, outputDir string) []string
+}
+
+// generators lists the generators of the data packages, in the order they are run.
+var generators = []generator{
+	{
+		name:   "schemes",
+		source: "./gen/schemes",
+		files: []string{
+			"schemes/schemes_official.go",
+			"schemes/schemes_official_status.go",
+			"schemes/schemes_official_metadata.go",
+			"schemes/schemes_unoficial.go",
+			"schemes/schemes_mobile.go",
+			"schemes/schemes_default_ports.go",
+		},
+		snapshots: true,
+		args: func(inputDir, outputDir string) []string {
+			return []string{
+				"-output", filepath.Join(outputDir, "schemes/schemes_official.go"),
+				"-status-output", filepath.Join(outputDir, "schemes/schemes_official_status.go"),
+				"-metadata-output", filepath.Join(outputDir, "schemes/schemes_official_metadata.go"),
+				"-unofficial-input", filepath.Join(inputDir, "schemes/schemes_unofficial.txt"),
+				"-unofficial-output", filepath.Join(outputDir, "schemes/schemes_unoficial.go"),
+				"-mobile-input", filepath.Join(inputDir, "schemes/schemes_mobile.txt"),
+				"-mobile-output", filepath.Join(outputDir, "schemes/schemes_mobile.go"),
+				"-default-ports-input", filepath.Join(inputDir, "schemes/schemes_default_ports.txt"),
+				"-default-ports-output", filepath.Join(outputDir, "schemes/schemes_default_ports.go"),
+			}
+		},
+	},
+	{
+		name:   "tlds",
+		source: "./gen/TLDs",
+		files: []string{
+			"tlds/tlds_official.go",
+			"tlds/tlds_categories.go",
+			"tlds/tlds_countries.go",
+			"tlds/tlds_risk_scores.go",
+			"tlds/tlds_official_metadata.go",
+		},
+		snapshots: true,
+		args: func(inputDir, outputDir string) []string {
+			return []string{
+				"-output", filepath.Join(outputDir, "tlds/tlds_official.go"),
+				"-categories-output", filepath.Join(outputDir, "tlds/tlds_categories.go"),
+				"-countries-output", filepath.Join(outputDir, "tlds/tlds_countries.go"),
+				"-risk-input", filepath.Join(inputDir, "tlds/tlds_risk.txt"),
+				"-risk-output", filepath.Join(outputDir, "tlds/tlds_risk_scores.go"),
+				"-metadata-output", filepath.Join(outputDir, "tlds/tlds_official_metadata.go"),
+			}
+		},
+	},
+	{
+		name:   "mimetypes",
+		source: "./gen/mimetypes",
+		files: []string{
+			"mimetypes/mimetypes_list.go",
+		},
+		snapshots: true,
+		args: func(_, outputDir string) []string {
+			return []string{
+				"-output", filepath.Join(outputDir, "mimetypes/mimetypes_list.go"),
+			}
+		},
+	},
+	{
+		name:   "unicodes",
+		source: "./gen/unicodes",
+		// The sets are generated from the Unicode tables of the unicode package, so the
+		// toolchain is pinned to the one with the pinned Unicode version.
+		toolchain: "go1.27.1",
+		files: []string{
+			"unicodes/unicodes.go",
+			"unicodes/unicodes_scripts.go",
+			"unicodes/unicodes_metadata.go",
+		},
+		args: func(_, outputDir string) []string {
+			return []string{
+				"-output", filepath.Join(outputDir, "unicodes/unicodes.go"),
+				"-scripts-output", filepath.Join(outputDir, "unicodes/unicodes_scripts.go"),
+				"-metadata-output", filepath.Join(outputDir, "unicodes/unicodes_metadata.go"),
+				"-unicode-version", "17.0.0",
+			}
+		},
+	},
+	{
+		name:   "shorteners",
+		source: "./gen/shorteners",
+		files: []string{
+			"shorteners/shorteners_list.go",
+		},
+		args: func(inputDir, outputDir string) []string {
+			return []string{
+				"-input", filepath.Join(inputDir, "shorteners/shorteners.txt"),
+				"-output", filepath.Join(outputDir, "shorteners/shorteners_list.go"),
+			}
+		},
+	},
+	{
+		name:   "bigrams",
+		source: "./gen/bigrams",
+		files: []string{
+			"domain/bigrams.go",
+		},
+		args: func(inputDir, outputDir string) []string {
+			return []string{
+				"-input", filepath.Join(inputDir, "domain/words.txt"),
+				"-output", filepath.Join(outputDir, "domain/bigrams.go"),
+			}
+		},
+	},
+}
+
+func init() {
+	// Define the command-line flags
+	flag.StringVar(&outputDir, "output-dir", ".", "Specify the directory holding the data packages, i.e. the repository root.")
+	flag.StringVar(&snapshotDir, "snapshot-dir", "", "Specify the directory holding the snapshots of the sources, read instead of fetching them.")
+	flag.BoolVar(&updateSnapshots, "update-snapshots", false, "Fetch the sources and update their snapshots in the snapshot directory.")
+	flag.BoolVar(&dryRun, "dry-run", false, "Print the differences with the current files instead of writing them.")
+	flag.StringVar(&only, "only", "", "Specify a comma-separated list of the data packages to regenerate (schemes, tlds, mimetypes, unicodes, shorteners, bigrams).")
+
+	// Custom usage message for the command-line flags
+	flag.Usage = func() {
+		h := "USAGE:\n"
+		h += "  gen [OPTIONS]\n"
+
+		h += "\nOPTIONS:\n"
+		h += " -output-dir string      Specify the directory holding the data packages, i.e. the repository root (default \".\").\n"
+		h += " -snapshot-dir string    Specify the directory holding the snapshots of the sources, read instead of fetching them.\n"
+		h += " -update-snapshots       Fetch the sources and update their snapshots in the snapshot directory.\n"
+		h += " -dry-run                Print the differences with the current files instead of writing them.\n"
+		h += " -only string            Specify a comma-separated list of the data packages to regenerate (schemes, tlds, mimetypes, unicodes, shorteners, bigrams).\n"
+
+		fmt.Fprintln(os.Stderr, h)
+	}
+
+	// Parse command-line flags
+	flag.Parse()
+}
+
+func main() {
+	if updateSnapshots && snapshotDir == "" {
+		log.Fatalln("Snapshot directory is required. Use -snapshot-dir to specify the snapshot directory.")
+	}
+
+	selected, err := selectGenerators(only)
+	if err != nil {
+		log.Fatalln(err)
+	}
+
+	if err := generate(selected); err != nil {
+		log.Fatalln(err)
+	}
+}
+
+// generate runs the selected generators, writing their outputs to the output directory,
+// or, with dry run, to a temporary directory, and prints the differences.
+func generate(selected []generator) (err error) {
+	target := outputDir
+
+	if dryRun {
+		if target, err = os.MkdirTemp("", "gen"); err != nil {
+			err = fmt.Errorf("failed to create temporary directory: %w", err)
+
+			return
+		}
+
+		defer os.RemoveAll(target)
+	}
+
+	for _, g := range selected {
+		if err = run(g, target); err != nil {
+			err = fmt.Errorf("failed to generate %s: %w", g.name, err)
+
+			return
+		}
+	}
+
+	if !dryRun {
+		log.Println("Data packages generated successfully.")
+
+		return
+	}
+
+	changed := 0
+
+	for _, g := range selected {
+		for _, file := range g.files {
+			var different bool
+
+			different, err = printDiff(filepath.Join(outputDir, file), filepath.Join(target, file))
+			if err != nil {
+				err = fmt.Errorf("failed to compare %s: %w", file, err)
+
+				return
+			}
+
+			if different {
+				changed++
+			}
+		}
+	}
+
+	log.Printf("%d file(s) would change.\n", changed)
+
+	return
+}
+
+// selectGenerators returns the generators of the data packages listed in list, or all of
+// them if list is empty.
+func selectGenerators(list string) (selected []generator, err error) {
+	if list == "" {
+		selected = generators
+
+		return
+	}
+
+	names := strings.Split(list, ",")
+
+	for i, name := range names {
+		names[i] = strings.TrimSpace(name)
+
+		if !slices.ContainsFunc(generators, func(g generator) bool { return g.name == names[i] }) {
+			err = fmt.Errorf("unknown data package %q", names[i])
+
+			return
+		}
+	}
+
+	for _, g := range generators {
+		if slices.Contains(names, g.name) {
+			selected = append(selected, g)
+		}
+	}
+
+	return
+}
+
+// run runs the generator g, writing its outputs to the target directory.
+func run(g generator, target string) (err error) {
+	for _, file := range g.files {
+		if err = os.MkdirAll(filepath.Join(target, filepath.Dir(file)), 0o755); err != nil {
+			return
+		}
+	}
+
+	args := append([]string{"run", g.source}, g.args(outputDir, target)...)
+
+	if g.snapshots && snapshotDir != "" {
+		args = append(args, "-snapshot-dir", snapshotDir)
+
+		if updateSnapshots {
+			args = append(args, "-update-snapshot")
+		}
+	}
+
+	cmd := exec.Command("go", args...)
+
+	if g.toolchain != "" {
+		cmd.Env = append(os.Environ(), "GOTOOLCHAIN="+g.toolchain)
+	}
+
+	cmd.Stdout = os.Stderr
+	cmd.Stderr = os.Stderr
+
+	err = cmd.Run()
+
+	return
+}
+
+// printDiff prints the differences between the current file (possibly missing) and the
+// generated one, in unified format if the diff command is available.
+func printDiff(current, generated string) (different bool, err error) {
+	before, err := os.ReadFile(current)
+	if err != nil && !errors.Is(err, os.ErrNotExist) {
+		return
+	}
+
+	after, err := os.ReadFile(generated)
+	if err != nil {
+		return
+	}
+
+	if different = !bytes.Equal(before, after); !different {
+		return
+	}
+
+	cmd := exec.Command("diff", "-u", "-N", current, generated)
+
+	cmd.Stdout = os.Stdout
+
+	var exitErr *exec.ExitError
+
+	// diff exits with status 1 when the files differ.
+	if err = cmd.Run(); errors.As(err, &exitErr) && exitErr.ExitCode() == 1 {
+		err = nil
+	} else if errors.Is(err, exec.ErrNotFound) {
+		fmt.Printf("%s would change\n", current)
+
+		err = nil
+	}
+
+	return
+}
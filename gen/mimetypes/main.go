@@ -0,0 +1,179 @@
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"flag"
+	"fmt"
+	"go/format"
+	"log"
+	"os"
+	"sort"
+	"strings"
+	"text/template"
+
+	"go.source.hueristiq.com/url/gen/internal/genutil"
+)
+
+// mediaTypesURL is the URL of the media types table of the media-types Debian package,
+// mapping media types to the file extensions that represent them.
+const mediaTypesURL = "https://salsa.debian.org/debian/media-types/-/raw/master/mime.types"
+
+var (
+	// Output file path for the generated Go source file.
+	output string
+	// Directory holding the snapshots of the sources, read instead of fetching them.
+	snapshotDir string
+	// Whether to fetch the sources and update their snapshots.
+	updateSnapshot bool
+
+	// overrides maps the extensions whose media type in the table is outdated or ambiguous
+	// for web content to the type served and expected by browsers.
+	overrides = map[string]string{
+		"js":          "text/javascript",
+		"mjs":         "text/javascript",
+		"map":         "application/json",
+		"webmanifest": "application/manifest+json",
+		"woff":        "font/woff",
+		"woff2":       "font/woff2",
+		"ttf":         "font/ttf",
+		"otf":         "font/otf",
+		"wasm":        "application/wasm",
+	}
+
+	// Template for the autogenerated Go file containing the media types of the extensions.
+	tmpl = template.Must(template.New("mimetypes").Parse(`// This file is autogenerated by the mimetypes generator. Please do not edit manually.
+package mimetypes
+
+// types maps file extensions (lowercase, with leading dot) to their media type, as listed in:
+//   - https://salsa.debian.org/debian/media-types/-/raw/master/mime.types
+var types = map[string]string{
+{{- range .Types}}
+	"{{.Extension}}": "{{.Type}}",
+{{- end}}
+}
+`))
+)
+
+// mediaType is a file extension, with leading dot, with its media type.
+type mediaType struct {
+	Extension string
+	Type      string
+}
+
+func init() {
+	flag.StringVar(&output, "output", "", "Specify the output file path for the generated Go source file.")
+	flag.StringVar(&snapshotDir, "snapshot-dir", "", "Specify the directory holding the snapshots of the sources, read instead of fetching them.")
+	flag.BoolVar(&updateSnapshot, "update-snapshot", false, "Fetch the sources and update their snapshots in the snapshot directory.")
+
+	flag.Usage = func() {
+		h := "USAGE:\n"
+		h += "  mimetypes [OPTIONS]\n"
+
+		h += "\nOPTIONS:\n"
+		h += " -output string          Specify the output file path for the generated Go source file.\n"
+		h += " -snapshot-dir string    Specify the directory holding the snapshots of the sources, read instead of fetching them.\n"
+		h += " -update-snapshot        Fetch the sources and update their snapshots in the snapshot directory.\n"
+
+		fmt.Fprintln(os.Stderr, h)
+	}
+
+	flag.Parse()
+}
+
+func main() {
+	if output == "" {
+		log.Fatalln("Output file path is required. Use -output to specify the output file path.")
+	}
+
+	log.Printf("Generating %s...\n", output)
+
+	types, err := getMediaTypes()
+	if err != nil {
+		log.Fatalf("Failed to get media types: %v\n", err)
+	}
+
+	if err := writeMediaTypesToFile(types, output); err != nil {
+		log.Fatalf("Failed to write media types to file: %v\n", err)
+	}
+
+	log.Println("Media types file generated successfully.")
+}
+
+// getMediaTypes fetches the media types table and returns the media types of the
+// extensions, sorted by extension. An extension listed for several types keeps the first
+// one, unless overridden.
+func getMediaTypes() (types []mediaType, err error) {
+	data, _, err := genutil.Fetch(mediaTypesURL, snapshotDir, updateSnapshot)
+	if err != nil {
+		err = fmt.Errorf("failed to fetch media types: %w", err)
+
+		return
+	}
+
+	byExtension := map[string]string{}
+
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+
+		fields := strings.Fields(line)
+
+		for _, extension := range fields[1:] {
+			extension = strings.ToLower(extension)
+
+			if _, ok := byExtension[extension]; !ok {
+				byExtension[extension] = strings.ToLower(fields[0])
+			}
+		}
+	}
+
+	if err = scanner.Err(); err != nil {
+		err = fmt.Errorf("scanner error: %w", err)
+
+		return
+	}
+
+	for extension, override := range overrides {
+		byExtension[extension] = override
+	}
+
+	for extension, typ := range byExtension {
+		types = append(types, mediaType{Extension: "." + extension, Type: typ})
+	}
+
+	sort.Slice(types, func(i, j int) bool { return types[i].Extension < types[j].Extension })
+
+	return
+}
+
+// writeMediaTypesToFile writes the media types of the extensions to the specified file
+// using a Go source file template.
+func writeMediaTypesToFile(types []mediaType, output string) (err error) {
+	var buf bytes.Buffer
+
+	if err = tmpl.Execute(&buf, struct{ Types []mediaType }{Types: types}); err != nil {
+		err = fmt.Errorf("failed to execute template: %w", err)
+
+		return
+	}
+
+	// Format the source, e.g. to align map values
+	source, err := format.Source(buf.Bytes())
+	if err != nil {
+		err = fmt.Errorf("failed to format source: %w", err)
+
+		return
+	}
+
+	if err = os.WriteFile(output, source, 0o644); err != nil { //nolint:gosec // Generated source files are world-readable.
+		err = fmt.Errorf("failed to write output file: %w", err)
+	}
+
+	return
+}
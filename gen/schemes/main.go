@@ -1,24 +1,51 @@
 package main
 
 import (
+	"bufio"
+	"bytes"
 	"encoding/csv"
 	"errors"
 	"flag"
 	"fmt"
+	"go/format"
 	"io"
 	"log"
-	"net/http"
 	"os"
+	"slices"
+	"strconv"
 	"strings"
 	"text/template"
+	"time"
+
+	"go.source.hueristiq.com/url/gen/internal/genutil"
 )
 
 var (
 	// Output file path for the generated Go source file.
 	output string
+	// Output file path for the generated Go source file with the statuses of the schemes.
+	statusOutput string
+	// Output file path for the generated Go source file with the generation metadata of the IANA-assigned schemes.
+	metadataOutput string
+	// Directory holding the snapshot of the IANA registry, read instead of fetching it.
+	snapshotDir string
+	// Whether to fetch the IANA registry and update its snapshot.
+	updateSnapshot bool
+	// Input file path for the curated list of unofficial schemes.
+	unofficialInput string
+	// Output file path for the generated Go source file with the unofficial schemes.
+	unofficialOutput string
+	// Input file path for the curated list of mobile app deep-link schemes.
+	mobileInput string
+	// Output file path for the generated Go source file with the mobile app deep-link schemes.
+	mobileOutput string
+	// Input file path for the curated list of the default ports of well-known schemes.
+	defaultPortsInput string
+	// Output file path for the generated Go source file with the default ports of well-known schemes.
+	defaultPortsOutput string
 
 	// Template for the autogenerated Go file containing the list of schemes.
-	schemesTmpl = template.Must(template.New("schemes").Parse(`// This file is autogenerated by the schemes generator. Please do not edit manually.
+	schemesTmpl = template.Must(template.New("schemes").Funcs(template.FuncMap{"ToLower": strings.ToLower, "IsWebSocket": isWebSocket}).Parse(`// This file is autogenerated by the schemes generator. Please do not edit manually.
 package schemes
 
 // Official is a sorted list of all IANA-assigned URL schemes.
@@ -33,15 +60,155 @@ package schemes
 // It is used to verify or process URL schemes in various applications.
 var Official = []string{
 {{- range $scheme := .Schemes}}
-	"{{$scheme}}",
+	"{{$scheme.Name}}",
+{{- end}}
+}
+
+// officialSet is the set of the schemes in Official, lowercased, for constant-time lookups.
+var officialSet = map[string]struct{}{
+{{- range $scheme := .Schemes}}
+	"{{ToLower $scheme.Name}}": {},
+{{- end}}
+}
+
+// WebSocket is a sorted list of the IANA-assigned URL schemes of WebSocket endpoints:
+// "ws" and "wss" (RFC 6455), and the schemes of protocols carried over WebSockets, which
+// end in "+ws" or "+wss" (e.g., "coap+ws", RFC 8323).
+var WebSocket = []string{
+{{- range $scheme := .Schemes}}
+{{- if IsWebSocket $scheme.Name}}
+	"{{$scheme.Name}}",
+{{- end}}
+{{- end}}
+}
+`))
+
+	// Template for the autogenerated Go file containing the registration statuses of the schemes.
+	statusTmpl = template.Must(template.New("status").Funcs(template.FuncMap{"ToLower": strings.ToLower}).Parse(`// This file is autogenerated by the schemes generator. Please do not edit manually.
+package schemes
+
+// officialStatuses maps each IANA-assigned URL scheme in Official, lowercased, to its
+// registration status ("Permanent", "Provisional" or "Historical") in the IANA registry:
+//   - https://www.iana.org/assignments/uri-schemes/uri-schemes-1.csv
+var officialStatuses = map[string]Status{
+{{- range $scheme := .Schemes}}
+	"{{ToLower $scheme.Name}}": "{{$scheme.Status}}",
+{{- end}}
+}
+`))
+
+	// Template for the autogenerated Go file containing the generation metadata of the IANA-assigned schemes.
+	metadataTmpl = template.Must(template.New("metadata").Parse(`// This file is autogenerated by the schemes generator. Please do not edit manually.
+package schemes
+
+import "time"
+
+// init sets the generation metadata of Official: the time it was generated at, and the
+// ETags its sources were served with.
+func init() {
+	GeneratedAt = time.Unix({{.GeneratedAt.Unix}}, 0).UTC()
+
+	SourceETags = map[string]string{
+{{- range $URL, $ETag := .SourceETags}}
+		"{{$URL}}": {{printf "%q" $ETag}},
+{{- end}}
+	}
+}
+`))
+
+	// Template for the autogenerated Go file containing the list of unofficial schemes.
+	unofficialTmpl = template.Must(template.New("unofficial").Parse(`// This file is autogenerated by the schemes generator from schemes_unofficial.txt.
+// Please do not edit manually; edit schemes_unofficial.txt instead.
+package schemes
+
+// Unofficial is a sorted list of some well-known URL schemes that are not yet officially registered.
+// These schemes are commonly associated with specific software or services, and while they may not be
+// part of the official URI scheme registry, they are widely recognized and used.
+//
+// This list primarily gathers schemes from:
+//   - https://en.wikipedia.org/wiki/List_of_URI_schemes#Unofficial_but_common_URI_schemes
+//
+// The schemes in this list are useful in specific contexts, such as handling custom protocols for
+// software applications or services. Applications can register additional schemes at runtime
+// with RegisterUnofficial.
+var Unofficial = []string{
+{{- range $scheme := .Schemes}}
+	` + "`{{$scheme.Name}}`" + `, // {{$scheme.Description}}
+{{- end}}
+}
+
+// unofficialSet is the set of the schemes in Unofficial, for constant-time lookups.
+var unofficialSet = map[string]struct{}{
+{{- range $scheme := .Schemes}}
+	` + "`{{$scheme.Name}}`" + `: {},
+{{- end}}
+}
+`))
+
+	// Template for the autogenerated Go file containing the list of mobile app deep-link schemes.
+	mobileTmpl = template.Must(template.New("mobile").Parse(`// This file is autogenerated by the schemes generator from schemes_mobile.txt.
+// Please do not edit manually; edit schemes_mobile.txt instead.
+package schemes
+
+// Mobile is a sorted list of the deep-link schemes of popular Android and iOS apps (e.g.,
+// "intent", "fb", "whatsapp"), which launch an app, or a specific screen of it, from a
+// link. Some of them are also official or unofficial schemes.
+//
+// The schemes in this list are useful when mining APK and IPA string dumps for the entry
+// points of mobile apps.
+var Mobile = []string{
+{{- range $scheme := .Schemes}}
+	` + "`{{$scheme.Name}}`" + `, // {{$scheme.Description}}
+{{- end}}
+}
+
+// mobileSet is the set of the schemes in Mobile, for constant-time lookups.
+var mobileSet = map[string]struct{}{
+{{- range $scheme := .Schemes}}
+	` + "`{{$scheme.Name}}`" + `: {},
+{{- end}}
+}
+`))
+
+	// Template for the autogenerated Go file containing the default ports of well-known schemes.
+	defaultPortsTmpl = template.Must(template.New("defaultPorts").Parse(`// This file is autogenerated by the schemes generator from schemes_default_ports.txt.
+// Please do not edit manually; edit schemes_default_ports.txt instead.
+package schemes
+
+// DefaultPorts maps well-known URL schemes to the port used when a URL with that scheme
+// does not specify one (e.g., 443 for "https"). Keys are lowercase.
+//
+// The ports are the ones assigned by IANA to the protocol each scheme refers to.
+var DefaultPorts = map[string]int{
+{{- range $scheme := .Schemes}}
+	` + "`{{$scheme.Name}}`" + `: {{$scheme.Port}}, // {{$scheme.Description}}
 {{- end}}
 }
 `))
 )
 
+// scheme is a URL scheme, with its registration status (for IANA-assigned schemes),
+// description (for curated schemes) or default port.
+type scheme struct {
+	Name        string
+	Status      string
+	Description string
+	Port        int
+}
+
 func init() {
 	// Define the command-line flag for output file path
 	flag.StringVar(&output, "output", "", "Specify the output file path for the generated Go source file.")
+	flag.StringVar(&statusOutput, "status-output", "", "Specify the output file path for the generated Go source file with scheme statuses.")
+	flag.StringVar(&metadataOutput, "metadata-output", "", "Specify the output file path for the generated Go source file with generation metadata.")
+	flag.StringVar(&snapshotDir, "snapshot-dir", "", "Specify the directory holding the snapshot of the IANA registry, read instead of fetching it.")
+	flag.BoolVar(&updateSnapshot, "update-snapshot", false, "Fetch the IANA registry and update its snapshot in the snapshot directory.")
+	flag.StringVar(&unofficialInput, "unofficial-input", "", "Specify the input file path for the curated list of unofficial schemes.")
+	flag.StringVar(&unofficialOutput, "unofficial-output", "", "Specify the output file path for the generated Go source file with unofficial schemes.")
+	flag.StringVar(&mobileInput, "mobile-input", "", "Specify the input file path for the curated list of mobile app deep-link schemes.")
+	flag.StringVar(&mobileOutput, "mobile-output", "", "Specify the output file path for the generated Go source file with mobile app deep-link schemes.")
+	flag.StringVar(&defaultPortsInput, "default-ports-input", "", "Specify the input file path for the curated list of default ports of well-known schemes.")
+	flag.StringVar(&defaultPortsOutput, "default-ports-output", "", "Specify the output file path for the generated Go source file with default ports of well-known schemes.")
 
 	// Custom usage message for the command-line flag
 	flag.Usage = func() {
@@ -49,7 +216,17 @@ func init() {
 		h += "  schemes [OPTIONS]\n"
 
 		h += "\nOPTIONS:\n"
-		h += " -output string    Specify the output file path for the generated Go source file.\n"
+		h += " -output string               Specify the output file path for the generated Go source file.\n"
+		h += " -status-output string        Specify the output file path for the generated Go source file with scheme statuses.\n"
+		h += " -metadata-output string      Specify the output file path for the generated Go source file with generation metadata.\n"
+		h += " -snapshot-dir string         Specify the directory holding the snapshot of the IANA registry, read instead of fetching it.\n"
+		h += " -update-snapshot             Fetch the IANA registry and update its snapshot in the snapshot directory.\n"
+		h += " -unofficial-input string     Specify the input file path for the curated list of unofficial schemes.\n"
+		h += " -unofficial-output string    Specify the output file path for the generated Go source file with unofficial schemes.\n"
+		h += " -mobile-input string         Specify the input file path for the curated list of mobile app deep-link schemes.\n"
+		h += " -mobile-output string        Specify the output file path for the generated Go source file with mobile app deep-link schemes.\n"
+		h += " -default-ports-input string  Specify the input file path for the curated list of default ports of well-known schemes.\n"
+		h += " -default-ports-output string Specify the output file path for the generated Go source file with default ports of well-known schemes.\n"
 
 		fmt.Fprintln(os.Stderr, h)
 	}
@@ -60,53 +237,156 @@ func init() {
 
 func main() {
 	// Ensure that an output file path is specified
-	if output == "" {
-		log.Fatalln("Output file path is required. Use -output to specify the output file path.")
+	if output == "" && unofficialOutput == "" && mobileOutput == "" && defaultPortsOutput == "" {
+		log.Fatalln("Output file path is required. Use -output, -unofficial-output, -mobile-output or -default-ports-output to specify the output file path.")
+	}
+
+	if output != "" {
+		generateOfficial()
 	}
 
+	if unofficialOutput != "" {
+		generateUnofficial()
+	}
+
+	if mobileOutput != "" {
+		generateMobile()
+	}
+
+	if defaultPortsOutput != "" {
+		generateDefaultPorts()
+	}
+}
+
+// generateOfficial generates the list of IANA-assigned schemes and, if requested, their statuses.
+func generateOfficial() {
 	log.Printf("Generating %s...\n", output)
 
 	// Fetch and generate the list of URI schemes
-	schemes, err := fetchSchemesList()
+	schemes, ETag, err := fetchSchemesList()
 	if err != nil {
 		log.Fatalf("Failed to fetch schemes: %v\n", err)
 	}
 
 	// Write the schemes to the output file
-	if err := writeSchemesToFile(schemes, output); err != nil {
+	if err := writeSchemesToFile(schemesTmpl, schemes, output); err != nil {
 		log.Fatalf("Failed to write schemes to file: %v\n", err)
 	}
 
+	// Write the statuses of the schemes to the status output file, if requested
+	if statusOutput != "" {
+		log.Printf("Generating %s...\n", statusOutput)
+
+		if err := writeSchemesToFile(statusTmpl, schemes, statusOutput); err != nil {
+			log.Fatalf("Failed to write scheme statuses to file: %v\n", err)
+		}
+	}
+
+	// Write the generation metadata to the metadata output file, if requested
+	if metadataOutput != "" {
+		log.Printf("Generating %s...\n", metadataOutput)
+
+		if err := writeMetadataToFile(map[string]string{schemesSourcesURL: ETag}, metadataOutput); err != nil {
+			log.Fatalf("Failed to write metadata to file: %v\n", err)
+		}
+	}
+
 	log.Println("Schemes file generated successfully.")
 }
 
-// fetchSchemesList fetches the list of URI schemes from the IANA CSV file
-// and returns a slice of valid scheme names.
-func fetchSchemesList() (schemes []string, err error) {
-	// Perform HTTP GET request to fetch the CSV file
-	schemesSourcesURL := "https://www.iana.org/assignments/uri-schemes/uri-schemes-1.csv"
+// generateUnofficial generates the list of unofficial schemes from the curated input file.
+func generateUnofficial() {
+	if unofficialInput == "" {
+		log.Fatalln("Input file path is required. Use -unofficial-input to specify the input file path.")
+	}
+
+	log.Printf("Generating %s...\n", unofficialOutput)
+
+	schemes, err := readCuratedSchemes(unofficialInput)
+	if err != nil {
+		log.Fatalf("Failed to read unofficial schemes: %v\n", err)
+	}
+
+	if err := writeSchemesToFile(unofficialTmpl, schemes, unofficialOutput); err != nil {
+		log.Fatalf("Failed to write unofficial schemes to file: %v\n", err)
+	}
+
+	log.Println("Unofficial schemes file generated successfully.")
+}
+
+// generateMobile generates the list of mobile app deep-link schemes from the curated input file.
+func generateMobile() {
+	if mobileInput == "" {
+		log.Fatalln("Input file path is required. Use -mobile-input to specify the input file path.")
+	}
 
-	var res *http.Response
+	log.Printf("Generating %s...\n", mobileOutput)
 
-	res, err = http.Get(schemesSourcesURL)
+	schemes, err := readCuratedSchemes(mobileInput)
+	if err != nil {
+		log.Fatalf("Failed to read mobile schemes: %v\n", err)
+	}
+
+	if err := writeSchemesToFile(mobileTmpl, schemes, mobileOutput); err != nil {
+		log.Fatalf("Failed to write mobile schemes to file: %v\n", err)
+	}
+
+	log.Println("Mobile schemes file generated successfully.")
+}
+
+// generateDefaultPorts generates the default ports of well-known schemes from the curated input file.
+func generateDefaultPorts() {
+	if defaultPortsInput == "" {
+		log.Fatalln("Input file path is required. Use -default-ports-input to specify the input file path.")
+	}
+
+	log.Printf("Generating %s...\n", defaultPortsOutput)
+
+	schemes, err := readDefaultPorts(defaultPortsInput)
+	if err != nil {
+		log.Fatalf("Failed to read default ports: %v\n", err)
+	}
+
+	if err := writeSchemesToFile(defaultPortsTmpl, schemes, defaultPortsOutput); err != nil {
+		log.Fatalf("Failed to write default ports to file: %v\n", err)
+	}
+
+	log.Println("Default ports file generated successfully.")
+}
+
+// schemesSourcesURL is the URL of the IANA CSV file listing the URI schemes.
+const schemesSourcesURL = "https://www.iana.org/assignments/uri-schemes/uri-schemes-1.csv"
+
+// fetchSchemesList fetches the list of URI schemes from the IANA CSV file
+// and returns a slice of valid schemes with their registration statuses, and the ETag
+// the file was served with.
+func fetchSchemesList() (schemes []scheme, ETag string, err error) {
+	// Fetch the CSV file (or read its snapshot)
+	body, ETag, err := genutil.Fetch(schemesSourcesURL, snapshotDir, updateSnapshot)
 	if err != nil {
 		err = fmt.Errorf("failed to fetch the schemes CSV: %w", err)
 
 		return
 	}
 
-	defer res.Body.Close()
+	// Create a new CSV reader for parsing the body
+	reader := csv.NewReader(bytes.NewReader(body))
 
-	// Create a new CSV reader for parsing the response body
-	reader := csv.NewReader(res.Body)
-
-	// Skip the CSV header row
-	if _, err = reader.Read(); err != nil {
+	// Read the CSV header row to locate the status column
+	header, err := reader.Read()
+	if err != nil {
 		err = fmt.Errorf("failed to read CSV header: %w", err)
 
 		return
 	}
 
+	statusColumn := slices.Index(header, "Status")
+	if statusColumn < 0 {
+		err = errors.New("failed to find the Status column in CSV header")
+
+		return
+	}
+
 	for {
 		var record []string
 
@@ -131,34 +411,200 @@ func fetchSchemesList() (schemes []string, err error) {
 		}
 
 		// Append valid scheme to the list
-		schemes = append(schemes, record[0])
+		schemes = append(schemes, scheme{
+			Name:   record[0],
+			Status: record[statusColumn],
+		})
 	}
 
 	return
 }
 
-// writeSchemesToFile writes the generated list of URI schemes to the specified file
-// using a Go source file template.
-func writeSchemesToFile(schemes []string, output string) (err error) {
-	// Create the output file
-	file, err := os.Create(output)
+// readCuratedSchemes reads a curated list of schemes (e.g., the unofficial ones). Each non-empty line
+// holds a scheme, optionally followed by "#" and a description; lines starting with "#"
+// are comments. The schemes are returned sorted and deduplicated.
+func readCuratedSchemes(input string) (schemes []scheme, err error) {
+	file, err := os.Open(input)
+	if err != nil {
+		err = fmt.Errorf("failed to open input file: %w", err)
+
+		return
+	}
+
+	defer file.Close()
+
+	scanner := bufio.NewScanner(file)
+
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+
+		name, description, _ := strings.Cut(line, "#")
+
+		schemes = append(schemes, scheme{
+			Name:        strings.ToLower(strings.TrimSpace(name)),
+			Description: strings.TrimSpace(description),
+		})
+	}
+
+	if err = scanner.Err(); err != nil {
+		err = fmt.Errorf("scanner error: %w", err)
+
+		return
+	}
+
+	slices.SortFunc(schemes, func(a, b scheme) int { return strings.Compare(a.Name, b.Name) })
+
+	schemes = slices.CompactFunc(schemes, func(a, b scheme) bool { return a.Name == b.Name })
+
+	return
+}
+
+// readDefaultPorts reads a curated list of default ports, one "scheme port" pair per line,
+// optionally followed by "#" and a description, skipping blank lines and comments. The
+// schemes are lowercased and sorted; a scheme listed twice is an error.
+func readDefaultPorts(input string) (schemes []scheme, err error) {
+	file, err := os.Open(input)
 	if err != nil {
-		err = fmt.Errorf("failed to create output file: %w", err)
+		err = fmt.Errorf("failed to open input file: %w", err)
 
 		return
 	}
 
 	defer file.Close()
 
-	// Execute the template and write to the output file
+	scanner := bufio.NewScanner(file)
+
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+
+		entry, description, _ := strings.Cut(line, "#")
+
+		fields := strings.Fields(entry)
+		if len(fields) != 2 {
+			err = fmt.Errorf("invalid line %q: expected a scheme and a port", line)
+
+			return
+		}
+
+		var port int
+
+		port, err = strconv.Atoi(fields[1])
+		if err != nil || port < 1 || port > 65535 {
+			err = fmt.Errorf("invalid port in line %q", line)
+
+			return
+		}
+
+		schemes = append(schemes, scheme{
+			Name:        strings.ToLower(fields[0]),
+			Description: strings.TrimSpace(description),
+			Port:        port,
+		})
+	}
+
+	if err = scanner.Err(); err != nil {
+		err = fmt.Errorf("scanner error: %w", err)
+
+		return
+	}
+
+	slices.SortFunc(schemes, func(a, b scheme) int { return strings.Compare(a.Name, b.Name) })
+
+	for i := 1; i < len(schemes); i++ {
+		if schemes[i].Name == schemes[i-1].Name {
+			err = fmt.Errorf("duplicate scheme %q", schemes[i].Name)
+
+			return
+		}
+	}
+
+	return
+}
+
+// isWebSocket reports whether name is the scheme of a WebSocket endpoint, i.e. "ws",
+// "wss", or a scheme of a protocol carried over WebSockets (e.g., "coap+ws").
+func isWebSocket(name string) bool {
+	name = strings.ToLower(name)
+
+	return name == "ws" || name == "wss" || strings.HasSuffix(name, "+ws") || strings.HasSuffix(name, "+wss")
+}
+
+// writeSchemesToFile writes the generated list of URI schemes to the specified file
+// using the given Go source file template.
+func writeSchemesToFile(tmpl *template.Template, schemes []scheme, output string) (err error) {
+	// Execute the template
 	data := struct {
-		Schemes []string
+		Schemes []scheme
 	}{
 		Schemes: schemes,
 	}
 
-	if err := schemesTmpl.Execute(file, data); err != nil {
-		return fmt.Errorf("failed to execute template: %w", err)
+	var buf bytes.Buffer
+
+	if err = tmpl.Execute(&buf, data); err != nil {
+		err = fmt.Errorf("failed to execute template: %w", err)
+
+		return
+	}
+
+	// Format the source, e.g. to align trailing comments
+	source, err := format.Source(buf.Bytes())
+	if err != nil {
+		err = fmt.Errorf("failed to format source: %w", err)
+
+		return
+	}
+
+	// Write to the output file
+	if err = os.WriteFile(output, source, 0o644); err != nil { //nolint:gosec // Generated source files are world-readable.
+		err = fmt.Errorf("failed to write output file: %w", err)
+	}
+
+	return
+}
+
+// writeMetadataToFile writes the generation metadata of the list of IANA-assigned schemes,
+// fetched from the sources with the given ETags, to the specified file.
+func writeMetadataToFile(sourceETags map[string]string, output string) (err error) {
+	at, err := genutil.GeneratedAt()
+	if err != nil {
+		return
+	}
+
+	data := struct {
+		GeneratedAt time.Time
+		SourceETags map[string]string
+	}{
+		GeneratedAt: at,
+		SourceETags: sourceETags,
+	}
+
+	var buf bytes.Buffer
+
+	if err = metadataTmpl.Execute(&buf, data); err != nil {
+		err = fmt.Errorf("failed to execute template: %w", err)
+
+		return
+	}
+
+	// Format the source, e.g. to align map values
+	source, err := format.Source(buf.Bytes())
+	if err != nil {
+		err = fmt.Errorf("failed to format source: %w", err)
+
+		return
+	}
+
+	if err = os.WriteFile(output, source, 0o644); err != nil { //nolint:gosec // Generated source files are world-readable.
+		err = fmt.Errorf("failed to write output file: %w", err)
 	}
 
 	return
@@ -0,0 +1,160 @@
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"flag"
+	"fmt"
+	"go/format"
+	"log"
+	"os"
+	"slices"
+	"strings"
+	"text/template"
+)
+
+var (
+	// Input file path for the curated list of shortener domains.
+	input string
+	// Output file path for the generated Go source file.
+	output string
+
+	// Template for the autogenerated Go file containing the list of shortener domains.
+	tmpl = template.Must(template.New("shorteners").Parse(`// This file is autogenerated by the shorteners generator from shorteners.txt.
+// Please do not edit manually; edit shorteners.txt instead.
+package shorteners
+
+// Domains is a sorted list of the domains of well-known URL shorteners. Subdomains of
+// these domains are shorteners too. Applications can register additional domains at
+// runtime with Register.
+var Domains = []string{
+{{- range $shortener := .Shorteners}}
+	` + "`{{$shortener.Domain}}`" + `, // {{$shortener.Description}}
+{{- end}}
+}
+
+// domainSet is the set of the domains in Domains, for constant-time lookups.
+var domainSet = map[string]struct{}{
+{{- range $shortener := .Shorteners}}
+	` + "`{{$shortener.Domain}}`" + `: {},
+{{- end}}
+}
+`))
+)
+
+// shortener is a URL shortener domain, with its description.
+type shortener struct {
+	Domain      string
+	Description string
+}
+
+func init() {
+	flag.StringVar(&input, "input", "", "Specify the input file path for the curated list of shortener domains.")
+	flag.StringVar(&output, "output", "", "Specify the output file path for the generated Go source file.")
+
+	flag.Usage = func() {
+		h := "USAGE:\n"
+		h += "  shorteners [OPTIONS]\n"
+
+		h += "\nOPTIONS:\n"
+		h += " -input string     Specify the input file path for the curated list of shortener domains.\n"
+		h += " -output string    Specify the output file path for the generated Go source file.\n"
+
+		fmt.Fprintln(os.Stderr, h)
+	}
+
+	flag.Parse()
+}
+
+func main() {
+	if input == "" || output == "" {
+		log.Fatalln("Input and output file paths are required. Use -input and -output to specify them.")
+	}
+
+	log.Printf("Generating %s...\n", output)
+
+	shorteners, err := readShorteners(input)
+	if err != nil {
+		log.Fatalf("Failed to read shorteners: %v\n", err)
+	}
+
+	if err := writeShortenersToFile(shorteners, output); err != nil {
+		log.Fatalf("Failed to write shorteners to file: %v\n", err)
+	}
+
+	log.Println("Shorteners file generated successfully.")
+}
+
+// readShorteners reads the curated list of shortener domains. Each non-empty line holds a
+// domain, optionally followed by "#" and a description; lines starting with "#" are
+// comments. The domains are returned sorted and deduplicated.
+func readShorteners(input string) (shorteners []shortener, err error) {
+	file, err := os.Open(input)
+	if err != nil {
+		err = fmt.Errorf("failed to open input file: %w", err)
+
+		return
+	}
+
+	defer file.Close()
+
+	scanner := bufio.NewScanner(file)
+
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+
+		domain, description, _ := strings.Cut(line, "#")
+
+		shorteners = append(shorteners, shortener{
+			Domain:      strings.ToLower(strings.TrimSpace(domain)),
+			Description: strings.TrimSpace(description),
+		})
+	}
+
+	if err = scanner.Err(); err != nil {
+		err = fmt.Errorf("scanner error: %w", err)
+
+		return
+	}
+
+	slices.SortFunc(shorteners, func(a, b shortener) int { return strings.Compare(a.Domain, b.Domain) })
+
+	shorteners = slices.CompactFunc(shorteners, func(a, b shortener) bool { return a.Domain == b.Domain })
+
+	return
+}
+
+// writeShortenersToFile writes the generated list of shortener domains to the output file.
+func writeShortenersToFile(shorteners []shortener, output string) (err error) {
+	data := struct {
+		Shorteners []shortener
+	}{
+		Shorteners: shorteners,
+	}
+
+	var buf bytes.Buffer
+
+	if err = tmpl.Execute(&buf, data); err != nil {
+		err = fmt.Errorf("failed to execute template: %w", err)
+
+		return
+	}
+
+	// Format the source, e.g. to align trailing comments
+	source, err := format.Source(buf.Bytes())
+	if err != nil {
+		err = fmt.Errorf("failed to format source: %w", err)
+
+		return
+	}
+
+	if err = os.WriteFile(output, source, 0o644); err != nil { //nolint:gosec // Generated source files are world-readable.
+		err = fmt.Errorf("failed to write output file: %w", err)
+	}
+
+	return
+}
@@ -5,20 +5,33 @@ import (
 	"fmt"
 	"log"
 	"os"
+	"slices"
 	"strconv"
 	"strings"
 	"text/template"
+	"time"
 	"unicode"
+
+	"go.source.hueristiq.com/url/gen/internal/genutil"
 )
 
 var (
 	// Output file path for the generated Go source file.
 	output string
+	// Output file path for the generated Go source file with the script-specific sets.
+	scriptsOutput string
+	// Output file path for the generated Go source file with the generation metadata.
+	metadataOutput string
+	// The Unicode version the sets are generated from, which the unicode package of the
+	// toolchain running the generator must have.
+	unicodeVersion string
 
 	// Template for the autogenerated Go file containing the list of schemes.
 	tmpl = template.Must(template.New("schemes").Parse(`// This file is autogenerated by the unicodes generator. Please do not edit manually.
 package unicodes
 
+import "unicode"
+
 // AllowedUcsChar defines a range of allowed Unicode characters.
 // This set includes various characters spanning multiple blocks of the Unicode specification.
 // It allows for a wide range of characters, including those from languages, symbols, and certain punctuation.
@@ -32,12 +45,61 @@ const AllowedUcsChar = {{.withPunc}}
 // This constant is useful when processing input where punctuation is undesired
 // or needs to be filtered out, such as usernames, identifiers, or file names.
 const AllowedUcsCharMinusPunc = {{.withoutPunc}}
+
+// allowedUcsCharTable is the unicode.RangeTable form of AllowedUcsChar.
+var allowedUcsCharTable = {{.withPuncTable}}
+
+// allowedUcsCharMinusPuncTable is the unicode.RangeTable form of AllowedUcsCharMinusPunc.
+var allowedUcsCharMinusPuncTable = {{.withoutPuncTable}}
 `))
 )
 
+// scripts lists the script-specific sets written to the scripts output file.
+var scripts = []struct {
+	Name        string
+	Description string
+	Tables      []*unicode.RangeTable
+}{
+	{"Arabic", "the Arabic script", []*unicode.RangeTable{unicode.Arabic}},
+	{"CJK", "the CJK scripts (Han, Hiragana, Katakana, Hangul and Bopomofo)", []*unicode.RangeTable{unicode.Han, unicode.Hiragana, unicode.Katakana, unicode.Hangul, unicode.Bopomofo}},
+	{"Cyrillic", "the Cyrillic script", []*unicode.RangeTable{unicode.Cyrillic}},
+	{"Devanagari", "the Devanagari script", []*unicode.RangeTable{unicode.Devanagari}},
+	{"Greek", "the Greek script", []*unicode.RangeTable{unicode.Greek}},
+	{"Hebrew", "the Hebrew script", []*unicode.RangeTable{unicode.Hebrew}},
+	{"Latin", "the Latin script", []*unicode.RangeTable{unicode.Latin}},
+	{"Thai", "the Thai script", []*unicode.RangeTable{unicode.Thai}},
+}
+
+// Template for the autogenerated Go file containing the script-specific sets.
+var scriptsTmpl = template.Must(template.New("scripts").Parse(`// This file is autogenerated by the unicodes generator. Please do not edit manually.
+package unicodes
+{{range .}}
+// Allowed{{.Name}} defines the characters of {{.Description}} allowed by AllowedUcsChar,
+// i.e. beyond ASCII. It is meant to be used inside a regular expression character class.
+const Allowed{{.Name}} = {{.Value}}
+{{end -}}
+`))
+
+// Template for the autogenerated Go file containing the generation metadata.
+var metadataTmpl = template.Must(template.New("metadata").Parse(`// This file is autogenerated by the unicodes generator. Please do not edit manually.
+package unicodes
+
+import "time"
+
+// GeneratedAt is the time the sets of this package were generated at. See IsStale.
+var GeneratedAt = time.Unix({{.GeneratedAt.Unix}}, 0).UTC()
+
+// UnicodeVersion is the version of the Unicode tables the sets of this package were
+// generated from (those of the unicode package of the Go toolchain that generated them).
+const UnicodeVersion = "{{.UnicodeVersion}}"
+`))
+
 func init() {
 	// Define the command-line flag for output file path
 	flag.StringVar(&output, "output", "", "Specify the output file path for the generated Go source file.")
+	flag.StringVar(&scriptsOutput, "scripts-output", "", "Specify the output file path for the generated Go source file with script-specific sets.")
+	flag.StringVar(&metadataOutput, "metadata-output", "", "Specify the output file path for the generated Go source file with generation metadata.")
+	flag.StringVar(&unicodeVersion, "unicode-version", "", "Specify the Unicode version the sets are generated from (e.g., 17.0.0).")
 
 	// Custom usage message for the command-line flag
 	flag.Usage = func() {
@@ -45,7 +107,10 @@ func init() {
 		h += "  schemes [OPTIONS]\n"
 
 		h += "\nOPTIONS:\n"
-		h += " -output string    Specify the output file path for the generated Go source file.\n"
+		h += " -output string             Specify the output file path for the generated Go source file.\n"
+		h += " -scripts-output string     Specify the output file path for the generated Go source file with script-specific sets.\n"
+		h += " -metadata-output string    Specify the output file path for the generated Go source file with generation metadata.\n"
+		h += " -unicode-version string    Specify the Unicode version the sets are generated from (e.g., 17.0.0).\n"
 
 		fmt.Fprintln(os.Stderr, h)
 	}
@@ -60,12 +125,30 @@ func main() {
 		log.Fatalln("Output file path is required. Use -output to specify the output file path.")
 	}
 
+	// Ensure that the sets are generated from the pinned Unicode version, which depends on
+	// the toolchain running the generator
+	if unicodeVersion == "" {
+		log.Fatalln("Unicode version is required. Use -unicode-version to pin the Unicode version of the sets.")
+	}
+
+	if unicodeVersion != unicode.Version {
+		log.Fatalf("The Go toolchain has the Unicode %s tables, not %s: run the generator with a toolchain of Unicode %s (e.g., with GOTOOLCHAIN).\n", unicode.Version, unicodeVersion, unicodeVersion)
+	}
+
 	log.Printf("Generating %s...\n", output)
 
 	if err := writeUnicode(); err != nil {
 		log.Fatal(err)
 	}
 
+	if metadataOutput != "" {
+		log.Printf("Generating %s...\n", metadataOutput)
+
+		if err := writeMetadata(); err != nil {
+			log.Fatal(err)
+		}
+	}
+
 	log.Println("Unicodes file generated successfully.")
 }
 
@@ -205,8 +288,144 @@ func writeUnicode() error {
 
 	defer f.Close()
 
-	return tmpl.Execute(f, map[string]string{
-		"withPunc":    strconv.Quote(allowedUcsChar.String()),
-		"withoutPunc": strconv.Quote(allowedUcsCharMinusPunc.String()),
+	if err := tmpl.Execute(f, map[string]string{
+		"withPunc":         strconv.Quote(allowedUcsChar.String()),
+		"withoutPunc":      strconv.Quote(allowedUcsCharMinusPunc.String()),
+		"withPuncTable":    rangeTableLiteral(sepFreeRanges),
+		"withoutPuncTable": rangeTableLiteral(puncFreeRanges),
+	}); err != nil {
+		return err
+	}
+
+	if scriptsOutput == "" {
+		return nil
+	}
+
+	log.Printf("Generating %s...\n", scriptsOutput)
+
+	// Build the script-specific sets, restricted to the characters allowed by AllowedUcsChar.
+	type scriptSet struct {
+		Name        string
+		Description string
+		Value       string
+	}
+
+	sets := make([]scriptSet, 0, len(scripts))
+
+	for _, script := range scripts {
+		var runes []rune
+
+		for _, table := range script.Tables {
+			visit(table, func(cp rune) {
+				if inRanges(sepFreeRanges, cp) {
+					runes = append(runes, cp)
+				}
+			})
+		}
+
+		contents := characterClassContents(toRanges(runes))
+
+		sets = append(sets, scriptSet{
+			Name:        script.Name,
+			Description: script.Description,
+			Value:       strconv.Quote(contents.String()),
+		})
+	}
+
+	sf, err := os.Create(scriptsOutput)
+	if err != nil {
+		return err
+	}
+
+	defer sf.Close()
+
+	return scriptsTmpl.Execute(sf, sets)
+}
+
+// rangeTableLiteral renders the given inclusive code point ranges (in ascending order)
+// as a Go *unicode.RangeTable literal.
+func rangeTableLiteral(ranges [][2]rune) string {
+	var R16, R32 strings.Builder
+
+	latinOffset := 0
+
+	for _, r := range ranges {
+		if r[1] <= 0xFFFF {
+			fmt.Fprintf(&R16, "\t\t{Lo: 0x%04x, Hi: 0x%04x, Stride: 1},\n", r[0], r[1])
+
+			if r[1] <= unicode.MaxLatin1 {
+				latinOffset++
+			}
+
+			continue
+		}
+
+		// A range crossing the 16-bit boundary is split between R16 and R32.
+		if r[0] <= 0xFFFF {
+			fmt.Fprintf(&R16, "\t\t{Lo: 0x%04x, Hi: 0xffff, Stride: 1},\n", r[0])
+
+			r[0] = 0x10000
+		}
+
+		fmt.Fprintf(&R32, "\t\t{Lo: 0x%x, Hi: 0x%x, Stride: 1},\n", r[0], r[1])
+	}
+
+	return "&unicode.RangeTable{\n" +
+		"\tR16: []unicode.Range16{\n" + R16.String() + "\t},\n" +
+		"\tR32: []unicode.Range32{\n" + R32.String() + "\t},\n" +
+		"\tLatinOffset: " + strconv.Itoa(latinOffset) + ",\n" +
+		"}"
+}
+
+// inRanges reports whether cp is in one of the given inclusive code point ranges.
+func inRanges(ranges [][2]rune, cp rune) bool {
+	for _, r := range ranges {
+		if r[0] <= cp && cp <= r[1] {
+			return true
+		}
+	}
+
+	return false
+}
+
+// toRanges sorts and deduplicates runes and merges consecutive ones into inclusive ranges.
+func toRanges(runes []rune) (ranges [][2]rune) {
+	slices.Sort(runes)
+
+	runes = slices.Compact(runes)
+
+	for _, cp := range runes {
+		if n := len(ranges); n > 0 && ranges[n-1][1] == cp-1 {
+			ranges[n-1][1] = cp
+
+			continue
+		}
+
+		ranges = append(ranges, [2]rune{cp, cp})
+	}
+
+	return
+}
+
+// writeMetadata writes the generation metadata to the metadata output file.
+func writeMetadata() error {
+	at, err := genutil.GeneratedAt()
+	if err != nil {
+		return err
+	}
+
+	f, err := os.Create(metadataOutput)
+	if err != nil {
+		return err
+	}
+
+	defer f.Close()
+
+	return metadataTmpl.Execute(f, struct {
+		GeneratedAt    time.Time
+		UnicodeVersion string
+	}{
+		GeneratedAt:    at,
+		UnicodeVersion: unicode.Version,
 	})
 }
@@ -1,5 +1,3 @@
 package url
 
-//go:generate go run gen/schemes/main.go -output ./schemes/schemes_official.go
-//go:generate go run gen/TLDs/main.go -output ./tlds/tlds_official.go
-//go:generate go run gen/unicodes/main.go -output ./unicodes/unicodes.go
+//go:generate go run ./gen
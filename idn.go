@@ -0,0 +1,132 @@
+package url
+
+import (
+	"strings"
+
+	"golang.org/x/net/idna"
+	"golang.org/x/text/unicode/norm"
+)
+
+// IDNForms holds the canonical forms of an internationalized host, so that de-spoofing
+// logic can compare hosts without re-deriving them: two hosts with the same Skeleton are
+// visually confusable (e.g., "exаmple.com", with a Cyrillic "а", and "example.com").
+type IDNForms struct {
+	Unicode  string `json:"unicode"`  // The NFC-normalized Unicode form (e.g., "bücher.de").
+	ASCII    string `json:"ascii"`    // The punycode form (e.g., "xn--bcher-kva.de").
+	Skeleton string `json:"skeleton"` // The UTS #39 skeleton of the Unicode form.
+}
+
+// idnForms returns the canonical forms of host, or nil if it is not internationalized
+// (neither non-ASCII nor with punycode labels) or cannot be converted.
+func idnForms(host string) (forms *IDNForms) {
+	host = strings.ToLower(strings.TrimSuffix(host, "."))
+
+	unicodeHost := host
+
+	if isASCII(host) {
+		if !strings.HasPrefix(host, "xn--") && !strings.Contains(host, ".xn--") {
+			return
+		}
+
+		var err error
+
+		if unicodeHost, err = idna.ToUnicode(host); err != nil {
+			return
+		}
+	}
+
+	unicodeHost = norm.NFC.String(unicodeHost)
+
+	ASCII, err := hostToASCII(unicodeHost)
+	if err != nil {
+		return
+	}
+
+	forms = &IDNForms{
+		Unicode:  unicodeHost,
+		ASCII:    ASCII,
+		Skeleton: Skeleton(unicodeHost),
+	}
+
+	return
+}
+
+// Skeleton returns the skeleton of s, as defined by UTS #39 (Unicode Security Mechanisms)
+// section 4: s is decomposed (NFD), each confusable character is replaced by its
+// prototype, and the result is decomposed again. Strings with the same skeleton are
+// visually confusable, e.g. "pаypаl" (with Cyrillic "а") and "paypal", or "rnicrosoft"
+// and "microsoft" (whose "m" has the prototype "rn").
+//
+// The confusables are a subset of the Unicode confusables data (confusables.txt), covering
+// the characters most commonly used to spoof the letters and digits of domains. As in
+// UTS #39, skeletons are meant for comparison only, not for display.
+//
+// Parameters:
+//   - s (string): The string (e.g., a host).
+//
+// Returns:
+//   - skeleton (string): The skeleton of s.
+func Skeleton(s string) (skeleton string) {
+	var b strings.Builder
+
+	for _, r := range norm.NFD.String(s) {
+		if prototype, ok := confusables[r]; ok {
+			b.WriteString(prototype)
+
+			continue
+		}
+
+		b.WriteRune(r)
+	}
+
+	skeleton = norm.NFD.String(b.String())
+
+	return
+}
+
+// confusables maps confusable characters to their prototypes, as in the Unicode
+// confusables data (https://www.unicode.org/Public/security/latest/confusables.txt).
+var confusables = map[rune]string{
+	// ASCII.
+	'0': "O",
+	'1': "l",
+	'I': "l",
+	'|': "l",
+	'm': "rn",
+
+	// Latin.
+	'ı': "i", // ı LATIN SMALL LETTER DOTLESS I
+	'ǀ': "l", // ǀ LATIN LETTER DENTAL CLICK
+	'ɑ': "a", // ɑ LATIN SMALL LETTER ALPHA
+	'ɡ': "g", // ɡ LATIN SMALL LETTER SCRIPT G
+	'ɩ': "i", // ɩ LATIN SMALL LETTER IOTA
+
+	// Greek.
+	'α': "a", // α GREEK SMALL LETTER ALPHA
+	'ι': "i", // ι GREEK SMALL LETTER IOTA
+	'ν': "v", // ν GREEK SMALL LETTER NU
+	'ο': "o", // ο GREEK SMALL LETTER OMICRON
+	'ρ': "p", // ρ GREEK SMALL LETTER RHO
+
+	// Cyrillic.
+	'а': "a", // а CYRILLIC SMALL LETTER A
+	'е': "e", // е CYRILLIC SMALL LETTER IE
+	'о': "o", // о CYRILLIC SMALL LETTER O
+	'р': "p", // р CYRILLIC SMALL LETTER ER
+	'с': "c", // с CYRILLIC SMALL LETTER ES
+	'у': "y", // у CYRILLIC SMALL LETTER U
+	'х': "x", // х CYRILLIC SMALL LETTER HA
+	'ѕ': "s", // ѕ CYRILLIC SMALL LETTER DZE
+	'і': "i", // і CYRILLIC SMALL LETTER BYELORUSSIAN-UKRAINIAN I
+	'ј': "j", // ј CYRILLIC SMALL LETTER JE
+	'һ': "h", // һ CYRILLIC SMALL LETTER SHHA
+	'ӏ': "l", // ӏ CYRILLIC SMALL LETTER PALOCHKA
+	'ԁ': "d", // ԁ CYRILLIC SMALL LETTER KOMI DE
+	'ԛ': "q", // ԛ CYRILLIC SMALL LETTER QA
+	'ԝ': "w", // ԝ CYRILLIC SMALL LETTER WE
+
+	// Armenian.
+	'ո': "n", // ո ARMENIAN SMALL LETTER VO
+	'ս': "u", // ս ARMENIAN SMALL LETTER SEH
+	'օ': "o", // օ ARMENIAN SMALL LETTER OH
+}
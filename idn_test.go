@@ -0,0 +1,35 @@
+package url_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	hqgourl "go.source.hueristiq.com/url"
+)
+
+func TestSkeleton(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		s        string
+		skeleton string
+	}{
+		{"example.com", "exarnple.corn"},
+		{"exаmple.com", "exarnple.corn"},
+		{"раураl.com", "paypal.corn"},
+		{"rnicrosoft.com", "rnicrosoft.corn"},
+		{"g00gle.com", "gOOgle.corn"},
+		{"ԁіѕсоrd.com", "discord.corn"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.s, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, tt.skeleton, hqgourl.Skeleton(tt.s))
+		})
+	}
+
+	assert.Equal(t, hqgourl.Skeleton("microsoft.com"), hqgourl.Skeleton("rnicrosoft.com"))
+	assert.Equal(t, hqgourl.Skeleton("bücher.de"), hqgourl.Skeleton("bücher.de"))
+}
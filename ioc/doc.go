@@ -0,0 +1,15 @@
+// Package ioc extracts indicators of compromise (IOCs) from threat intelligence reports,
+// alerts and other free text: URLs, domains, IPv4 and IPv6 addresses and emails, each
+// reported with its type.
+//
+// Reports usually "defang" indicators so that they can't be clicked or resolved by
+// accident, e.g. "hxxps://evil[.]com" or "user[at]evil(.)com". Extract refangs the text
+// before extraction, so defanged indicators are found and reported in their usable form,
+// along with the raw text they were found as and its offsets.
+//
+// Example:
+//
+//	for _, indicator := range ioc.Extract("C2: hxxps://evil[.]com/gate.php, 203.0.113[.]7") {
+//	    fmt.Println(indicator.Type, indicator.Value) // url https://evil.com/gate.php, then ipv4 203.0.113.7
+//	}
+package ioc
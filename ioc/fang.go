@@ -0,0 +1,154 @@
+package ioc
+
+import "strings"
+
+// refang is a defanged form of a piece of an indicator and the text it stands for.
+type refang struct {
+	defanged string
+	fanged   string
+}
+
+// refangs are the defanged forms Refang recognizes, matched case-insensitively. Longer
+// forms come first, so that e.g. "[://]" is preferred over "[:]".
+var refangs = []refang{
+	{"[://]", "://"},
+	{"[dot]", "."},
+	{"(dot)", "."},
+	{"{dot}", "."},
+	{"[at]", "@"},
+	{"(at)", "@"},
+	{"{at}", "@"},
+	{"[.]", "."},
+	{"(.)", "."},
+	{"{.}", "."},
+	{"[:]", ":"},
+	{"[/]", "/"},
+	{"[@]", "@"},
+	{"(@)", "@"},
+	{`\.`, "."},
+}
+
+// Refang restores defanged indicators in text: "hxxp" and "hXXp" schemes become "http",
+// and bracketed separators such as "[.]", "(.)", "[dot]", "[:]", "[://]", "[@]" and
+// "[at]", as well as escaped dots ("\."), are replaced by the characters they stand for.
+//
+// Parameters:
+//   - text (string): The text to refang.
+//
+// Returns:
+//   - refanged (string): The text, with defanged indicators restored.
+func Refang(text string) (refanged string) {
+	refanged, _ = refangWithOffsets(text)
+
+	return
+}
+
+// refangWithOffsets refangs text and maps each byte of the result back to text: offsets[i]
+// is the offset in text of the piece the i-th byte of refanged comes from, and
+// offsets[len(refanged)] is len(text).
+func refangWithOffsets(text string) (refanged string, offsets []int) {
+	var builder strings.Builder
+
+	builder.Grow(len(text))
+
+	offsets = make([]int, 0, len(text)+1)
+
+	for i := 0; i < len(text); {
+		defanged, fanged := refangAt(text, i)
+
+		if defanged == 0 {
+			builder.WriteByte(text[i])
+
+			offsets = append(offsets, i)
+
+			i++
+
+			continue
+		}
+
+		builder.WriteString(fanged)
+
+		for range len(fanged) {
+			offsets = append(offsets, i)
+		}
+
+		i += defanged
+	}
+
+	offsets = append(offsets, len(text))
+
+	refanged = builder.String()
+
+	return
+}
+
+// refangAt returns the length of the defanged form starting at text[i] and the text it
+// stands for, or a zero length if there is none.
+func refangAt(text string, i int) (length int, fanged string) {
+	rest := text[i:]
+
+	// "hxxp" is only refanged at the start of a word, where it is a scheme.
+	if len(rest) >= 4 && strings.EqualFold(rest[:4], "hxxp") && (i == 0 || !isWordByte(text[i-1])) {
+		length, fanged = 4, "http"
+
+		return
+	}
+
+	for _, r := range refangs {
+		if len(rest) >= len(r.defanged) && strings.EqualFold(rest[:len(r.defanged)], r.defanged) {
+			length, fanged = len(r.defanged), r.fanged
+
+			return
+		}
+	}
+
+	return
+}
+
+// isWordByte reports whether b is an ASCII letter or digit.
+func isWordByte(b byte) bool {
+	return 'a' <= b && b <= 'z' || 'A' <= b && b <= 'Z' || '0' <= b && b <= '9'
+}
+
+// Defang makes an indicator safe to share: the "http" in its scheme becomes "hxxp", its
+// "://" becomes "[://]", the dots of its host become "[.]" and the "@" of an email
+// becomes "[@]". Refang reverses it.
+//
+// Parameters:
+//   - value (string): The indicator to defang.
+//
+// Returns:
+//   - defanged (string): The defanged indicator.
+func Defang(value string) (defanged string) {
+	rest := value
+
+	var builder strings.Builder
+
+	if scheme, after, found := strings.Cut(rest, "://"); found && !strings.ContainsAny(scheme, "/?#") {
+		if len(scheme) >= 4 && strings.EqualFold(scheme[:4], "http") {
+			scheme = "hxxp" + scheme[4:]
+		}
+
+		builder.WriteString(scheme)
+		builder.WriteString("[://]")
+
+		rest = after
+	}
+
+	end := strings.IndexAny(rest, "/?#")
+	if end < 0 {
+		end = len(rest)
+	}
+
+	authority := rest[:end]
+
+	authority = strings.ReplaceAll(authority, ".", "[.]")
+	authority = strings.ReplaceAll(authority, "@", "[@]")
+
+	builder.WriteString(authority)
+	builder.WriteString(rest[end:])
+
+	defanged = builder.String()
+
+	return
+}
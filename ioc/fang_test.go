@@ -0,0 +1,51 @@
+package ioc_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.source.hueristiq.com/url/ioc"
+)
+
+func TestRefang(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		text string
+		want string
+	}{
+		{"hxxps://evil[.]com/gate.php", "https://evil.com/gate.php"},
+		{"hXXp[://]evil(.)com", "http://evil.com"},
+		{"hxxps[:]//evil[dot]com", "https://evil.com"},
+		{"user[at]evil{.}com and user[@]evil\\.com", "user@evil.com and user@evil.com"},
+		{"203.0.113[.]7", "203.0.113.7"},
+		{"shxxp stays", "shxxp stays"},
+		{"nothing to do", "nothing to do"},
+	}
+
+	for _, tt := range tests {
+		assert.Equal(t, tt.want, ioc.Refang(tt.text))
+	}
+}
+
+func TestDefang(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		value string
+		want  string
+	}{
+		{"https://evil.com/gate.php?a=b.c", "hxxps[://]evil[.]com/gate.php?a=b.c"},
+		{"ftp://files.evil.com", "ftp[://]files[.]evil[.]com"},
+		{"evil.com", "evil[.]com"},
+		{"203.0.113.7", "203[.]0[.]113[.]7"},
+		{"user@evil.com", "user[@]evil[.]com"},
+	}
+
+	for _, tt := range tests {
+		defanged := ioc.Defang(tt.value)
+
+		assert.Equal(t, tt.want, defanged)
+		assert.Equal(t, tt.value, ioc.Refang(defanged))
+	}
+}
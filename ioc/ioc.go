@@ -0,0 +1,218 @@
+package ioc
+
+import (
+	"net/netip"
+	"regexp"
+	"slices"
+	"strings"
+
+	hqgourl "go.source.hueristiq.com/url"
+)
+
+// Type identifies the kind of an Indicator.
+type Type string
+
+const (
+	// TypeURL identifies a URL, with or without a scheme (e.g., "https://evil.com/gate.php"
+	// or "evil.com/gate.php").
+	TypeURL Type = "url"
+	// TypeDomain identifies a domain name (e.g., "evil.com").
+	TypeDomain Type = "domain"
+	// TypeIPv4 identifies an IPv4 address (e.g., "203.0.113.7").
+	TypeIPv4 Type = "ipv4"
+	// TypeIPv6 identifies an IPv6 address (e.g., "2001:db8::1").
+	TypeIPv6 Type = "ipv6"
+	// TypeEmail identifies an email address (e.g., "user@evil.com").
+	TypeEmail Type = "email"
+)
+
+// Types are all the types of indicators, in the order Extract reports them when several
+// start at the same offset.
+var Types = []Type{TypeURL, TypeDomain, TypeIPv4, TypeIPv6, TypeEmail}
+
+// Indicator is an indicator of compromise found in a text.
+type Indicator struct {
+	Type  Type   `json:"type"`  // The kind of indicator.
+	Value string `json:"value"` // The refanged indicator (e.g., "https://evil.com").
+	Raw   string `json:"raw"`   // The indicator as found in the text (e.g., "hxxps://evil[.]com").
+	Start int    `json:"start"` // The byte offset of the start of Raw in the text.
+	End   int    `json:"end"`   // The byte offset of the end (exclusive) of Raw in the text.
+}
+
+// Defanged reports whether the indicator was defanged in the text.
+//
+// Returns:
+//   - defanged (bool): True if Raw contains defanged forms that Refang restores.
+func (i Indicator) Defanged() (defanged bool) {
+	defanged = Refang(i.Raw) != i.Raw
+
+	return
+}
+
+// Options holds the configuration of Extract.
+type Options struct {
+	Types  []Type // The types of indicators to report (all by default).
+	Refang bool   // Whether defanged indicators are refanged before extraction (true by default).
+}
+
+// OptionFunc defines a function type for configuring the Options of Extract.
+type OptionFunc func(*Options)
+
+// WithTypes returns an option function that restricts the indicators reported by Extract
+// to the given types.
+func WithTypes(types ...Type) OptionFunc {
+	return func(o *Options) {
+		o.Types = types
+	}
+}
+
+// WithoutRefang returns an option function that disables refanging, so only indicators
+// written in their usable form are found.
+func WithoutRefang() OptionFunc {
+	return func(o *Options) {
+		o.Refang = false
+	}
+}
+
+// sharedExtractor is the Extractor used by Extract: it finds URLs with a scheme or a host
+// and emails, but no relative URLs, which are not indicators.
+var sharedExtractor = hqgourl.NewExtractor(hqgourl.ExtractorWithHost())
+
+// ipv6Regex matches the candidates for unbracketed IPv6 addresses, which the Extractor
+// only recognizes in URLs; candidates are validated with netip.ParseAddr.
+var ipv6Regex = regexp.MustCompile(`(?i)[0-9a-f:.]*:[0-9a-f:.]*`)
+
+// Extract finds the indicators of compromise in text, refanging it first unless
+// WithoutRefang is given. Scheme-less URLs and hosts are reported as URLs if they have a
+// port, path, query or fragment, and otherwise as domains or IP addresses (the brackets of
+// IPv6 addresses are dropped). Indicators are returned in the order in which they appear.
+//
+// Parameters:
+//   - text (string): The text to extract indicators from.
+//   - opts (variadic OptionFunc): Options restricting the types and refanging.
+//
+// Returns:
+//   - indicators ([]Indicator): The indicators found in text.
+func Extract(text string, opts ...OptionFunc) (indicators []Indicator) {
+	options := &Options{
+		Types:  Types,
+		Refang: true,
+	}
+
+	for _, opt := range opts {
+		opt(options)
+	}
+
+	refanged := text
+
+	var offsets []int
+
+	if options.Refang {
+		refanged, offsets = refangWithOffsets(text)
+	}
+
+	add := func(indicatorType Type, value string, start, end int) {
+		if !slices.Contains(options.Types, indicatorType) {
+			return
+		}
+
+		if offsets != nil {
+			start, end = offsets[start], offsets[end]
+		}
+
+		indicators = append(indicators, Indicator{
+			Type:  indicatorType,
+			Value: value,
+			Raw:   text[start:end],
+			Start: start,
+			End:   end,
+		})
+	}
+
+	for _, match := range sharedExtractor.Extract(refanged) {
+		indicatorType, value := classify(match)
+		if indicatorType == "" {
+			continue
+		}
+
+		add(indicatorType, value, match.Start, match.End)
+	}
+
+	for _, loc := range ipv6Regex.FindAllStringIndex(refanged, -1) {
+		// A trailing dot ends the sentence, not the address.
+		candidate := strings.TrimRight(refanged[loc[0]:loc[1]], ".")
+		start, end := loc[0], loc[0]+len(candidate)
+
+		if !standalone(refanged, start, end) {
+			continue
+		}
+
+		addr, err := netip.ParseAddr(candidate)
+		if err != nil || !addr.Is6() || addr.IsUnspecified() {
+			continue
+		}
+
+		add(TypeIPv6, addr.String(), start, end)
+	}
+
+	slices.SortStableFunc(indicators, func(a, b Indicator) int {
+		return a.Start - b.Start
+	})
+
+	return
+}
+
+// standalone reports whether text[start:end] is not part of a word, a bracketed IPv6
+// address or a zone-qualified address.
+func standalone(text string, start, end int) bool {
+	if start > 0 && (isWordByte(text[start-1]) || text[start-1] == '[') {
+		return false
+	}
+
+	if end < len(text) && (isWordByte(text[end]) || text[end] == ']' || text[end] == '%') {
+		return false
+	}
+
+	return true
+}
+
+// classify returns the type of indicator a match is and its value, or an empty type if
+// it is not an indicator.
+func classify(match hqgourl.Match) (indicatorType Type, value string) {
+	value = match.Value
+
+	switch match.Type {
+	case hqgourl.MatchTypeURL, hqgourl.MatchTypeSchemeRelative:
+		indicatorType = TypeURL
+	case hqgourl.MatchTypeEmail:
+		indicatorType = TypeEmail
+	case hqgourl.MatchTypeHost:
+		components := match.Components()
+		if components == nil {
+			return
+		}
+
+		if components.Port != "" || components.Path != "" || components.Query != "" ||
+			components.Fragment != "" || components.User != "" || strings.HasSuffix(value, "?") {
+			indicatorType = TypeURL
+
+			return
+		}
+
+		addr, err := netip.ParseAddr(components.Host)
+
+		switch {
+		case err != nil:
+			indicatorType = TypeDomain
+			value = components.Host
+		case addr.Is4():
+			indicatorType = TypeIPv4
+			value = addr.String()
+		default:
+			indicatorType = TypeIPv6
+			value = addr.String()
+		}
+	}
+
+	return
+}
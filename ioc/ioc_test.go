@@ -0,0 +1,80 @@
+package ioc_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.source.hueristiq.com/url/ioc"
+)
+
+func TestExtract(t *testing.T) {
+	t.Parallel()
+
+	text := "C2: hxxps://evil[.]com/gate.php, beacons to 203.0.113[.]7 and 2001:db8::7. " +
+		"Phish from admin[at]evil(.)net via cdn.evil[.]org/x.js and [2001:db8::1]."
+
+	got := ioc.Extract(text)
+
+	want := []ioc.Indicator{
+		{Type: ioc.TypeURL, Value: "https://evil.com/gate.php", Raw: "hxxps://evil[.]com/gate.php"},
+		{Type: ioc.TypeIPv4, Value: "203.0.113.7", Raw: "203.0.113[.]7"},
+		{Type: ioc.TypeIPv6, Value: "2001:db8::7", Raw: "2001:db8::7"},
+		{Type: ioc.TypeEmail, Value: "admin@evil.net", Raw: "admin[at]evil(.)net"},
+		{Type: ioc.TypeURL, Value: "cdn.evil.org/x.js", Raw: "cdn.evil[.]org/x.js"},
+		{Type: ioc.TypeIPv6, Value: "2001:db8::1", Raw: "[2001:db8::1]"},
+	}
+
+	if assert.Len(t, got, len(want)) {
+		for i := range want {
+			assert.Equal(t, want[i].Type, got[i].Type)
+			assert.Equal(t, want[i].Value, got[i].Value)
+			assert.Equal(t, want[i].Raw, got[i].Raw)
+			assert.Equal(t, want[i].Raw, text[got[i].Start:got[i].End])
+		}
+	}
+
+	assert.True(t, got[0].Defanged())
+	assert.False(t, got[2].Defanged())
+}
+
+func TestExtract_Domains(t *testing.T) {
+	t.Parallel()
+
+	got := ioc.Extract("resolves evil[.]com, 10.0.0.1:8080 and www.bad.co.uk.")
+
+	var types, values []string
+
+	for _, indicator := range got {
+		types = append(types, string(indicator.Type))
+		values = append(values, indicator.Value)
+	}
+
+	assert.Equal(t, []string{"domain", "url", "domain"}, types)
+	assert.Equal(t, []string{"evil.com", "10.0.0.1:8080", "www.bad.co.uk"}, values)
+}
+
+func TestExtract_Options(t *testing.T) {
+	t.Parallel()
+
+	text := "evil[.]com, bad.example.com and 198.51.100.1"
+
+	got := ioc.Extract(text, ioc.WithTypes(ioc.TypeIPv4))
+
+	if assert.Len(t, got, 1) {
+		assert.Equal(t, "198.51.100.1", got[0].Value)
+	}
+
+	got = ioc.Extract(text, ioc.WithoutRefang(), ioc.WithTypes(ioc.TypeDomain))
+
+	if assert.Len(t, got, 1) {
+		assert.Equal(t, "bad.example.com", got[0].Value)
+	}
+}
+
+func TestExtract_IPv6Candidates(t *testing.T) {
+	t.Parallel()
+
+	got := ioc.Extract("at 12:30:45, mac 00:11:22:33:44:55, std::vector, :: and fe80::1%eth0")
+
+	assert.Empty(t, got)
+}
@@ -0,0 +1,81 @@
+package url
+
+import (
+	"net/netip"
+	"strconv"
+	"strings"
+)
+
+// ObfuscatedIPv4 parses host as an IPv4 address written in one of the alternative forms
+// accepted by inet_aton(3), and so by most browsers and HTTP clients, which phishing URLs
+// use to disguise IP hosts: one to four dot-separated parts, each in decimal, octal
+// (leading "0") or hexadecimal (leading "0x"), the last part filling the remaining bytes,
+// e.g. "0x7f.0.0.1", "2130706433", "0177.0.0.01" or "127.1" for "127.0.0.1". Hosts that
+// are already canonical dotted-quads, or that are not IPv4 addresses, are not obfuscated.
+//
+// Parameters:
+//   - host (string): The host, without port.
+//
+// Returns:
+//   - addr (netip.Addr): The IPv4 address.
+//   - ok (bool): Whether host is an obfuscated IPv4 address.
+func ObfuscatedIPv4(host string) (addr netip.Addr, ok bool) {
+	host = strings.TrimSuffix(host, ".")
+
+	parts := strings.Split(host, ".")
+	if len(parts) > 4 {
+		return
+	}
+
+	values := make([]uint64, len(parts))
+
+	for i, part := range parts {
+		value, err := parseIPv4Part(part)
+		if err != nil {
+			return
+		}
+
+		values[i] = value
+	}
+
+	// Every part but the last is a single byte; the last fills the remaining bytes.
+	var ip uint64
+
+	for _, value := range values[:len(values)-1] {
+		if value > 0xff {
+			return
+		}
+
+		ip = ip<<8 | value
+	}
+
+	last := values[len(values)-1]
+	remaining := 8 * (5 - len(values))
+
+	if last >= 1<<remaining {
+		return
+	}
+
+	ip = ip<<remaining | last
+
+	addr = netip.AddrFrom4([4]byte{byte(ip >> 24), byte(ip >> 16), byte(ip >> 8), byte(ip)})
+	ok = addr.String() != host
+
+	return
+}
+
+// parseIPv4Part parses a part of an IPv4 address in inet_aton(3) notation.
+func parseIPv4Part(part string) (value uint64, err error) {
+	base := 10
+
+	switch {
+	case len(part) > 2 && (part[:2] == "0x" || part[:2] == "0X"):
+		part, base = part[2:], 16
+	case len(part) > 1 && part[0] == '0':
+		part, base = part[1:], 8
+	}
+
+	value, err = strconv.ParseUint(part, base, 32)
+
+	return
+}
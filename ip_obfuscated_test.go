@@ -0,0 +1,48 @@
+package url_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	hqgourl "go.source.hueristiq.com/url"
+)
+
+func TestObfuscatedIPv4(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		host       string
+		addr       string
+		obfuscated bool
+	}{
+		{"0x7f.0.0.1", "127.0.0.1", true},
+		{"2130706433", "127.0.0.1", true},
+		{"0x7f000001", "127.0.0.1", true},
+		{"0177.0.0.01", "127.0.0.1", true},
+		{"127.1", "127.0.0.1", true},
+		{"0xC0.0250.1", "192.168.0.1", true},
+		{"127.0.0.1.", "", false},
+		{"127.0.0.1", "", false},
+		{"256.0.0.1", "", false},
+		{"1.2.3.4.5", "", false},
+		{"4294967296", "", false},
+		{"08.0.0.1", "", false},
+		{"0x", "", false},
+		{"example.com", "", false},
+		{"", "", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.host, func(t *testing.T) {
+			t.Parallel()
+
+			addr, ok := hqgourl.ObfuscatedIPv4(tt.host)
+
+			assert.Equal(t, tt.obfuscated, ok)
+
+			if tt.obfuscated {
+				assert.Equal(t, tt.addr, addr.String())
+			}
+		})
+	}
+}
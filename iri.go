@@ -0,0 +1,227 @@
+package url
+
+import (
+	"fmt"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"go.source.hueristiq.com/url/unicodes"
+	"golang.org/x/net/idna"
+)
+
+// IRIToURI converts an IRI (RFC 3987), such as "http://中国.中国/中国", into an ASCII URI
+// that can be fed to clients requiring one, following the mapping of RFC 3987 section 3.1:
+// the host is converted to punycode (e.g., "http://xn--fiqs8s.xn--fiqs8s/%E4%B8%AD%E5%9B%BD")
+// and every other non-ASCII character is percent-encoded as UTF-8. ASCII characters,
+// including existing percent-encodings, are left unchanged.
+//
+// Parameters:
+//   - iri (string): The IRI to convert.
+//
+// Returns:
+//   - uri (string): The ASCII URI.
+//   - err (error): ErrEmptyInput, or ErrInvalidURL (wrapped) if the host cannot be converted.
+func IRIToURI(iri string) (uri string, err error) {
+	if iri == "" {
+		err = fmt.Errorf("%w: IRI", ErrEmptyInput)
+
+		return
+	}
+
+	start, end := hostSpan(iri)
+
+	host := iri[start:end]
+
+	if !isASCII(host) {
+		if host, err = hostToASCII(host); err != nil {
+			err = fmt.Errorf("%w: %w", ErrInvalidURL, err)
+
+			return
+		}
+	}
+
+	uri = percentEncodeNonASCII(iri[:start]) + host + percentEncodeNonASCII(iri[end:])
+
+	return
+}
+
+// emojiProfile is the IDNA profile used for hosts that idna.Lookup rejects only because
+// of the zero width joiners of emoji sequences (e.g., "🏳️‍🌈"), which registries
+// offering emoji domains accept.
+var emojiProfile = idna.New(idna.MapForLookup(), idna.BidiRule(), idna.CheckJoiners(false))
+
+// hostToASCII converts a host to punycode, falling back to emojiProfile for hosts with
+// emoji sequences.
+func hostToASCII(host string) (ASCII string, err error) {
+	ASCII, err = idna.Lookup.ToASCII(host)
+	if err == nil || !strings.ContainsRune(host, '\u200d') {
+		return
+	}
+
+	if emojiASCII, emojiErr := emojiProfile.ToASCII(host); emojiErr == nil {
+		ASCII, err = emojiASCII, nil
+	}
+
+	return
+}
+
+// URIToIRI converts an ASCII URI into an IRI (RFC 3987), following section 3.2: a punycode
+// host is converted back to Unicode, and percent-encoded UTF-8 sequences are decoded when
+// they form characters allowed in IRIs (see unicodes.AllowedUcsCharTable), except for
+// bidirectional formatting characters. Percent-encoded
+// ASCII characters (e.g., "%20" or "%2F") and invalid sequences are left encoded, so the
+// conversion never changes the meaning of the URI.
+//
+// Parameters:
+//   - uri (string): The URI to convert.
+//
+// Returns:
+//   - iri (string): The IRI.
+func URIToIRI(uri string) (iri string) {
+	start, end := hostSpan(uri)
+
+	host := uri[start:end]
+
+	if unicodeHost, err := idna.Lookup.ToUnicode(host); err == nil {
+		host = unicodeHost
+	}
+
+	iri = percentDecodeIRIChars(uri[:start]) + host + percentDecodeIRIChars(uri[end:])
+
+	return
+}
+
+// hostSpan returns the byte offsets of the host (without userinfo or port) in a URL
+// with an authority ("scheme://..." or "//..."), or an empty span if there is none.
+// Bracketed IPv6 hosts are returned with their brackets.
+func hostSpan(s string) (start, end int) {
+	i := strings.Index(s, "//")
+	if i < 0 || strings.ContainsAny(s[:i], "/?#") {
+		return
+	}
+
+	start = i + 2
+	end = start + strings.IndexAny(s[start:], "/?#")
+
+	if end < start {
+		end = len(s)
+	}
+
+	if at := strings.LastIndexByte(s[start:end], '@'); at >= 0 {
+		start += at + 1
+	}
+
+	if strings.HasPrefix(s[start:end], "[") {
+		if bracket := strings.IndexByte(s[start:end], ']'); bracket >= 0 {
+			end = start + bracket + 1
+		}
+
+		return
+	}
+
+	if colon := strings.LastIndexByte(s[start:end], ':'); colon >= 0 {
+		end = start + colon
+	}
+
+	return
+}
+
+// percentEncodeNonASCII percent-encodes the bytes of s that are not ASCII.
+func percentEncodeNonASCII(s string) string {
+	if isASCII(s) {
+		return s
+	}
+
+	var b strings.Builder
+
+	for i := range len(s) {
+		if c := s[i]; c < utf8.RuneSelf {
+			b.WriteByte(c)
+		} else {
+			fmt.Fprintf(&b, "%%%02X", c)
+		}
+	}
+
+	return b.String()
+}
+
+// percentDecodeIRIChars decodes the percent-encoded UTF-8 sequences of s that form
+// characters allowed in IRIs, leaving everything else unchanged.
+func percentDecodeIRIChars(s string) string {
+	if !strings.Contains(s, "%") {
+		return s
+	}
+
+	var b strings.Builder
+
+	for i := 0; i < len(s); {
+		// Collect a run of percent-encoded non-ASCII bytes.
+		var raw []byte
+
+		j := i
+
+		for j+2 < len(s) && s[j] == '%' && isHex(s[j+1]) && isHex(s[j+2]) {
+			c := unhex(s[j+1])<<4 | unhex(s[j+2])
+			if c < utf8.RuneSelf {
+				break
+			}
+
+			raw = append(raw, c)
+			j += 3
+		}
+
+		if len(raw) == 0 {
+			b.WriteByte(s[i])
+			i++
+
+			continue
+		}
+
+		// Decode the run rune by rune; keep invalid or disallowed sequences encoded.
+		for k := 0; k < len(raw); {
+			r, size := utf8.DecodeRune(raw[k:])
+
+			// Bidirectional formatting characters must stay encoded (RFC 3987 section 4.1).
+			if r != utf8.RuneError && unicode.Is(unicodes.AllowedUcsCharTable(), r) && !unicode.Is(unicode.Bidi_Control, r) {
+				b.WriteRune(r)
+			} else {
+				b.WriteString(s[i+3*k : i+3*(k+size)])
+			}
+
+			k += size
+		}
+
+		i = j
+	}
+
+	return b.String()
+}
+
+// isASCII reports whether s only contains ASCII characters.
+func isASCII(s string) bool {
+	for i := range len(s) {
+		if s[i] >= utf8.RuneSelf {
+			return false
+		}
+	}
+
+	return true
+}
+
+// isHex reports whether c is a hexadecimal digit.
+func isHex(c byte) bool {
+	return c >= '0' && c <= '9' || c >= 'a' && c <= 'f' || c >= 'A' && c <= 'F'
+}
+
+// unhex returns the value of the hexadecimal digit c.
+func unhex(c byte) byte {
+	switch {
+	case c >= '0' && c <= '9':
+		return c - '0'
+	case c >= 'a' && c <= 'f':
+		return c - 'a' + 10
+	default:
+		return c - 'A' + 10
+	}
+}
@@ -0,0 +1,71 @@
+package url_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	hqgourl "go.source.hueristiq.com/url"
+)
+
+func TestIRIToURI(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		iri string
+		uri string
+	}{
+		{"http://中国.中国/中国", "http://xn--fiqs8s.xn--fiqs8s/%E4%B8%AD%E5%9B%BD"},
+		{"https://user@bücher.de:8443/straße?q=café#é", "https://user@xn--bcher-kva.de:8443/stra%C3%9Fe?q=caf%C3%A9#%C3%A9"},
+		{"https://example.com/a%20b/ü", "https://example.com/a%20b/%C3%BC"},
+		{"http://[::1]:8080/ü", "http://[::1]:8080/%C3%BC"},
+		{"/relative/ü", "/relative/%C3%BC"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.iri, func(t *testing.T) {
+			t.Parallel()
+
+			uri, err := hqgourl.IRIToURI(tt.iri)
+
+			require.NoError(t, err)
+
+			assert.Equal(t, tt.uri, uri)
+			assert.Equal(t, tt.iri, hqgourl.URIToIRI(uri))
+		})
+	}
+
+	_, err := hqgourl.IRIToURI("")
+
+	require.ErrorIs(t, err, hqgourl.ErrEmptyInput)
+}
+
+func TestIRIToURI_EmojiDomains(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		iri string
+		uri string
+	}{
+		{"http://i❤.ws/", "http://xn--i-7iq.ws/"},
+		{"http://i❤️.ws/", "http://xn--i-7iq.ws/"},
+		{"http://🏳️\u200d🌈.ws/", "http://xn--1ugy405pdua.ws/"},
+	}
+
+	for _, tt := range tests {
+		uri, err := hqgourl.IRIToURI(tt.iri)
+
+		require.NoError(t, err)
+
+		assert.Equal(t, tt.uri, uri)
+	}
+}
+
+func TestURIToIRI_KeepsUnsafeEncodings(t *testing.T) {
+	t.Parallel()
+
+	// Encoded ASCII, invalid UTF-8 and non-IRI characters (U+200E) stay encoded.
+	uri := "https://example.com/a%2Fb%20c/%FF%C3%BC/%E2%80%8E"
+
+	assert.Equal(t, "https://example.com/a%2Fb%20c/%FFü/%E2%80%8E", hqgourl.URIToIRI(uri))
+}
@@ -0,0 +1,242 @@
+package url
+
+import (
+	"fmt"
+	"regexp"
+	"regexp/syntax"
+)
+
+// Diagnostic is an issue found by Lint in a user-supplied pattern.
+type Diagnostic struct {
+	Option   string   // The option the pattern was passed to (e.g., "ExtractorWithHostPattern").
+	Pattern  string   // The pattern.
+	Severity Severity // How serious the issue is.
+	Message  string   // A description of the issue.
+}
+
+// String formats the diagnostic, e.g. "warning: ExtractorWithHostPattern: pattern has a
+// capturing group...".
+func (d Diagnostic) String() string {
+	return string(d.Severity) + ": " + d.Option + ": " + d.Message
+}
+
+// Severity is the severity of a Diagnostic.
+type Severity string
+
+const (
+	// SeverityError marks a pattern that does not compile: CompileRegex would panic.
+	SeverityError Severity = "error"
+	// SeverityWarning marks a pattern that compiles but likely doesn't do what is intended,
+	// or is costly to match.
+	SeverityWarning Severity = "warning"
+)
+
+const (
+	// lintMaxRepeat is the largest counted repetition (e.g., "{1000}") Lint accepts without
+	// warning: the regexp package expands them, so large counts blow up the program.
+	lintMaxRepeat = 100
+	// lintMaxProgramSize is the largest compiled program Lint accepts without warning.
+	lintMaxProgramSize = 5000
+)
+
+// Lint checks the custom scheme and host patterns of the Extractor, if any, for issues
+// that Validate doesn't catch because the patterns compile, but that break or slow down
+// the composite regex they are embedded in:
+//
+//   - Capturing groups, which shift the submatch indexes of the composite regex.
+//   - Anchors ("^", "$", "\A", "\z"), which prevent matching within text.
+//   - Top-level alternations not wrapped in a group, which swallow the rest of the
+//     composite regex.
+//   - Patterns matching the empty string, which make the component optional.
+//   - Nested repetitions (e.g., "(a+)+"), which are catastrophic for backtracking engines
+//     fed with Pattern, and large counted repetitions or programs, which are costly to
+//     compile and match.
+//
+// Patterns that don't compile are reported as errors.
+//
+// Returns:
+//   - diagnostics ([]Diagnostic): The issues found, or nil.
+func (e *Extractor) Lint() (diagnostics []Diagnostic) {
+	diagnostics = append(diagnostics, lintPattern("ExtractorWithSchemePattern", e.withSchemePattern)...)
+	diagnostics = append(diagnostics, lintPattern("ExtractorWithHostPattern", e.withHostPattern)...)
+
+	return
+}
+
+// Lint checks the custom root domain and TLD patterns of the DomainExtractor, if any,
+// like Extractor.Lint.
+//
+// Returns:
+//   - diagnostics ([]Diagnostic): The issues found, or nil.
+func (e *DomainExtractor) Lint() (diagnostics []Diagnostic) {
+	diagnostics = append(diagnostics, lintPattern("DomainExtractorWithRootDomainPattern", e.RootDomainPattern)...)
+	diagnostics = append(diagnostics, lintPattern("DomainExtractorWithTLDPattern", e.TopLevelDomainPattern)...)
+
+	return
+}
+
+// lintPattern checks a user-supplied pattern passed to option.
+func lintPattern(option, pattern string) (diagnostics []Diagnostic) {
+	if pattern == "" {
+		return
+	}
+
+	report := func(severity Severity, format string, args ...any) {
+		diagnostics = append(diagnostics, Diagnostic{
+			Option:   option,
+			Pattern:  pattern,
+			Severity: severity,
+			Message:  fmt.Sprintf(format, args...),
+		})
+	}
+
+	re, err := syntax.Parse(pattern, syntax.Perl)
+	if err != nil {
+		report(SeverityError, "pattern does not compile: %v", err)
+
+		return
+	}
+
+	if captures := re.MaxCap(); captures > 0 {
+		report(SeverityWarning, "pattern has %d capturing group(s), which shift the submatch indexes of the composite regex; use (?:...)", captures)
+	}
+
+	if hasTopLevelAlternation(pattern) {
+		report(SeverityWarning, "pattern has a top-level alternation, which extends to the rest of the composite regex; wrap it in (?:...)")
+	}
+
+	var anchored, nested, largeRepeat bool
+
+	walkRegexp(re, func(node *syntax.Regexp) {
+		switch node.Op {
+		case syntax.OpBeginLine, syntax.OpEndLine, syntax.OpBeginText, syntax.OpEndText:
+			anchored = true
+		case syntax.OpStar, syntax.OpPlus, syntax.OpRepeat:
+			if canRepeatUnbounded(node) && repeatsRepetition(node.Sub[0]) {
+				nested = true
+			}
+
+			if node.Op == syntax.OpRepeat && max(node.Min, node.Max) > lintMaxRepeat {
+				largeRepeat = true
+			}
+		}
+	})
+
+	if anchored {
+		report(SeverityWarning, "pattern is anchored, which prevents matching within text; remove ^, $, \\A and \\z")
+	}
+
+	if nested {
+		report(SeverityWarning, "pattern nests unbounded repetitions, which is catastrophic for backtracking engines fed with Pattern")
+	}
+
+	if largeRepeat {
+		report(SeverityWarning, "pattern has a counted repetition above %d, which is expanded into a large program", lintMaxRepeat)
+	}
+
+	if prog, err := syntax.Compile(re.Simplify()); err == nil && len(prog.Inst) > lintMaxProgramSize {
+		report(SeverityWarning, "pattern compiles to %d instructions (above %d), which is costly to match", len(prog.Inst), lintMaxProgramSize)
+	}
+
+	if matchesEmpty(re) {
+		report(SeverityWarning, "pattern matches the empty string, which makes the component optional")
+	}
+
+	return
+}
+
+// walkRegexp calls fn for each node of the tree rooted at re.
+func walkRegexp(re *syntax.Regexp, fn func(node *syntax.Regexp)) {
+	fn(re)
+
+	for _, sub := range re.Sub {
+		walkRegexp(sub, fn)
+	}
+}
+
+// repeatsRepetition reports whether re, the body of an unbounded repetition, is itself
+// an unbounded repetition, possibly surrounded by parts that can match the empty string
+// (e.g., "(a+)+" or "(?:a*b?)*"): the ways to split an input between both repetitions
+// are then exponential for backtracking engines. Bodies with a mandatory separator
+// (e.g., "(?:[a-z]+\.)+") are not ambiguous.
+func repeatsRepetition(re *syntax.Regexp) bool {
+	for re.Op == syntax.OpCapture {
+		re = re.Sub[0]
+	}
+
+	if canRepeatUnbounded(re) {
+		return true
+	}
+
+	if re.Op != syntax.OpConcat {
+		return false
+	}
+
+	repetition := false
+
+	for _, sub := range re.Sub {
+		switch {
+		case repeatsRepetition(sub):
+			repetition = true
+		case !matchesEmpty(sub):
+			return false
+		}
+	}
+
+	return repetition
+}
+
+// matchesEmpty reports whether re matches the empty string.
+func matchesEmpty(re *syntax.Regexp) bool {
+	matched, _ := regexp.MatchString(`^(?:`+re.String()+`)$`, "")
+
+	return matched
+}
+
+// canRepeatUnbounded reports whether re is a repetition without upper bound.
+func canRepeatUnbounded(re *syntax.Regexp) bool {
+	switch re.Op {
+	case syntax.OpStar, syntax.OpPlus:
+		return true
+	case syntax.OpRepeat:
+		return re.Max < 0
+	}
+
+	return false
+}
+
+// hasTopLevelAlternation reports whether pattern has a "|" outside groups and character
+// classes.
+func hasTopLevelAlternation(pattern string) bool {
+	depth, class := 0, false
+
+	for i := 0; i < len(pattern); i++ {
+		switch c := pattern[i]; {
+		case c == '\\':
+			i++
+		case class:
+			if c == ']' {
+				class = false
+			}
+		case c == '[':
+			class = true
+
+			// A "]" right after "[" or "[^" is a literal.
+			if i+1 < len(pattern) && pattern[i+1] == '^' {
+				i++
+			}
+
+			if i+1 < len(pattern) && pattern[i+1] == ']' {
+				i++
+			}
+		case c == '(':
+			depth++
+		case c == ')':
+			depth--
+		case c == '|' && depth == 0:
+			return true
+		}
+	}
+
+	return false
+}
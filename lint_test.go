@@ -0,0 +1,65 @@
+package url_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	hqgourl "go.source.hueristiq.com/url"
+)
+
+func TestExtractor_Lint(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		opts     []hqgourl.ExtractorOptionFunc
+		severity hqgourl.Severity
+		contains string
+	}{
+		{"Invalid", []hqgourl.ExtractorOptionFunc{hqgourl.ExtractorWithHostPattern(`[`)}, hqgourl.SeverityError, "does not compile"},
+		{"Capturing group", []hqgourl.ExtractorOptionFunc{hqgourl.ExtractorWithHostPattern(`(www\.)?example\.com`)}, hqgourl.SeverityWarning, "capturing group"},
+		{"Top-level alternation", []hqgourl.ExtractorOptionFunc{hqgourl.ExtractorWithScheme(), hqgourl.ExtractorWithSchemePattern(`https://|ftp://`)}, hqgourl.SeverityWarning, "top-level alternation"},
+		{"Anchored", []hqgourl.ExtractorOptionFunc{hqgourl.ExtractorWithHostPattern(`^example\.com$`)}, hqgourl.SeverityWarning, "anchored"},
+		{"Nested repetition", []hqgourl.ExtractorOptionFunc{hqgourl.ExtractorWithHostPattern(`(?:(?:[a-z]+)*\.)+com`)}, hqgourl.SeverityWarning, "nests unbounded repetitions"},
+		{"Large repetition", []hqgourl.ExtractorOptionFunc{hqgourl.ExtractorWithHostPattern(`[a-z]{1,500}\.com`)}, hqgourl.SeverityWarning, "counted repetition"},
+		{"Empty match", []hqgourl.ExtractorOptionFunc{hqgourl.ExtractorWithHostPattern(`(?:example\.com)?`)}, hqgourl.SeverityWarning, "empty string"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			diagnostics := hqgourl.NewExtractor(tt.opts...).Lint()
+
+			require.NotEmpty(t, diagnostics)
+
+			assert.Equal(t, tt.severity, diagnostics[0].Severity)
+			assert.Contains(t, diagnostics[0].Message, tt.contains)
+			assert.Contains(t, diagnostics[0].String(), string(tt.severity)+": Extractor")
+		})
+	}
+
+	assert.Empty(t, hqgourl.NewExtractor().Lint())
+	assert.Empty(t, hqgourl.NewExtractor(hqgourl.ExtractorWithKnownSchemes()).Lint())
+	assert.Empty(t, hqgourl.NewExtractor(
+		hqgourl.ExtractorWithScheme(),
+		hqgourl.ExtractorWithSchemePattern(`(?:https?|ftp)://`),
+		hqgourl.ExtractorWithHostPattern(`(?:[a-z0-9-]+\.)*example\.(?:com|net)`),
+	).Lint())
+}
+
+func TestDomainExtractor_Lint(t *testing.T) {
+	t.Parallel()
+
+	diagnostics := hqgourl.NewDomainExtractor(
+		hqgourl.DomainExtractorWithRootDomainPattern(`(example)`),
+		hqgourl.DomainExtractorWithTLDPattern(`com|net`),
+	).Lint()
+
+	require.Len(t, diagnostics, 2)
+
+	assert.Equal(t, "DomainExtractorWithRootDomainPattern", diagnostics[0].Option)
+	assert.Equal(t, "DomainExtractorWithTLDPattern", diagnostics[1].Option)
+	assert.Empty(t, hqgourl.NewDomainExtractor().Lint())
+}
@@ -0,0 +1,28 @@
+// Package match provides URL pattern matching with a small glob-based DSL, for scoping
+// crawlers to routes, allowlisting endpoints and similar uses where raw regular
+// expressions are error-prone.
+//
+// A pattern has the shape of a URL, "scheme://host:port/path?query", where every part
+// but the host is optional:
+//
+//   - scheme: A scheme or "*". Without "scheme://", any scheme matches.
+//   - host: Dot-separated labels. "*" matches exactly one label and "**" matches any
+//     number of labels, including none; other labels are globs (e.g., "api-*").
+//     Hosts are matched case-insensitively.
+//   - port: A port or "*". Without a port, any port (including none) matches.
+//   - path: Slash-separated segments. "**" matches any number of segments, including
+//     none; other segments are globs (e.g., "*.json"). Without a path, any path matches.
+//   - query: "&"-separated constraints. "name" requires the parameter to be present and
+//     "name=glob" requires one of its values to match the glob. Other parameters are
+//     allowed.
+//
+// Globs follow path.Match: "*" matches any run of characters, "?" any single character
+// and "[...]" a character class.
+//
+// Example:
+//
+//	pattern := match.MustCompile("https://*.example.com/api/**?id=*")
+//
+//	pattern.MatchString("https://www.example.com/api/v1/users?id=42") // true
+//	pattern.MatchString("https://www.example.com/static/app.js")      // false
+package match
@@ -0,0 +1,273 @@
+package match
+
+import (
+	"fmt"
+	"net/url"
+	"path"
+	"strings"
+
+	hqgourl "go.source.hueristiq.com/url"
+)
+
+// Pattern is a compiled URL pattern. A Pattern is immutable and safe for concurrent use.
+type Pattern struct {
+	raw string
+
+	scheme string   // Lowercased; empty matches any scheme.
+	host   []string // Lowercased labels.
+	port   string   // Empty matches any port.
+	path   []string // Nil matches any path.
+	query  []constraint
+}
+
+// constraint is a query parameter constraint.
+type constraint struct {
+	name    string
+	value   string // Glob.
+	present bool   // Only require the parameter to be present.
+}
+
+// wildcard matches any number of host labels or path segments, including none.
+const wildcard = "**"
+
+// Compile parses a pattern into a Pattern that can be matched against URLs.
+//
+// Parameters:
+//   - pattern (string): The pattern (e.g., "https://*.example.com/api/**?id=*").
+//
+// Returns:
+//   - compiled (*Pattern): The compiled pattern.
+//   - err (error): hqgourl.ErrEmptyInput (wrapped) if the pattern is empty, or
+//     hqgourl.ErrInvalidPattern (wrapped) if it is malformed.
+func Compile(pattern string) (compiled *Pattern, err error) {
+	if pattern == "" {
+		err = fmt.Errorf("%w: pattern", hqgourl.ErrEmptyInput)
+
+		return
+	}
+
+	compiled = &Pattern{raw: pattern}
+
+	rest := pattern
+
+	if scheme, after, found := strings.Cut(rest, "://"); found {
+		compiled.scheme = strings.ToLower(scheme)
+
+		if compiled.scheme == "*" {
+			compiled.scheme = ""
+		}
+
+		rest = after
+	}
+
+	rest, query, hasQuery := strings.Cut(rest, "?")
+
+	authority, pathPattern, hasPath := strings.Cut(rest, "/")
+
+	host := authority
+
+	if i := strings.LastIndex(authority, ":"); i >= 0 && !strings.HasSuffix(authority, "]") {
+		host, compiled.port = authority[:i], authority[i+1:]
+
+		if compiled.port == "*" {
+			compiled.port = ""
+		} else if compiled.port == "" {
+			err = fmt.Errorf("%w: empty port in %q", hqgourl.ErrInvalidPattern, pattern)
+
+			return
+		}
+	}
+
+	if host == "" {
+		err = fmt.Errorf("%w: empty host in %q", hqgourl.ErrInvalidPattern, pattern)
+
+		return
+	}
+
+	if strings.HasPrefix(host, "[") && strings.HasSuffix(host, "]") {
+		compiled.host = []string{strings.ToLower(host[1 : len(host)-1])}
+	} else {
+		compiled.host = strings.Split(strings.ToLower(host), ".")
+	}
+
+	if hasPath {
+		compiled.path = strings.Split(pathPattern, "/")
+	}
+
+	if hasQuery && query != "" {
+		for _, part := range strings.Split(query, "&") {
+			name, value, hasValue := strings.Cut(part, "=")
+
+			compiled.query = append(compiled.query, constraint{
+				name:    name,
+				value:   value,
+				present: !hasValue,
+			})
+		}
+	}
+
+	for _, glob := range compiled.globs() {
+		if _, err = path.Match(glob, ""); err != nil {
+			err = fmt.Errorf("%w: %q in %q: %w", hqgourl.ErrInvalidPattern, glob, pattern, err)
+
+			return
+		}
+	}
+
+	return
+}
+
+// MustCompile is like Compile but panics if the pattern is invalid. It simplifies the
+// initialization of global variables holding patterns.
+func MustCompile(pattern string) (compiled *Pattern) {
+	compiled, err := Compile(pattern)
+	if err != nil {
+		panic(err)
+	}
+
+	return
+}
+
+// globs returns all the globs of the pattern, for validation.
+func (p *Pattern) globs() (globs []string) {
+	globs = append(globs, p.host...)
+	globs = append(globs, p.path...)
+
+	for _, c := range p.query {
+		globs = append(globs, c.value)
+	}
+
+	return
+}
+
+// String returns the source text of the pattern.
+func (p *Pattern) String() (pattern string) {
+	pattern = p.raw
+
+	return
+}
+
+// Match reports whether a parsed URL matches the pattern.
+//
+// Parameters:
+//   - URL (*hqgourl.URL): The URL to match.
+//
+// Returns:
+//   - matches (bool): True if every part of the pattern matches the URL.
+func (p *Pattern) Match(URL *hqgourl.URL) (matches bool) {
+	if URL == nil || URL.URL == nil {
+		return
+	}
+
+	matches = p.match(URL.URL)
+
+	return
+}
+
+// MatchString parses raw and reports whether it matches the pattern.
+//
+// Parameters:
+//   - raw (string): The URL to match.
+//
+// Returns:
+//   - matches (bool): True if raw is a valid URL and every part of the pattern matches it.
+func (p *Pattern) MatchString(raw string) (matches bool) {
+	parsed, err := url.Parse(raw)
+	if err != nil {
+		return
+	}
+
+	matches = p.match(parsed)
+
+	return
+}
+
+// match reports whether parsed matches the pattern.
+func (p *Pattern) match(parsed *url.URL) (matches bool) {
+	if p.scheme != "" && !strings.EqualFold(p.scheme, parsed.Scheme) {
+		return
+	}
+
+	host := parsed.Hostname()
+	if host == "" {
+		return
+	}
+
+	if !matchParts(p.host, strings.Split(strings.ToLower(host), ".")) {
+		return
+	}
+
+	if p.port != "" && p.port != parsed.Port() {
+		return
+	}
+
+	if p.path != nil {
+		segments := strings.Split(strings.TrimPrefix(parsed.Path, "/"), "/")
+
+		if !matchParts(p.path, segments) {
+			return
+		}
+	}
+
+	query := parsed.Query()
+
+	for _, c := range p.query {
+		values, ok := query[c.name]
+		if !ok {
+			return
+		}
+
+		if !c.present && !matchAny(c.value, values) {
+			return
+		}
+	}
+
+	matches = true
+
+	return
+}
+
+// matchParts reports whether parts (host labels or path segments) match globs, where a
+// "**" glob matches any number of parts.
+func matchParts(globs, parts []string) (matches bool) {
+	if len(globs) == 0 {
+		matches = len(parts) == 0
+
+		return
+	}
+
+	if globs[0] == wildcard {
+		for i := 0; i <= len(parts); i++ {
+			if matchParts(globs[1:], parts[i:]) {
+				matches = true
+
+				return
+			}
+		}
+
+		return
+	}
+
+	if len(parts) == 0 {
+		return
+	}
+
+	if ok, _ := path.Match(globs[0], parts[0]); !ok {
+		return
+	}
+
+	matches = matchParts(globs[1:], parts[1:])
+
+	return
+}
+
+// matchAny reports whether any of values matches glob.
+func matchAny(glob string, values []string) (matches bool) {
+	for _, value := range values {
+		if matches, _ = path.Match(glob, value); matches {
+			return
+		}
+	}
+
+	return
+}
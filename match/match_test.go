@@ -0,0 +1,91 @@
+package match_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	hqgourl "go.source.hueristiq.com/url"
+	"go.source.hueristiq.com/url/match"
+)
+
+func TestPattern_MatchString(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		pattern string
+		URL     string
+		matches bool
+	}{
+		{"Exact", "https://example.com/a", "https://example.com/a", true},
+		{"Scheme Mismatch", "https://example.com/a", "http://example.com/a", false},
+		{"Any Scheme", "*://example.com/a", "ftp://example.com/a", true},
+		{"No Scheme", "example.com", "http://example.com/x", true},
+		{"Host Case Insensitive", "https://EXAMPLE.com", "https://example.COM/", true},
+		{"Single Label Wildcard", "https://*.example.com", "https://api.example.com", true},
+		{"Single Label Wildcard Depth", "https://*.example.com", "https://a.b.example.com", false},
+		{"Single Label Wildcard Apex", "https://*.example.com", "https://example.com", false},
+		{"Label Wildcard", "https://**.example.com", "https://a.b.example.com", true},
+		{"Label Wildcard Apex", "https://**.example.com", "https://example.com", true},
+		{"Label Glob", "https://api-*.example.com", "https://api-eu.example.com", true},
+		{"Port", "https://example.com:8443", "https://example.com:8443/", true},
+		{"Port Mismatch", "https://example.com:8443", "https://example.com/", false},
+		{"Any Port", "https://example.com:*", "https://example.com:1/", true},
+		{"IPv6", "http://[::1]:8080/*", "http://[::1]:8080/a", true},
+		{"Root Path", "https://example.com/", "https://example.com", true},
+		{"Root Path Mismatch", "https://example.com/", "https://example.com/a", false},
+		{"Segment Wildcard", "https://*.example.com/api/**", "https://www.example.com/api/v1/users", true},
+		{"Segment Wildcard Empty", "https://*.example.com/api/**", "https://www.example.com/api", true},
+		{"Segment Wildcard Mismatch", "https://*.example.com/api/**", "https://www.example.com/static/app.js", false},
+		{"Segment Wildcard Middle", "https://example.com/**/edit", "https://example.com/a/b/edit", true},
+		{"Segment Glob", "https://example.com/*.json", "https://example.com/data.json", true},
+		{"Segment Glob Depth", "https://example.com/*.json", "https://example.com/a/data.json", false},
+		{"Query Value", "https://example.com/**?id=*", "https://example.com/a?id=42&x=1", true},
+		{"Query Missing", "https://example.com/**?id=*", "https://example.com/a?x=1", false},
+		{"Query Exact Value", "https://example.com/**?page=1", "https://example.com/a?page=2", false},
+		{"Query Any Value", "https://example.com/**?page=1", "https://example.com/a?page=2&page=1", true},
+		{"Query Present", "https://example.com/**?debug", "https://example.com/a?debug=", true},
+		{"Invalid URL", "https://example.com", "https://example.com:port", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			pattern, err := match.Compile(tt.pattern)
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.pattern, pattern.String())
+			assert.Equal(t, tt.matches, pattern.MatchString(tt.URL))
+		})
+	}
+}
+
+func TestPattern_Match(t *testing.T) {
+	t.Parallel()
+
+	parsed, err := hqgourl.NewParser(hqgourl.ParserWithDefaultScheme("https")).Parse("www.example.com/api/users")
+
+	require.NoError(t, err)
+
+	assert.True(t, match.MustCompile("https://*.example.com/api/**").Match(parsed))
+	assert.False(t, match.MustCompile("http://*.example.com/api/**").Match(parsed))
+	assert.False(t, match.MustCompile("https://example.com").Match(nil))
+}
+
+func TestCompile_Invalid(t *testing.T) {
+	t.Parallel()
+
+	_, err := match.Compile("")
+
+	require.ErrorIs(t, err, hqgourl.ErrEmptyInput)
+
+	for _, pattern := range []string{"https://", "https://example.com:/", "https://[a-.com", "https://example.com/?id=[x"} {
+		_, err = match.Compile(pattern)
+
+		require.ErrorIs(t, err, hqgourl.ErrInvalidPattern, pattern)
+	}
+
+	assert.Panics(t, func() { match.MustCompile("https://") })
+}
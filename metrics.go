@@ -0,0 +1,85 @@
+package url
+
+import "time"
+
+// Metrics receives the counters and durations of the work done by Extractors and Parsers
+// configured with ExtractorWithMetrics and ParserWithMetrics, so that services embedding
+// the package can export them (e.g., as Prometheus counters and histograms) without
+// wrapping every call. Implementations must be safe for concurrent use, as Extractors and
+// Parsers are, and fast, as they are called on the hot path.
+//
+// Example:
+//
+//	type prometheusMetrics struct{ matches *prometheus.CounterVec /* ... */ }
+//
+//	func (m *prometheusMetrics) AddMatches(matchType hqgourl.MatchType, count int) {
+//	    m.matches.WithLabelValues(string(matchType)).Add(float64(count))
+//	}
+//
+//	extractor := hqgourl.NewExtractor(hqgourl.ExtractorWithMetrics(&prometheusMetrics{ /* ... */ }))
+type Metrics interface {
+	// AddMatches counts matches of the given type found by an Extractor.
+	AddMatches(matchType MatchType, count int)
+	// AddParseErrors counts URLs a Parser failed to parse.
+	AddParseErrors(count int)
+	// AddBytesScanned counts bytes of input scanned by an Extractor.
+	AddBytesScanned(count int)
+	// ObserveDuration records the duration of an extraction (a call to Extract,
+	// ExtractContext or ExtractReader) or of a parse.
+	ObserveDuration(operation Operation, duration time.Duration)
+}
+
+// Operation identifies the operation whose duration is reported to Metrics.
+type Operation string
+
+const (
+	// OperationExtract is the extraction of matches from text or a stream.
+	OperationExtract Operation = "extract"
+	// OperationParse is the parsing of a URL.
+	OperationParse Operation = "parse"
+)
+
+// observeMatches counts matches by type in metrics.
+func observeMatches(metrics Metrics, matches []Match) {
+	if len(matches) == 0 {
+		return
+	}
+
+	counts := map[MatchType]int{}
+
+	for _, match := range matches {
+		counts[match.Type]++
+	}
+
+	for matchType, count := range counts {
+		metrics.AddMatches(matchType, count)
+	}
+}
+
+// observeExtraction reports an extraction that started at start, scanned the given number
+// of bytes and found matches to the Extractor's metrics, if any. Matches reported as they
+// are found (as by ExtractReader) are passed as nil.
+func (e *Extractor) observeExtraction(start time.Time, scanned int, matches []Match) {
+	if e.metrics == nil {
+		return
+	}
+
+	observeMatches(e.metrics, matches)
+
+	e.metrics.AddBytesScanned(scanned)
+	e.metrics.ObserveDuration(OperationExtract, time.Since(start))
+}
+
+// observeParse reports a parse that started at start and returned err to the Parser's
+// metrics, if any.
+func (p *Parser) observeParse(start time.Time, err error) {
+	if p.metrics == nil {
+		return
+	}
+
+	if err != nil {
+		p.metrics.AddParseErrors(1)
+	}
+
+	p.metrics.ObserveDuration(OperationParse, time.Since(start))
+}
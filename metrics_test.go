@@ -0,0 +1,130 @@
+package url_test
+
+import (
+	"context"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	hqgourl "go.source.hueristiq.com/url"
+)
+
+type recordingMetrics struct {
+	mu sync.Mutex
+
+	matches     map[hqgourl.MatchType]int
+	parseErrors int
+	scanned     int
+	durations   map[hqgourl.Operation]int
+}
+
+func newRecordingMetrics() *recordingMetrics {
+	return &recordingMetrics{
+		matches:   map[hqgourl.MatchType]int{},
+		durations: map[hqgourl.Operation]int{},
+	}
+}
+
+func (m *recordingMetrics) AddMatches(matchType hqgourl.MatchType, count int) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.matches[matchType] += count
+}
+
+func (m *recordingMetrics) AddParseErrors(count int) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.parseErrors += count
+}
+
+func (m *recordingMetrics) AddBytesScanned(count int) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.scanned += count
+}
+
+func (m *recordingMetrics) ObserveDuration(operation hqgourl.Operation, _ time.Duration) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.durations[operation]++
+}
+
+func TestExtractorWithMetrics(t *testing.T) {
+	t.Parallel()
+
+	text := "see https://example.com/a, www.example.org and user@example.net"
+
+	expected := map[hqgourl.MatchType]int{
+		hqgourl.MatchTypeURL:   1,
+		hqgourl.MatchTypeHost:  1,
+		hqgourl.MatchTypeEmail: 1,
+	}
+
+	t.Run("Extract", func(t *testing.T) {
+		t.Parallel()
+
+		metrics := newRecordingMetrics()
+
+		hqgourl.NewExtractor(hqgourl.ExtractorWithMetrics(metrics)).Extract(text)
+
+		assert.Equal(t, expected, metrics.matches)
+		assert.Equal(t, len(text), metrics.scanned)
+		assert.Equal(t, map[hqgourl.Operation]int{hqgourl.OperationExtract: 1}, metrics.durations)
+	})
+
+	t.Run("ExtractContext", func(t *testing.T) {
+		t.Parallel()
+
+		metrics := newRecordingMetrics()
+
+		compiled, err := hqgourl.NewExtractor(hqgourl.ExtractorWithMetrics(metrics), hqgourl.ExtractorWithChunkSize(16)).Compile()
+
+		require.NoError(t, err)
+
+		_, err = compiled.ExtractContext(context.Background(), text)
+
+		require.NoError(t, err)
+
+		assert.Equal(t, expected, metrics.matches)
+		assert.Equal(t, len(text), metrics.scanned)
+		assert.Equal(t, map[hqgourl.Operation]int{hqgourl.OperationExtract: 1}, metrics.durations)
+	})
+
+	t.Run("ExtractReader", func(t *testing.T) {
+		t.Parallel()
+
+		metrics := newRecordingMetrics()
+
+		extractor := hqgourl.NewExtractor(hqgourl.ExtractorWithMetrics(metrics), hqgourl.ExtractorWithChunkSize(16))
+
+		err := extractor.ExtractReader(context.Background(), strings.NewReader(text), func(hqgourl.Match) {})
+
+		require.NoError(t, err)
+
+		assert.Equal(t, expected, metrics.matches)
+		assert.Equal(t, len(text), metrics.scanned)
+		assert.Equal(t, map[hqgourl.Operation]int{hqgourl.OperationExtract: 1}, metrics.durations)
+	})
+}
+
+func TestParserWithMetrics(t *testing.T) {
+	t.Parallel()
+
+	metrics := newRecordingMetrics()
+
+	parser := hqgourl.NewParser(hqgourl.ParserWithMetrics(metrics), hqgourl.ParserWithCache(8))
+
+	for _, raw := range []string{"https://example.com", "https://example.com", "", "http://[::1"} {
+		_, _ = parser.Parse(raw)
+	}
+
+	assert.Equal(t, 2, metrics.parseErrors)
+	assert.Equal(t, map[hqgourl.Operation]int{hqgourl.OperationParse: 4}, metrics.durations)
+}
@@ -0,0 +1,6 @@
+// Package mimetypes maps file extensions to media (MIME) types, to guess the type of the
+// content a URL serves from its path (e.g., to triage crawled URLs) without a request.
+//
+// The table is autogenerated from the media types table of the media-types Debian package,
+// with the types served by browsers for web content (e.g., "text/javascript" for ".js").
+package mimetypes
@@ -0,0 +1,23 @@
+package mimetypes
+
+import "strings"
+
+// TypeByExtension returns the media type of a file extension. The lookup is
+// case-insensitive and accepts extensions with or without leading dot (e.g., ".JSON" or
+// "json").
+//
+// Parameters:
+//   - extension (string): The file extension (e.g., ".json").
+//
+// Returns:
+//   - contentType (string): The media type (e.g., "application/json"), or an empty string
+//     if the extension is unknown.
+func TypeByExtension(extension string) (contentType string) {
+	if extension == "" {
+		return
+	}
+
+	contentType = types["."+strings.ToLower(strings.TrimPrefix(extension, "."))]
+
+	return
+}
@@ -0,0 +1,32 @@
+package mimetypes_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.source.hueristiq.com/url/mimetypes"
+)
+
+func TestTypeByExtension(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		extension   string
+		contentType string
+	}{
+		{".js", "text/javascript"},
+		{"JSON", "application/json"},
+		{".html", "text/html"},
+		{".woff2", "font/woff2"},
+		{".unknownext", ""},
+		{"", ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.extension, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, tt.contentType, mimetypes.TypeByExtension(tt.extension))
+		})
+	}
+}
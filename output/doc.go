@@ -0,0 +1,18 @@
+// Package output writes extraction results, deduplicated and sorted, to files or any
+// io.Writer: the boilerplate every command-line tool built on this module otherwise
+// rewrites. Files are replaced atomically, or appended to without repeating the values
+// they already hold, and can be gzip-compressed.
+//
+// Example:
+//
+//	writer := output.NewFileWriter("urls.txt.gz", output.WithGzip())
+//
+//	err := extractor.ExtractReader(ctx, os.Stdin, writer.AddMatch)
+//	if err != nil {
+//	    log.Fatal(err)
+//	}
+//
+//	if err := writer.Close(); err != nil {
+//	    log.Fatal(err)
+//	}
+package output
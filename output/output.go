@@ -0,0 +1,347 @@
+package output
+
+import (
+	"bufio"
+	"compress/gzip"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"sync"
+
+	hqgourl "go.source.hueristiq.com/url"
+)
+
+// ErrClosed is returned when a Writer is closed more than once.
+var ErrClosed = errors.New("output writer already closed")
+
+// Writer collects values (e.g., the values of matches), deduplicated, and writes them on
+// Close, sorted, one per line. Values are kept in memory until then. A Writer is safe for
+// concurrent use.
+type Writer struct {
+	mu sync.Mutex
+
+	options *Options
+
+	w    io.Writer // The destination, unless writing to path.
+	path string    // The destination file, unless writing to w.
+
+	values []string
+	seen   map[string]struct{}
+	closed bool
+}
+
+// Options holds the configuration of a Writer.
+type Options struct {
+	Append bool // Whether to append to the file, without repeating its values, instead of replacing it.
+	Gzip   bool // Whether to gzip-compress the output.
+	Sort   bool // Whether to sort the values; otherwise, they are written in the order first added.
+}
+
+// OptionFunc defines a function type for configuring the Options of a Writer.
+type OptionFunc func(*Options)
+
+// WithAppend returns an option function that makes a Writer writing to a file append the
+// values it doesn't hold yet, instead of replacing it. The existing values are read (and
+// decompressed, with WithGzip) on Close; the appended ones are sorted among themselves.
+func WithAppend() OptionFunc {
+	return func(o *Options) {
+		o.Append = true
+	}
+}
+
+// WithGzip returns an option function that makes a Writer gzip-compress its output. When
+// appending, a new gzip member is appended, which gzip readers decompress transparently.
+func WithGzip() OptionFunc {
+	return func(o *Options) {
+		o.Gzip = true
+	}
+}
+
+// WithoutSort returns an option function that makes a Writer write values in the order
+// they were first added, instead of sorting them.
+func WithoutSort() OptionFunc {
+	return func(o *Options) {
+		o.Sort = false
+	}
+}
+
+// newWriter applies opts over the default options.
+func newWriter(opts ...OptionFunc) (writer *Writer) {
+	writer = &Writer{
+		options: &Options{
+			Sort: true,
+		},
+		seen: map[string]struct{}{},
+	}
+
+	for _, opt := range opts {
+		opt(writer.options)
+	}
+
+	return
+}
+
+// NewWriter creates a Writer writing to w on Close. WithAppend doesn't apply.
+//
+// Parameters:
+//   - w (io.Writer): The destination.
+//   - opts (variadic OptionFunc): Options configuring the Writer.
+//
+// Returns:
+//   - writer (*Writer): The Writer.
+func NewWriter(w io.Writer, opts ...OptionFunc) (writer *Writer) {
+	writer = newWriter(opts...)
+
+	writer.w = w
+
+	return
+}
+
+// NewFileWriter creates a Writer writing to the file at path on Close: by default, the
+// file is replaced atomically, through a temporary file in the same directory renamed
+// over it, so readers never see partial results.
+//
+// Parameters:
+//   - path (string): The path of the destination file.
+//   - opts (variadic OptionFunc): Options configuring the Writer.
+//
+// Returns:
+//   - writer (*Writer): The Writer.
+func NewFileWriter(path string, opts ...OptionFunc) (writer *Writer) {
+	writer = newWriter(opts...)
+
+	writer.path = path
+
+	return
+}
+
+// Add adds values, ignoring empty and already added ones. Line breaks in values are
+// percent-encoded, so that each value stays on one line.
+//
+// Parameters:
+//   - values (variadic string): The values.
+func (w *Writer) Add(values ...string) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	if w.closed {
+		return
+	}
+
+	for _, value := range values {
+		value = strings.NewReplacer("\r", "%0D", "\n", "%0A").Replace(value)
+
+		if _, ok := w.seen[value]; ok || value == "" {
+			continue
+		}
+
+		w.seen[value] = struct{}{}
+		w.values = append(w.values, value)
+	}
+}
+
+// AddMatch adds the value of a match. Its signature fits the callback of
+// hqgourl.Extractor.ExtractReader.
+//
+// Parameters:
+//   - match (hqgourl.Match): The match.
+func (w *Writer) AddMatch(match hqgourl.Match) {
+	w.Add(match.Value)
+}
+
+// Len returns the number of distinct values added so far.
+//
+// Returns:
+//   - count (int): The number of values.
+func (w *Writer) Len() (count int) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	count = len(w.values)
+
+	return
+}
+
+// Close writes the values to the destination. Values added afterwards are ignored.
+//
+// Returns:
+//   - err (error): ErrClosed if the Writer was already closed, or an error if the values
+//     cannot be written.
+func (w *Writer) Close() (err error) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	if w.closed {
+		err = ErrClosed
+
+		return
+	}
+
+	w.closed = true
+
+	if w.options.Sort {
+		slices.Sort(w.values)
+	}
+
+	switch {
+	case w.path == "":
+		err = w.write(w.w, w.values)
+	case w.options.Append:
+		err = w.append()
+	default:
+		err = w.replace()
+	}
+
+	return
+}
+
+// replace writes the values to a temporary file, renamed over the destination file.
+func (w *Writer) replace() (err error) {
+	temporary, err := os.CreateTemp(filepath.Dir(w.path), "."+filepath.Base(w.path)+".*.tmp")
+	if err != nil {
+		return
+	}
+
+	defer func() {
+		if err != nil {
+			_ = temporary.Close()
+			_ = os.Remove(temporary.Name())
+		}
+	}()
+
+	if err = w.write(temporary, w.values); err != nil {
+		return
+	}
+
+	if err = temporary.Sync(); err != nil {
+		return
+	}
+
+	if err = temporary.Close(); err != nil {
+		return
+	}
+
+	// os.CreateTemp creates files readable by the owner only.
+	if err = os.Chmod(temporary.Name(), 0o644); err != nil { //nolint:gosec // Results are world-readable, as files created by os.Create.
+		return
+	}
+
+	err = os.Rename(temporary.Name(), w.path)
+
+	return
+}
+
+// append appends the values the destination file doesn't hold yet.
+func (w *Writer) append() (err error) {
+	existing, err := w.read()
+	if err != nil {
+		return
+	}
+
+	values := slices.DeleteFunc(w.values, func(value string) bool {
+		_, ok := existing[value]
+
+		return ok
+	})
+
+	if len(values) == 0 {
+		return
+	}
+
+	file, err := os.OpenFile(w.path, os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0o644) //nolint:gosec // The path is chosen by the caller.
+	if err != nil {
+		return
+	}
+
+	err = errors.Join(w.write(file, values), file.Close())
+
+	return
+}
+
+// read returns the set of the values the destination file holds, empty if it doesn't
+// exist.
+func (w *Writer) read() (values map[string]struct{}, err error) {
+	values = map[string]struct{}{}
+
+	file, err := os.Open(w.path)
+	if errors.Is(err, fs.ErrNotExist) {
+		err = nil
+
+		return
+	}
+
+	if err != nil {
+		return
+	}
+
+	defer file.Close()
+
+	var r io.Reader = file
+
+	if w.options.Gzip {
+		var decompressor *gzip.Reader
+
+		if decompressor, err = gzip.NewReader(file); errors.Is(err, io.EOF) {
+			// An empty file.
+			err = nil
+
+			return
+		} else if err != nil {
+			err = fmt.Errorf("error reading %s: %w", w.path, err)
+
+			return
+		}
+
+		defer decompressor.Close()
+
+		r = decompressor
+	}
+
+	scanner := bufio.NewScanner(r)
+
+	scanner.Buffer(nil, 1<<20)
+
+	for scanner.Scan() {
+		values[scanner.Text()] = struct{}{}
+	}
+
+	if err = scanner.Err(); err != nil {
+		err = fmt.Errorf("error reading %s: %w", w.path, err)
+	}
+
+	return
+}
+
+// write writes values to dst, one per line, gzip-compressed if configured.
+func (w *Writer) write(dst io.Writer, values []string) (err error) {
+	var compressor *gzip.Writer
+
+	if w.options.Gzip {
+		compressor = gzip.NewWriter(dst)
+
+		dst = compressor
+	}
+
+	buffered := bufio.NewWriter(dst)
+
+	for _, value := range values {
+		if _, err = buffered.WriteString(value + "\n"); err != nil {
+			return
+		}
+	}
+
+	if err = buffered.Flush(); err != nil {
+		return
+	}
+
+	if compressor != nil {
+		err = compressor.Close()
+	}
+
+	return
+}
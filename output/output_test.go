@@ -0,0 +1,109 @@
+package output_test
+
+import (
+	"bytes"
+	"compress/gzip"
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	hqgourl "go.source.hueristiq.com/url"
+	"go.source.hueristiq.com/url/output"
+)
+
+func TestWriter(t *testing.T) {
+	t.Parallel()
+
+	var buf bytes.Buffer
+
+	writer := output.NewWriter(&buf)
+
+	writer.Add("https://b.example.com", "https://a.example.com", "", "https://b.example.com", "line\nbreak")
+	writer.AddMatch(hqgourl.Match{Value: "https://a.example.com"})
+
+	assert.Equal(t, 3, writer.Len())
+
+	require.NoError(t, writer.Close())
+	require.ErrorIs(t, writer.Close(), output.ErrClosed)
+
+	writer.Add("https://ignored.example.com")
+
+	assert.Equal(t, "https://a.example.com\nhttps://b.example.com\nline%0Abreak\n", buf.String())
+
+	buf.Reset()
+
+	writer = output.NewWriter(&buf, output.WithoutSort())
+
+	writer.Add("b", "a", "b")
+
+	require.NoError(t, writer.Close())
+
+	assert.Equal(t, "b\na\n", buf.String())
+}
+
+func TestFileWriter(t *testing.T) {
+	t.Parallel()
+
+	path := filepath.Join(t.TempDir(), "urls.txt")
+
+	require.NoError(t, os.WriteFile(path, []byte("old\n"), 0o600))
+
+	writer := output.NewFileWriter(path)
+
+	writer.Add("b", "a")
+
+	require.NoError(t, writer.Close())
+
+	data, err := os.ReadFile(path)
+
+	require.NoError(t, err)
+	assert.Equal(t, "a\nb\n", string(data))
+
+	writer = output.NewFileWriter(path, output.WithAppend())
+
+	writer.Add("c", "a", "d")
+
+	require.NoError(t, writer.Close())
+
+	data, err = os.ReadFile(path)
+
+	require.NoError(t, err)
+	assert.Equal(t, "a\nb\nc\nd\n", string(data))
+
+	entries, err := os.ReadDir(filepath.Dir(path))
+
+	require.NoError(t, err)
+	assert.Len(t, entries, 1) // No temporary file left behind.
+}
+
+func TestFileWriter_Gzip(t *testing.T) {
+	t.Parallel()
+
+	path := filepath.Join(t.TempDir(), "urls.txt.gz")
+
+	for _, values := range [][]string{{"b", "a"}, {"a", "c"}} {
+		writer := output.NewFileWriter(path, output.WithGzip(), output.WithAppend())
+
+		writer.Add(values...)
+
+		require.NoError(t, writer.Close())
+	}
+
+	file, err := os.Open(path)
+
+	require.NoError(t, err)
+
+	defer file.Close()
+
+	decompressor, err := gzip.NewReader(file)
+
+	require.NoError(t, err)
+
+	data, err := io.ReadAll(decompressor)
+
+	require.NoError(t, err)
+	assert.Equal(t, "a\nb\nc\n", string(data))
+}
@@ -0,0 +1,21 @@
+// Package permutations generates candidate names from a parsed Domain, e.g. the names of
+// cloud storage buckets and apps an organization may own, to feed cloud asset discovery
+// and enumeration tools.
+//
+// Names are generated from templates, in which the placeholders are replaced by the
+// components of the domain and by words commonly used in resource names:
+//
+//   - {sld}: The second-level domain (e.g., "example").
+//   - {tld}: The top-level domain (e.g., "co.uk").
+//   - {subdomain}: The whole subdomain (e.g., "eu.assets").
+//   - {label}: Each label of the subdomain in turn (e.g., "eu", then "assets").
+//   - {word}: Each word in turn (e.g., "prod", "backup").
+//
+// Example:
+//
+//	domain := hqgourl.NewDomainParser().Parse("assets.example.com")
+//
+//	for _, name := range permutations.CloudNames(domain) {
+//	    fmt.Println(name) // example, example-assets, assets-example, example-prod, ...
+//	}
+package permutations
@@ -0,0 +1,148 @@
+package permutations
+
+import (
+	"regexp"
+	"strings"
+
+	hqgourl "go.source.hueristiq.com/url"
+)
+
+// DefaultTemplates are the templates used by CloudNames unless WithTemplates is given.
+var DefaultTemplates = []string{
+	"{sld}",
+	"{sld}.{tld}",
+	"{subdomain}.{sld}.{tld}",
+	"{sld}-{label}",
+	"{label}-{sld}",
+	"{sld}{label}",
+	"{label}.{sld}",
+	"{sld}-{word}",
+	"{word}-{sld}",
+	"{sld}{word}",
+	"{sld}.{word}",
+	"{sld}-{label}-{word}",
+	"{label}-{sld}-{word}",
+}
+
+// DefaultWords are the words used by CloudNames unless WithWords is given: environments,
+// content kinds and functions that commonly appear in the names of cloud resources.
+var DefaultWords = []string{
+	"prod", "production", "stage", "staging", "dev", "development", "test", "qa", "uat", "sandbox",
+	"backup", "backups", "archive", "logs", "data", "db", "dump",
+	"assets", "static", "media", "images", "uploads", "files", "cdn", "public", "private", "internal",
+	"web", "www", "app", "api",
+}
+
+// Options holds the templates and words CloudNames generates names from.
+type Options struct {
+	Templates []string
+	Words     []string
+}
+
+// OptionFunc defines a function type for configuring the Options of CloudNames.
+type OptionFunc func(*Options)
+
+// WithTemplates returns an option function that sets the templates names are generated
+// from, replacing DefaultTemplates.
+func WithTemplates(templates ...string) OptionFunc {
+	return func(o *Options) {
+		o.Templates = templates
+	}
+}
+
+// WithWords returns an option function that sets the words substituted for {word},
+// replacing DefaultWords.
+func WithWords(words ...string) OptionFunc {
+	return func(o *Options) {
+		o.Words = words
+	}
+}
+
+// bucketNameRegex matches the names valid for storage buckets across the major cloud
+// providers: 3 to 63 lowercase letters, digits, hyphens and dots, starting and ending
+// with a letter or digit.
+var bucketNameRegex = regexp.MustCompile(`^[a-z0-9][a-z0-9.-]{1,61}[a-z0-9]$`)
+
+// CloudNames generates candidate cloud resource names from a domain by expanding the
+// templates. Names are lowercased; names that are not valid bucket names (3 to 63
+// lowercase letters, digits, hyphens and dots, starting and ending with a letter or digit,
+// without consecutive dots) are dropped, as are templates with placeholders the domain
+// has no value for (e.g., {label} for a domain without subdomain). Names are returned in
+// template order, without duplicates.
+//
+// Parameters:
+//   - domain (*hqgourl.Domain): The domain.
+//   - opts (variadic OptionFunc): Options setting the templates and words.
+//
+// Returns:
+//   - names ([]string): The candidate names.
+func CloudNames(domain *hqgourl.Domain, opts ...OptionFunc) (names []string) {
+	if domain == nil || domain.SLD == "" {
+		return
+	}
+
+	options := &Options{
+		Templates: DefaultTemplates,
+		Words:     DefaultWords,
+	}
+
+	for _, opt := range opts {
+		opt(options)
+	}
+
+	var labels []string
+
+	if domain.Subdomain != "" {
+		labels = strings.Split(strings.ToLower(domain.Subdomain), ".")
+	}
+
+	fixed := strings.NewReplacer(
+		"{sld}", strings.ToLower(domain.SLD),
+		"{tld}", strings.ToLower(domain.TLD),
+		"{subdomain}", strings.ToLower(domain.Subdomain),
+	)
+
+	seen := map[string]bool{}
+
+	for _, template := range options.Templates {
+		if (strings.Contains(template, "{subdomain}") || strings.Contains(template, "{label}")) && len(labels) == 0 {
+			continue
+		}
+
+		if strings.Contains(template, "{tld}") && domain.TLD == "" {
+			continue
+		}
+
+		for _, name := range expand(fixed.Replace(template), "{label}", labels) {
+			for _, name := range expand(name, "{word}", options.Words) {
+				name = strings.ToLower(name)
+
+				if seen[name] || !bucketNameRegex.MatchString(name) || strings.Contains(name, "..") {
+					continue
+				}
+
+				seen[name] = true
+
+				names = append(names, name)
+			}
+		}
+	}
+
+	return
+}
+
+// expand returns template with placeholder replaced by each of values in turn, or
+// template alone if it doesn't contain placeholder.
+func expand(template, placeholder string, values []string) (expanded []string) {
+	if !strings.Contains(template, placeholder) {
+		expanded = []string{template}
+
+		return
+	}
+
+	for _, value := range values {
+		expanded = append(expanded, strings.ReplaceAll(template, placeholder, value))
+	}
+
+	return
+}
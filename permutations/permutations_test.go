@@ -0,0 +1,54 @@
+package permutations_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	hqgourl "go.source.hueristiq.com/url"
+	"go.source.hueristiq.com/url/permutations"
+)
+
+func TestCloudNames(t *testing.T) {
+	t.Parallel()
+
+	domain := hqgourl.NewDomain("assets", "example", "com")
+
+	names := permutations.CloudNames(domain)
+
+	for _, expected := range []string{"example", "example.com", "assets.example.com", "example-assets", "assets-example", "example-prod", "backup-example", "example-assets-backup"} {
+		assert.Contains(t, names, expected)
+	}
+
+	assert.Equal(t, "example", names[0])
+}
+
+func TestCloudNames_Templates(t *testing.T) {
+	t.Parallel()
+
+	domain := hqgourl.NewDomain("EU.Static", "Example", "co.uk")
+
+	names := permutations.CloudNames(domain,
+		permutations.WithTemplates("{sld}-{label}-{word}", "{sld}-{word}", "{sld}", "{sld}", "-{sld}", "{sld}..{word}"),
+		permutations.WithWords("prod", "dev"),
+	)
+
+	assert.Equal(t, []string{
+		"example-eu-prod",
+		"example-eu-dev",
+		"example-static-prod",
+		"example-static-dev",
+		"example-prod",
+		"example-dev",
+		"example",
+	}, names)
+}
+
+func TestCloudNames_NoSubdomain(t *testing.T) {
+	t.Parallel()
+
+	names := permutations.CloudNames(hqgourl.NewDomain("", "example", "com"), permutations.WithTemplates("{sld}-{label}", "{subdomain}.{sld}", "{sld}"))
+
+	assert.Equal(t, []string{"example"}, names)
+	assert.Empty(t, permutations.CloudNames(nil))
+	assert.Empty(t, permutations.CloudNames(hqgourl.NewDomain("", "", "com")))
+}
@@ -0,0 +1,120 @@
+package url
+
+import (
+	"regexp"
+	"slices"
+	"strings"
+)
+
+// regexTrieNode is a node of the prefix trie used by anyOf to build minimized
+// alternations. Each node maps the next rune to a child node and records whether
+// a complete string terminates at the node.
+type regexTrieNode struct {
+	children map[rune]*regexTrieNode
+	terminal bool
+}
+
+// insert adds s to the trie rooted at n.
+func (n *regexTrieNode) insert(s string) {
+	node := n
+
+	for _, r := range s {
+		child, ok := node.children[r]
+		if !ok {
+			child = newRegexTrieNode()
+
+			node.children[r] = child
+		}
+
+		node = child
+	}
+
+	node.terminal = true
+}
+
+// pattern renders the subtree rooted at n as a regular expression matching exactly
+// the suffixes stored below n. Children are visited in rune order so that the output
+// is deterministic.
+func (n *regexTrieNode) pattern() (pattern string) {
+	if len(n.children) == 0 {
+		return
+	}
+
+	keys := make([]rune, 0, len(n.children))
+
+	for r := range n.children {
+		keys = append(keys, r)
+	}
+
+	slices.Sort(keys)
+
+	var leaves []rune
+
+	var alternatives []string
+
+	for _, r := range keys {
+		child := n.children[r]
+
+		// Leaves are collapsed into a single character class.
+		if len(child.children) == 0 {
+			leaves = append(leaves, r)
+
+			continue
+		}
+
+		alternatives = append(alternatives, regexp.QuoteMeta(string(r))+child.pattern())
+	}
+
+	switch len(leaves) {
+	case 0:
+	case 1:
+		alternatives = append(alternatives, regexp.QuoteMeta(string(leaves[0])))
+	default:
+		var class strings.Builder
+
+		class.WriteByte('[')
+
+		for _, r := range leaves {
+			class.WriteString(quoteClassRune(r))
+		}
+
+		class.WriteByte(']')
+
+		alternatives = append(alternatives, class.String())
+	}
+
+	pattern = strings.Join(alternatives, "|")
+
+	switch {
+	case n.terminal && len(keys) == 1 && len(leaves) == 1:
+		// A single optional character needs no group.
+		pattern += "?"
+	case n.terminal:
+		pattern = "(?:" + pattern + ")?"
+	case len(alternatives) > 1:
+		pattern = "(?:" + pattern + ")"
+	}
+
+	return
+}
+
+// newRegexTrieNode returns an empty regexTrieNode.
+func newRegexTrieNode() (node *regexTrieNode) {
+	node = &regexTrieNode{
+		children: map[rune]*regexTrieNode{},
+	}
+
+	return
+}
+
+// quoteClassRune escapes r for use inside a regex character class.
+func quoteClassRune(r rune) (quoted string) {
+	switch r {
+	case '\\', ']', '[', '^', '-':
+		quoted = `\` + string(r)
+	default:
+		quoted = string(r)
+	}
+
+	return
+}
@@ -0,0 +1,48 @@
+package url
+
+import (
+	"regexp"
+	"strings"
+	"testing"
+
+	"go.source.hueristiq.com/url/tlds"
+)
+
+// plainAnyOf builds the flat alternation of strs that anyOf replaces, for comparison.
+func plainAnyOf(strs ...string) string {
+	quoted := make([]string, len(strs))
+
+	for i, s := range strs {
+		quoted[i] = regexp.QuoteMeta(s)
+	}
+
+	return "(?:" + strings.Join(quoted, "|") + ")"
+}
+
+// benchmarkTLDAlternation measures matching the known TLDs, as the domain pattern does,
+// with the alternation built by build, and reports the length of its pattern.
+func benchmarkTLDAlternation(b *testing.B, build func(strs ...string) string) {
+	TLDs := append(append([]string{}, tlds.Official...), tlds.Pseudo...)
+
+	pattern := build(TLDs...)
+	regex := regexp.MustCompile(`(?i)\.` + pattern + `\b`)
+
+	text := strings.Repeat("Contact admin@example.com, see https://www.example.co.uk/path?query=1 and sub.example.museum for details. ", 100)
+
+	b.SetBytes(int64(len(text)))
+	b.ResetTimer()
+
+	for range b.N {
+		regex.FindAllStringIndex(text, -1)
+	}
+
+	b.ReportMetric(float64(len(pattern)), "pattern-bytes")
+}
+
+func BenchmarkAnyOf_Trie(b *testing.B) {
+	benchmarkTLDAlternation(b, anyOf)
+}
+
+func BenchmarkAnyOf_Plain(b *testing.B) {
+	benchmarkTLDAlternation(b, plainAnyOf)
+}
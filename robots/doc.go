@@ -0,0 +1,20 @@
+// Package robots parses robots.txt files and evaluates their rules against URLs, following
+// the Robots Exclusion Protocol (RFC 9309):
+//
+//   - A crawler obeys the groups whose user-agent matches its product token
+//     (case-insensitively), or else the "*" groups. Without matching groups, everything is
+//     allowed.
+//   - The rule with the longest matching path pattern wins; if an allow and a disallow rule
+//     match with patterns of the same length, the allow rule wins.
+//   - In path patterns, "*" matches any sequence of characters and a trailing "$" anchors
+//     the pattern at the end of the path.
+//   - "/robots.txt" itself is always allowed.
+//
+// Example:
+//
+//	rules, err := robots.Parse(strings.NewReader("User-agent: *\nDisallow: /private/\nAllow: /private/public$\n"))
+//
+//	URL, _ := hqgourl.NewParser().Parse("https://example.com/private/data")
+//
+//	rules.Allowed("MyCrawler/1.0", URL) // false
+package robots
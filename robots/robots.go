@@ -0,0 +1,272 @@
+package robots
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"net/url"
+	"slices"
+	"strings"
+
+	hqgourl "go.source.hueristiq.com/url"
+)
+
+// Robots holds the rules of a parsed robots.txt file. A Robots is immutable and safe for
+// concurrent use.
+type Robots struct {
+	groups []group
+
+	// Sitemaps lists the URLs of the "Sitemap" lines, in file order.
+	Sitemaps []string
+}
+
+// group is a group of rules applying to a set of user-agents.
+type group struct {
+	userAgents []string // Lowercased product tokens.
+	rules      []rule
+}
+
+// rule is an allow or disallow rule.
+type rule struct {
+	allow   bool
+	pattern string
+}
+
+// Parse reads a robots.txt file. Lines that are malformed or unknown are ignored, as are
+// rules that precede the first user-agent line.
+//
+// Parameters:
+//   - r (io.Reader): The robots.txt content.
+//
+// Returns:
+//   - robots (*Robots): The parsed rules.
+//   - err (error): An error if r cannot be read.
+func Parse(r io.Reader) (robots *Robots, err error) {
+	robots = &Robots{}
+
+	scanner := bufio.NewScanner(r)
+
+	var current *group
+
+	// Consecutive user-agent lines start a single group.
+	startsGroup := true
+
+	for scanner.Scan() {
+		line, _, _ := strings.Cut(scanner.Text(), "#")
+
+		key, value, found := strings.Cut(line, ":")
+		if !found {
+			continue
+		}
+
+		key = strings.ToLower(strings.TrimSpace(key))
+		value = strings.TrimSpace(value)
+
+		switch key {
+		case "user-agent":
+			if startsGroup {
+				robots.groups = append(robots.groups, group{})
+
+				current = &robots.groups[len(robots.groups)-1]
+
+				startsGroup = false
+			}
+
+			current.userAgents = append(current.userAgents, strings.ToLower(productToken(value)))
+		case "allow", "disallow":
+			startsGroup = true
+
+			// An empty disallow rule matches nothing.
+			if current == nil || value == "" {
+				continue
+			}
+
+			current.rules = append(current.rules, rule{
+				allow:   key == "allow",
+				pattern: normalizePattern(value),
+			})
+		case "sitemap":
+			robots.Sitemaps = append(robots.Sitemaps, value)
+		}
+	}
+
+	if err = scanner.Err(); err != nil {
+		err = fmt.Errorf("error reading input: %w", err)
+	}
+
+	return
+}
+
+// Allowed reports whether the crawler identified by userAgent may fetch URL.
+//
+// Parameters:
+//   - userAgent (string): The crawler's user-agent (e.g., "MyCrawler/1.0"); its product
+//     token ("MyCrawler") is matched against the user-agent lines.
+//   - URL (*hqgourl.URL): The URL to check.
+//
+// Returns:
+//   - allowed (bool): True if no rule disallows the URL for the crawler.
+func (r *Robots) Allowed(userAgent string, URL *hqgourl.URL) (allowed bool) {
+	if URL == nil || URL.URL == nil {
+		return
+	}
+
+	allowed = r.AllowedPath(userAgent, pathAndQuery(URL.URL))
+
+	return
+}
+
+// AllowedPath is like Allowed, but takes the escaped path (and query) of the URL to check,
+// e.g. "/search?q=go".
+func (r *Robots) AllowedPath(userAgent, path string) (allowed bool) {
+	allowed = true
+
+	if path == "/robots.txt" {
+		return
+	}
+
+	longest := -1
+
+	for _, rule := range r.rules(userAgent) {
+		if len(rule.pattern) < longest || !matches(rule.pattern, path) {
+			continue
+		}
+
+		if len(rule.pattern) > longest {
+			longest, allowed = len(rule.pattern), rule.allow
+		} else {
+			allowed = allowed || rule.allow
+		}
+	}
+
+	return
+}
+
+// rules returns the rules of the groups applying to userAgent.
+func (r *Robots) rules(userAgent string) (rules []rule) {
+	token := strings.ToLower(productToken(userAgent))
+
+	var fallback []rule
+
+	matched := false
+
+	for _, group := range r.groups {
+		switch {
+		case slices.Contains(group.userAgents, token):
+			matched = true
+
+			rules = append(rules, group.rules...)
+		case slices.Contains(group.userAgents, "*"):
+			fallback = append(fallback, group.rules...)
+		}
+	}
+
+	if !matched {
+		rules = fallback
+	}
+
+	return
+}
+
+// productToken returns the product token of a user-agent, e.g. "Googlebot" for
+// "Googlebot/2.1 (+http://www.google.com/bot.html)".
+func productToken(userAgent string) (token string) {
+	userAgent = strings.TrimSpace(userAgent)
+
+	if userAgent == "*" {
+		token = userAgent
+
+		return
+	}
+
+	end := strings.IndexFunc(userAgent, func(r rune) bool {
+		return (r < 'a' || r > 'z') && (r < 'A' || r > 'Z') && r != '_' && r != '-'
+	})
+
+	if end < 0 {
+		end = len(userAgent)
+	}
+
+	token = userAgent[:end]
+
+	return
+}
+
+// normalizePattern percent-encodes the characters of a path pattern that a URL's escaped
+// path would encode, so that e.g. "/café" matches "/caf%C3%A9".
+func normalizePattern(pattern string) (normalized string) {
+	var builder strings.Builder
+
+	for i := range len(pattern) {
+		if c := pattern[i]; c >= 0x80 || c <= 0x20 || c == 0x7F {
+			fmt.Fprintf(&builder, "%%%02X", c)
+
+			continue
+		}
+
+		builder.WriteByte(pattern[i])
+	}
+
+	normalized = builder.String()
+
+	return
+}
+
+// pathAndQuery returns the escaped path and query of URL, as matched by the rules.
+func pathAndQuery(URL *url.URL) (path string) {
+	path = URL.EscapedPath()
+
+	if path == "" {
+		path = "/"
+	}
+
+	if URL.RawQuery != "" {
+		path += "?" + URL.RawQuery
+	}
+
+	return
+}
+
+// matches reports whether pattern matches a prefix of path (or all of it, if the pattern
+// ends with "$"). It tracks the (ascending) set of path positions reachable after each
+// pattern character, so it runs in O(len(pattern) * len(path)) without backtracking.
+func matches(pattern, path string) (matched bool) {
+	positions := []int{0}
+
+	for i := range len(pattern) {
+		c := pattern[i]
+
+		switch {
+		case c == '$' && i == len(pattern)-1:
+			matched = positions[len(positions)-1] == len(path)
+
+			return
+		case c == '*':
+			start := positions[0]
+
+			positions = positions[:0]
+
+			for position := start; position <= len(path); position++ {
+				positions = append(positions, position)
+			}
+		default:
+			next := positions[:0]
+
+			for _, position := range positions {
+				if position < len(path) && path[position] == c {
+					next = append(next, position+1)
+				}
+			}
+
+			positions = next
+		}
+
+		if len(positions) == 0 {
+			return
+		}
+	}
+
+	matched = true
+
+	return
+}
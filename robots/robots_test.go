@@ -0,0 +1,91 @@
+package robots_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	hqgourl "go.source.hueristiq.com/url"
+	"go.source.hueristiq.com/url/robots"
+)
+
+const robotsTXT = `# Example robots.txt
+User-agent: *
+Disallow: /private/
+Allow: /private/public$
+Disallow: /*.pdf$
+Disallow: /search?
+Disallow:
+
+User-agent: FooBot
+User-agent: barbot
+Disallow: /
+Allow: /open
+
+user-agent: EmptyBot
+
+Sitemap: https://example.com/sitemap.xml
+`
+
+func TestRobots_Allowed(t *testing.T) {
+	t.Parallel()
+
+	rules, err := robots.Parse(strings.NewReader(robotsTXT))
+
+	require.NoError(t, err)
+	assert.Equal(t, []string{"https://example.com/sitemap.xml"}, rules.Sitemaps)
+
+	parser := hqgourl.NewParser()
+
+	tests := []struct {
+		name      string
+		userAgent string
+		URL       string
+		allowed   bool
+	}{
+		{"Unmatched Path", "MyCrawler/1.0", "https://example.com/about", true},
+		{"Root", "MyCrawler/1.0", "https://example.com", true},
+		{"Disallowed Prefix", "MyCrawler/1.0", "https://example.com/private/data", false},
+		{"Longest Match Allows", "MyCrawler/1.0", "https://example.com/private/public", true},
+		{"End Anchor", "MyCrawler/1.0", "https://example.com/private/public/x", false},
+		{"Wildcard", "MyCrawler/1.0", "https://example.com/docs/a.pdf", false},
+		{"Wildcard End Anchor", "MyCrawler/1.0", "https://example.com/docs/a.pdf?x=1", true},
+		{"Query", "MyCrawler/1.0", "https://example.com/search?q=go", false},
+		{"Query Prefix", "MyCrawler/1.0", "https://example.com/search", true},
+		{"Named Group", "FooBot/2.1 (+https://foo.example)", "https://example.com/about", false},
+		{"Named Group Case Insensitive", "BarBot", "https://example.com/open/x", true},
+		{"Named Group Overrides Star", "FooBot", "https://example.com/private/public", false},
+		{"Empty Group Allows", "EmptyBot", "https://example.com/private/data", true},
+		{"Robots Always Allowed", "FooBot", "https://example.com/robots.txt", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			parsed, err := parser.Parse(tt.URL)
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.allowed, rules.Allowed(tt.userAgent, parsed))
+		})
+	}
+
+	assert.False(t, rules.Allowed("MyCrawler", nil))
+}
+
+func TestRobots_AllowedPath_TieAndEncoding(t *testing.T) {
+	t.Parallel()
+
+	rules, err := robots.Parse(strings.NewReader("User-agent: *\nDisallow: /page\nAllow: /page\nDisallow: /café\n"))
+
+	require.NoError(t, err)
+
+	assert.True(t, rules.AllowedPath("bot", "/page"))
+	assert.False(t, rules.AllowedPath("bot", "/caf%C3%A9/menu"))
+
+	empty, err := robots.Parse(strings.NewReader(""))
+
+	require.NoError(t, err)
+	assert.True(t, empty.AllowedPath("bot", "/anything"))
+}
@@ -7,6 +7,16 @@
 //  1. **Official IANA Schemes**: A list of schemes officially registered and managed by IANA (Internet Assigned Numbers Authority).
 //  2. **Unofficial Schemes**: A list of widely used but unofficial schemes commonly associated with specific software or services.
 //  3. **No Authority Schemes**: A list of schemes that do not require an authority component (i.e., they are followed by ":" instead of "://").
+//  4. **Statuses**: The IANA registration status (permanent, provisional or historical) of official schemes.
+//  5. **WebSocket Schemes**: The official schemes of WebSocket endpoints (e.g., "ws", "wss").
+//  6. **Mobile Schemes**: A curated list of the deep-link schemes of popular Android and iOS apps (e.g., "intent", "fb").
+//  7. **Default Ports**: A map of well-known schemes to the port used when a URL does not specify one.
+//
+// GeneratedAt and SourceETags record when, and from which version of the IANA registry, the
+// official list was generated (zero and nil if unknown); IsStale reports whether it is older
+// than a given age, or of unknown age.
+//
+// Applications can register proprietary unofficial schemes at runtime with RegisterUnofficial.
 //
 // The lists are autogenerated from official sources, including IANA, and may include additional unofficial schemes
 // for software interoperability and network services.
@@ -0,0 +1,84 @@
+package schemes
+
+import (
+	"slices"
+	"strings"
+)
+
+// Registry identifies which registry a URL scheme belongs to, as returned by Category.
+type Registry string
+
+const (
+	// RegistryOfficial identifies IANA-assigned schemes (see Official).
+	RegistryOfficial Registry = "official"
+	// RegistryUnofficial identifies unofficial schemes, built-in (see Unofficial) or
+	// registered with RegisterUnofficial.
+	RegistryUnofficial Registry = "unofficial"
+	// RegistryUnknown identifies schemes in neither registry.
+	RegistryUnknown Registry = "unknown"
+)
+
+// IsOfficial reports whether scheme is an IANA-assigned scheme. The lookup is
+// case-insensitive.
+func IsOfficial(scheme string) (official bool) {
+	_, official = officialSet[strings.ToLower(scheme)]
+
+	return
+}
+
+// IsUnofficial reports whether scheme is a built-in or registered unofficial scheme. The
+// lookup is case-insensitive.
+func IsUnofficial(scheme string) (unofficial bool) {
+	scheme = strings.ToLower(scheme)
+
+	if _, unofficial = unofficialSet[scheme]; unofficial {
+		return
+	}
+
+	registeredMu.RLock()
+
+	defer registeredMu.RUnlock()
+
+	_, unofficial = slices.BinarySearch(registered, scheme)
+
+	return
+}
+
+// IsNoAuthority reports whether scheme is a scheme without an authority component (see
+// NoAuthority), i.e. followed by ":" rather than "://". The lookup is case-insensitive.
+func IsNoAuthority(scheme string) (noAuthority bool) {
+	_, noAuthority = noAuthoritySet[strings.ToLower(scheme)]
+
+	return
+}
+
+// IsMobile reports whether scheme is the deep-link scheme of a popular mobile app (see
+// Mobile). Whether a scheme is a mobile one is orthogonal to its category. The lookup is
+// case-insensitive.
+func IsMobile(scheme string) (mobile bool) {
+	_, mobile = mobileSet[strings.ToLower(scheme)]
+
+	return
+}
+
+// Category returns the registry scheme belongs to. Official schemes take precedence over
+// unofficial ones. Whether a scheme has an authority component is orthogonal to its
+// category; see IsNoAuthority.
+//
+// Parameters:
+//   - scheme (string): The scheme (e.g., "https"), without "://" or ":".
+//
+// Returns:
+//   - category (Registry): The registry of the scheme.
+func Category(scheme string) (category Registry) {
+	switch {
+	case IsOfficial(scheme):
+		category = RegistryOfficial
+	case IsUnofficial(scheme):
+		category = RegistryUnofficial
+	default:
+		category = RegistryUnknown
+	}
+
+	return
+}
@@ -0,0 +1,56 @@
+package schemes_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.source.hueristiq.com/url/schemes"
+)
+
+func TestCategory(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		scheme      string
+		category    schemes.Registry
+		noAuthority bool
+	}{
+		{"https", schemes.RegistryOfficial, false},
+		{"HTTPS", schemes.RegistryOfficial, false},
+		{"machineprovisioningprogressreporter", schemes.RegistryOfficial, false},
+		{"mailto", schemes.RegistryOfficial, true},
+		{"Slack", schemes.RegistryUnofficial, false},
+		{"magnet", schemes.RegistryOfficial, true},
+		{"notascheme", schemes.RegistryUnknown, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.scheme, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, tt.category, schemes.Category(tt.scheme))
+			assert.Equal(t, tt.category == schemes.RegistryOfficial, schemes.IsOfficial(tt.scheme))
+			assert.Equal(t, tt.noAuthority, schemes.IsNoAuthority(tt.scheme))
+		})
+	}
+}
+
+func TestCategory_Registered(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, schemes.RegistryUnknown, schemes.Category("categorytestapp"))
+
+	schemes.RegisterUnofficial("CategoryTestApp", "categorytestapp", "slack")
+
+	assert.Equal(t, schemes.RegistryUnofficial, schemes.Category("categorytestapp"))
+	assert.True(t, schemes.IsUnofficial("CATEGORYTESTAPP"))
+}
+
+func TestIsMobile(t *testing.T) {
+	t.Parallel()
+
+	assert.True(t, schemes.IsMobile("intent"))
+	assert.True(t, schemes.IsMobile("WhatsApp"))
+	assert.True(t, schemes.IsMobile("market"))
+	assert.False(t, schemes.IsMobile("https"))
+}
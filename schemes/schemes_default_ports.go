@@ -0,0 +1,49 @@
+// This file is autogenerated by the schemes generator from schemes_default_ports.txt.
+// Please do not edit manually; edit schemes_default_ports.txt instead.
+package schemes
+
+// DefaultPorts maps well-known URL schemes to the port used when a URL with that scheme
+// does not specify one (e.g., 443 for "https"). Keys are lowercase.
+//
+// The ports are the ones assigned by IANA to the protocol each scheme refers to.
+var DefaultPorts = map[string]int{
+	`coap`:   5683, // Constrained Application Protocol.
+	`coaps`:  5684, // Constrained Application Protocol over DTLS.
+	`ftp`:    21,   // File Transfer Protocol.
+	`ftps`:   990,  // File Transfer Protocol over implicit TLS.
+	`git`:    9418, // Git protocol.
+	`gopher`: 70,   // Gopher.
+	`http`:   80,   // Hypertext Transfer Protocol.
+	`https`:  443,  // Hypertext Transfer Protocol over TLS.
+	`imap`:   143,  // Internet Message Access Protocol.
+	`imaps`:  993,  // Internet Message Access Protocol over TLS.
+	`irc`:    6667, // Internet Relay Chat.
+	`ircs`:   6697, // Internet Relay Chat over TLS.
+	`ldap`:   389,  // Lightweight Directory Access Protocol.
+	`ldaps`:  636,  // Lightweight Directory Access Protocol over TLS.
+	`mqtt`:   1883, // MQ Telemetry Transport.
+	`mqtts`:  8883, // MQ Telemetry Transport over TLS.
+	`nntp`:   119,  // Network News Transfer Protocol.
+	`pop`:    110,  // Post Office Protocol.
+	`pop3`:   110,  // Post Office Protocol version 3.
+	`pop3s`:  995,  // Post Office Protocol version 3 over TLS.
+	`rdp`:    3389, // Remote Desktop Protocol.
+	`redis`:  6379, // Redis.
+	`rediss`: 6380, // Redis over TLS.
+	`rtsp`:   554,  // Real Time Streaming Protocol.
+	`rtsps`:  322,  // Real Time Streaming Protocol over TLS.
+	`scp`:    22,   // Secure Copy Protocol.
+	`sftp`:   22,   // SSH File Transfer Protocol.
+	`sip`:    5060, // Session Initiation Protocol.
+	`sips`:   5061, // Session Initiation Protocol over TLS.
+	`smb`:    445,  // Server Message Block.
+	`smtp`:   25,   // Simple Mail Transfer Protocol.
+	`snmp`:   161,  // Simple Network Management Protocol.
+	`ssh`:    22,   // Secure Shell.
+	`telnet`: 23,   // Telnet.
+	`tftp`:   69,   // Trivial File Transfer Protocol.
+	`vnc`:    5900, // Virtual Network Computing.
+	`ws`:     80,   // WebSocket.
+	`wss`:    443,  // WebSocket over TLS.
+	`xmpp`:   5222, // Extensible Messaging and Presence Protocol.
+}
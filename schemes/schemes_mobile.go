@@ -0,0 +1,78 @@
+// This file is autogenerated by the schemes generator from schemes_mobile.txt.
+// Please do not edit manually; edit schemes_mobile.txt instead.
+package schemes
+
+// Mobile is a sorted list of the deep-link schemes of popular Android and iOS apps (e.g.,
+// "intent", "fb", "whatsapp"), which launch an app, or a specific screen of it, from a
+// link. Some of them are also official or unofficial schemes.
+//
+// The schemes in this list are useful when mining APK and IPA string dumps for the entry
+// points of mobile apps.
+var Mobile = []string{
+	`alipays`,       // Alipay - opens the Alipay app.
+	`comgooglemaps`, // Google Maps (iOS) - opens the Google Maps app.
+	`discord`,       // Discord - opens the Discord app.
+	`fb`,            // Facebook - opens the Facebook app.
+	`fb-messenger`,  // Messenger - opens the Facebook Messenger app.
+	`googlechrome`,  // Google Chrome (iOS) - opens a URL in Chrome.
+	`googlechromes`, // Google Chrome (iOS) - opens an HTTPS URL in Chrome.
+	`instagram`,     // Instagram - opens the Instagram app.
+	`intent`,        // Android intent - launches an Android activity from a browser.
+	`itms-apps`,     // App Store (iOS) - opens an App Store page.
+	`itms-services`, // Enterprise distribution (iOS) - installs an app from a manifest.
+	`line`,          // LINE - opens the LINE app.
+	`linkedin`,      // LinkedIn - opens the LinkedIn app.
+	`market`,        // Google Play (Android) - opens a Play Store page.
+	`msteams`,       // Microsoft Teams - opens the Teams app.
+	`paypal`,        // PayPal - opens the PayPal app.
+	`pinterest`,     // Pinterest - opens the Pinterest app.
+	`reddit`,        // Reddit - opens the Reddit app.
+	`snapchat`,      // Snapchat - opens the Snapchat app.
+	`spotify`,       // Spotify - opens the Spotify app.
+	`tg`,            // Telegram - opens the Telegram app.
+	`tiktok`,        // TikTok - opens the TikTok app.
+	`twitter`,       // Twitter/X - opens the Twitter/X app.
+	`uber`,          // Uber - opens the Uber app.
+	`viber`,         // Viber - opens the Viber app.
+	`vnd.youtube`,   // YouTube (Android) - opens the YouTube app.
+	`waze`,          // Waze - opens the Waze app.
+	`weixin`,        // WeChat - opens the WeChat app.
+	`whatsapp`,      // WhatsApp - opens the WhatsApp app.
+	`youtube`,       // YouTube (iOS) - opens the YouTube app.
+	`zoomus`,        // Zoom (mobile) - used by the Zoom mobile application.
+}
+
+// mobileSet is the set of the schemes in Mobile, for constant-time lookups.
+var mobileSet = map[string]struct{}{
+	`alipays`:       {},
+	`comgooglemaps`: {},
+	`discord`:       {},
+	`fb`:            {},
+	`fb-messenger`:  {},
+	`googlechrome`:  {},
+	`googlechromes`: {},
+	`instagram`:     {},
+	`intent`:        {},
+	`itms-apps`:     {},
+	`itms-services`: {},
+	`line`:          {},
+	`linkedin`:      {},
+	`market`:        {},
+	`msteams`:       {},
+	`paypal`:        {},
+	`pinterest`:     {},
+	`reddit`:        {},
+	`snapchat`:      {},
+	`spotify`:       {},
+	`tg`:            {},
+	`tiktok`:        {},
+	`twitter`:       {},
+	`uber`:          {},
+	`viber`:         {},
+	`vnd.youtube`:   {},
+	`waze`:          {},
+	`weixin`:        {},
+	`whatsapp`:      {},
+	`youtube`:       {},
+	`zoomus`:        {},
+}
@@ -19,3 +19,14 @@ var NoAuthority = []string{
 	`tel`,     // Telephone - Refers to telephone numbers.
 	`xmpp`,    // XMPP - Used for addressing XMPP (Jabber) communication services.
 }
+
+// noAuthoritySet is the set of the schemes in NoAuthority, for constant-time lookups.
+var noAuthoritySet = func() (set map[string]struct{}) {
+	set = make(map[string]struct{}, len(NoAuthority))
+
+	for _, scheme := range NoAuthority {
+		set[scheme] = struct{}{}
+	}
+
+	return
+}()
@@ -0,0 +1,67 @@
+package schemes
+
+import (
+	"slices"
+	"strings"
+	"sync"
+)
+
+var (
+	// registered holds the unofficial schemes registered at runtime with RegisterUnofficial,
+	// sorted.
+	registered   []string
+	registeredMu sync.RWMutex
+)
+
+// RegisterUnofficial extends the registry of unofficial schemes at runtime, so that
+// applications can add proprietary schemes (e.g., "myapp") that are then recognized by
+// UnofficialSchemes and everything built on it, such as scheme validation and extractors
+// configured to match known schemes. Names are lowercased; empty names and names already
+// known are ignored. It is safe for concurrent use.
+//
+// Registration only affects extractors created afterwards, so register schemes during
+// program initialization.
+//
+// Parameters:
+//   - names (variadic string): The schemes to register (e.g., "myapp"), without "://" or ":".
+func RegisterUnofficial(names ...string) {
+	registeredMu.Lock()
+
+	defer registeredMu.Unlock()
+
+	for _, name := range names {
+		name = strings.ToLower(name)
+
+		if _, builtIn := unofficialSet[name]; name == "" || builtIn {
+			continue
+		}
+
+		i, found := slices.BinarySearch(registered, name)
+		if found {
+			continue
+		}
+
+		registered = slices.Insert(registered, i, name)
+	}
+}
+
+// UnofficialSchemes returns the sorted list of unofficial schemes: the built-in Unofficial
+// schemes and the ones registered with RegisterUnofficial. The returned slice is a copy
+// and may be modified by the caller.
+//
+// Returns:
+//   - list ([]string): The unofficial schemes.
+func UnofficialSchemes() (list []string) {
+	registeredMu.RLock()
+
+	defer registeredMu.RUnlock()
+
+	list = make([]string, 0, len(Unofficial)+len(registered))
+
+	list = append(list, Unofficial...)
+	list = append(list, registered...)
+
+	slices.Sort(list)
+
+	return
+}
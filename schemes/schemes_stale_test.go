@@ -0,0 +1,16 @@
+package schemes_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"go.source.hueristiq.com/url/schemes"
+)
+
+func TestIsStale(t *testing.T) {
+	t.Parallel()
+
+	assert.True(t, schemes.IsStale(0))
+	assert.Equal(t, schemes.GeneratedAt.IsZero(), schemes.IsStale(100*365*24*time.Hour))
+}
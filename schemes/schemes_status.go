@@ -0,0 +1,32 @@
+package schemes
+
+import "strings"
+
+// Status is the registration status of a URL scheme in the IANA registry.
+type Status string
+
+const (
+	// StatusPermanent identifies a scheme registered through the permanent registration process.
+	StatusPermanent Status = "Permanent"
+	// StatusProvisional identifies a scheme registered through the provisional registration process.
+	StatusProvisional Status = "Provisional"
+	// StatusHistorical identifies a scheme that is no longer in common use.
+	StatusHistorical Status = "Historical"
+	// StatusUnknown identifies a scheme whose status is not known, e.g. an unofficial scheme.
+	StatusUnknown Status = ""
+)
+
+// OfficialStatus returns the IANA registration status of a scheme. The lookup is
+// case-insensitive.
+//
+// Parameters:
+//   - scheme (string): The scheme (e.g., "https"), without "://" or ":".
+//
+// Returns:
+//   - status (Status): The status of the scheme, or StatusUnknown if it is not an
+//     IANA-assigned scheme.
+func OfficialStatus(scheme string) (status Status) {
+	status = officialStatuses[strings.ToLower(scheme)]
+
+	return
+}
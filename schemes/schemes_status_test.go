@@ -0,0 +1,42 @@
+package schemes_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.source.hueristiq.com/url/schemes"
+)
+
+func TestOfficialStatus(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		scheme string
+		status schemes.Status
+	}{
+		{"https", schemes.StatusPermanent},
+		{"HTTPS", schemes.StatusPermanent},
+		{"mailto", schemes.StatusPermanent},
+		{"wais", schemes.StatusHistorical},
+		{"prospero", schemes.StatusHistorical},
+		{"bitcoin", schemes.StatusProvisional},
+		{"machineProvisioningProgressReporter", schemes.StatusProvisional},
+		{"slack", schemes.StatusUnknown},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.scheme, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, tt.status, schemes.OfficialStatus(tt.scheme))
+		})
+	}
+}
+
+func TestOfficialStatus_Official(t *testing.T) {
+	t.Parallel()
+
+	for _, scheme := range schemes.Official {
+		assert.NotEqualf(t, schemes.StatusUnknown, schemes.OfficialStatus(scheme), "no status for %q", scheme)
+	}
+}
@@ -1,3 +1,5 @@
+// This file is autogenerated by the schemes generator from schemes_unofficial.txt.
+// Please do not edit manually; edit schemes_unofficial.txt instead.
 package schemes
 
 // Unofficial is a sorted list of some well-known URL schemes that are not yet officially registered.
@@ -8,7 +10,8 @@ package schemes
 //   - https://en.wikipedia.org/wiki/List_of_URI_schemes#Unofficial_but_common_URI_schemes
 //
 // The schemes in this list are useful in specific contexts, such as handling custom protocols for
-// software applications or services.
+// software applications or services. Applications can register additional schemes at runtime
+// with RegisterUnofficial.
 var Unofficial = []string{
 	`gemini`,        // Gemini - a lightweight internet protocol for navigating and publishing on the web.
 	`jdbc`,          // Java Database Connectivity (JDBC) - for connecting to databases from Java applications.
@@ -19,3 +22,15 @@ var Unofficial = []string{
 	`zoommtg`,       // Zoom (desktop) - used by the Zoom desktop application.
 	`zoomus`,        // Zoom (mobile) - used by the Zoom mobile application.
 }
+
+// unofficialSet is the set of the schemes in Unofficial, for constant-time lookups.
+var unofficialSet = map[string]struct{}{
+	`gemini`:        {},
+	`jdbc`:          {},
+	`moz-extension`: {},
+	`postgres`:      {},
+	`postgresql`:    {},
+	`slack`:         {},
+	`zoommtg`:       {},
+	`zoomus`:        {},
+}
@@ -0,0 +1,66 @@
+package seen
+
+import (
+	"net/url"
+	"slices"
+	"strconv"
+	"strings"
+
+	"go.source.hueristiq.com/url/schemes"
+)
+
+// Canonical returns the canonical form of a URL, under which equivalent URLs are
+// considered the same: the scheme and host are lowercased, the default port of the scheme
+// is removed, an empty path becomes "/", the query parameters are sorted by name (keeping
+// the order of repeated values) and the fragment is removed. URLs that cannot be parsed
+// are returned as is.
+//
+// Parameters:
+//   - raw (string): The URL.
+//
+// Returns:
+//   - canonical (string): The canonical form of the URL.
+func Canonical(raw string) (canonical string) {
+	parsed, err := url.Parse(raw)
+	if err != nil || parsed.Opaque != "" {
+		canonical = raw
+
+		return
+	}
+
+	parsed.Scheme = strings.ToLower(parsed.Scheme)
+	parsed.Fragment, parsed.RawFragment = "", ""
+
+	host, port := strings.ToLower(parsed.Hostname()), parsed.Port()
+
+	if strings.Contains(host, ":") {
+		host = "[" + host + "]"
+	}
+
+	if defaultPort, ok := schemes.DefaultPorts[parsed.Scheme]; port != "" && (!ok || port != strconv.Itoa(defaultPort)) {
+		host += ":" + port
+	}
+
+	parsed.Host = host
+
+	if parsed.Host != "" && parsed.Path == "" {
+		parsed.Path, parsed.RawPath = "/", ""
+	}
+
+	if parsed.RawQuery != "" {
+		parameters := strings.Split(parsed.RawQuery, "&")
+
+		slices.SortStableFunc(parameters, func(a, b string) int {
+			nameA, _, _ := strings.Cut(a, "=")
+			nameB, _, _ := strings.Cut(b, "=")
+
+			return strings.Compare(nameA, nameB)
+		})
+
+		parsed.RawQuery = strings.Join(parameters, "&")
+	}
+
+	canonical = parsed.String()
+
+	return
+}
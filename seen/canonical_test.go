@@ -0,0 +1,35 @@
+package seen_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.source.hueristiq.com/url/seen"
+)
+
+func TestCanonical(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		input    string
+		expected string
+	}{
+		{"HTTPS://WWW.Example.COM", "https://www.example.com/"},
+		{"https://example.com:443/a", "https://example.com/a"},
+		{"http://example.com:443/a", "http://example.com:443/a"},
+		{"https://example.com/a?b=2&a=1&b=1", "https://example.com/a?a=1&b=2&b=1"},
+		{"https://example.com/a#top", "https://example.com/a"},
+		{"https://[::1]:8443/", "https://[::1]:8443/"},
+		{"/relative/path?b&a", "/relative/path?a&b"},
+		{"mailto:user@example.com", "mailto:user@example.com"},
+		{"http://[::1", "http://[::1"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, tt.expected, seen.Canonical(tt.input))
+		})
+	}
+}
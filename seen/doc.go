@@ -0,0 +1,16 @@
+// Package seen tracks the URLs a crawler has already visited with bounded memory, using a
+// Bloom filter keyed by the canonical form of the URLs, so that hundreds of millions of
+// URLs fit in a few hundred megabytes. As with any Bloom filter, a URL that was never added
+// may be reported as seen with a small, configurable probability (a false positive), but a
+// URL that was added is always reported as seen.
+//
+// Example:
+//
+//	visited := seen.New(100_000_000, 0.001) // ~171 MiB.
+//
+//	if visited.Add("https://Example.com:443/a?b=2&a=1#top") {
+//	    // First visit.
+//	}
+//
+//	visited.Contains("https://example.com/a?a=1&b=2") // true
+package seen
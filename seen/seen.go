@@ -0,0 +1,152 @@
+package seen
+
+import (
+	"encoding/binary"
+	"hash/fnv"
+	"math"
+	"sync"
+)
+
+// Filter is a Bloom filter of canonical URLs. A Filter is safe for concurrent use.
+type Filter struct {
+	mu sync.RWMutex
+
+	bits   []uint64
+	size   uint64 // The number of bits.
+	hashes uint64 // The number of hash functions.
+	count  uint64 // The number of URLs added.
+}
+
+// New creates a Filter sized to hold the expected number of URLs with at most the given
+// false positive rate. It uses -n·ln(p)/ln(2)² bits for n URLs and a rate p, e.g. about
+// 9.6 bits per URL for a rate of 1%, and 14.4 bits per URL for 0.1%.
+//
+// Parameters:
+//   - expected (uint): The expected number of URLs.
+//   - falsePositiveRate (float64): The acceptable false positive rate once the expected
+//     number of URLs was added, between 0 and 1 exclusive (e.g., 0.001).
+//
+// Returns:
+//   - filter (*Filter): The filter.
+func New(expected uint, falsePositiveRate float64) (filter *Filter) {
+	if expected == 0 {
+		expected = 1
+	}
+
+	if falsePositiveRate <= 0 || falsePositiveRate >= 1 {
+		falsePositiveRate = 0.01
+	}
+
+	n := float64(expected)
+
+	size := uint64(math.Ceil(-n * math.Log(falsePositiveRate) / (math.Ln2 * math.Ln2)))
+	hashes := uint64(math.Max(1, math.Round(float64(size)/n*math.Ln2)))
+
+	filter = &Filter{
+		bits:   make([]uint64, (size+63)/64),
+		size:   size,
+		hashes: hashes,
+	}
+
+	return
+}
+
+// Add adds a URL, under its canonical form, and reports whether it is new: false if it
+// (or an equivalent URL) was added before, or, with the filter's false positive rate, if
+// it was not.
+//
+// Parameters:
+//   - raw (string): The URL.
+//
+// Returns:
+//   - added (bool): True if the URL was not seen before.
+func (f *Filter) Add(raw string) (added bool) {
+	added = f.addKey(Canonical(raw))
+
+	return
+}
+
+// addKey adds a canonical URL and reports whether it is new.
+func (f *Filter) addKey(key string) (added bool) {
+	h1, h2 := hash(key)
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	for i := range f.hashes {
+		bit := (h1 + i*h2) % f.size
+
+		if f.bits[bit/64]&(1<<(bit%64)) == 0 {
+			f.bits[bit/64] |= 1 << (bit % 64)
+
+			added = true
+		}
+	}
+
+	if added {
+		f.count++
+	}
+
+	return
+}
+
+// Contains reports whether a URL (or an equivalent URL) was added. It is true for all
+// added URLs, and, with the filter's false positive rate, for URLs that were not.
+//
+// Parameters:
+//   - raw (string): The URL.
+//
+// Returns:
+//   - contains (bool): True if the URL was probably added.
+func (f *Filter) Contains(raw string) (contains bool) {
+	h1, h2 := hash(Canonical(raw))
+
+	f.mu.RLock()
+	defer f.mu.RUnlock()
+
+	for i := range f.hashes {
+		bit := (h1 + i*h2) % f.size
+
+		if f.bits[bit/64]&(1<<(bit%64)) == 0 {
+			return
+		}
+	}
+
+	contains = true
+
+	return
+}
+
+// Len returns the number of distinct URLs added. It may undercount, as a new URL whose
+// bits were all set by others is taken for a seen one.
+func (f *Filter) Len() (count uint64) {
+	f.mu.RLock()
+	defer f.mu.RUnlock()
+
+	count = f.count
+
+	return
+}
+
+// SizeBytes returns the memory used by the bits of the filter, in bytes.
+func (f *Filter) SizeBytes() (size uint64) {
+	size = uint64(len(f.bits)) * 8
+
+	return
+}
+
+// hash returns two independent 64-bit hashes of key, from which the filter derives its
+// hash functions by double hashing (h1 + i·h2). The hashes are deterministic across
+// processes.
+func hash(key string) (h1, h2 uint64) {
+	hasher := fnv.New128a()
+
+	_, _ = hasher.Write([]byte(key))
+
+	sum := hasher.Sum(nil)
+
+	h1 = binary.BigEndian.Uint64(sum[:8])
+	h2 = binary.BigEndian.Uint64(sum[8:]) | 1 // Odd, so that the probes don't cycle early.
+
+	return
+}
@@ -0,0 +1,48 @@
+package seen_test
+
+import (
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.source.hueristiq.com/url/seen"
+)
+
+func TestFilter_Add(t *testing.T) {
+	t.Parallel()
+
+	filter := seen.New(1000, 0.01)
+
+	assert.True(t, filter.Add("https://Example.com:443/a?b=2&a=1#top"))
+	assert.False(t, filter.Add("https://example.com/a?a=1&b=2"))
+	assert.True(t, filter.Contains("https://EXAMPLE.com/a?a=1&b=2#other"))
+	assert.False(t, filter.Contains("https://example.com/b"))
+	assert.Equal(t, uint64(1), filter.Len())
+}
+
+func TestFilter_FalsePositiveRate(t *testing.T) {
+	t.Parallel()
+
+	const n = 20000
+
+	filter := seen.New(n, 0.01)
+
+	for i := range n {
+		filter.Add("https://example.com/added/" + strconv.Itoa(i))
+	}
+
+	for i := range n {
+		assert.True(t, filter.Contains("https://example.com/added/"+strconv.Itoa(i)))
+	}
+
+	falsePositives := 0
+
+	for i := range n {
+		if filter.Contains("https://example.com/other/" + strconv.Itoa(i)) {
+			falsePositives++
+		}
+	}
+
+	assert.Less(t, float64(falsePositives)/n, 0.02)
+	assert.InDelta(t, 9.6*n/8, float64(filter.SizeBytes()), 64)
+}
@@ -0,0 +1,190 @@
+package seen
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"strings"
+	"sync"
+)
+
+// Store persists the canonical URLs added to a Persistent filter, so that long-running
+// jobs survive restarts without reprocessing URLs. Implementations only need to append
+// keys and replay them; FileStore is the default implementation.
+type Store interface {
+	// Load calls fn with each key appended so far, in order.
+	Load(fn func(key string)) (err error)
+	// Append persists a key.
+	Append(key string) (err error)
+	// Close flushes and releases the store.
+	Close() (err error)
+}
+
+// FileStore is a Store appending keys to a file, one per line. Appends are buffered; call
+// Flush (or Close) to write them. A FileStore is safe for concurrent use.
+type FileStore struct {
+	mu sync.Mutex
+
+	path   string
+	file   *os.File
+	writer *bufio.Writer
+}
+
+// Ensure type compatibility with the Store interface.
+var _ Store = (*FileStore)(nil)
+
+// OpenFileStore opens the FileStore at path, creating the file if it doesn't exist.
+//
+// Parameters:
+//   - path (string): The path of the file.
+//
+// Returns:
+//   - store (*FileStore): The store.
+//   - err (error): An error if the file cannot be opened.
+func OpenFileStore(path string) (store *FileStore, err error) {
+	file, err := os.OpenFile(path, os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0o644) //nolint:gosec // The path is chosen by the caller.
+	if err != nil {
+		return
+	}
+
+	store = &FileStore{
+		path:   path,
+		file:   file,
+		writer: bufio.NewWriter(file),
+	}
+
+	return
+}
+
+// Load calls fn with each key in the file, in order.
+func (s *FileStore) Load(fn func(key string)) (err error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if err = s.writer.Flush(); err != nil {
+		return
+	}
+
+	file, err := os.Open(s.path)
+	if err != nil {
+		return
+	}
+
+	defer file.Close()
+
+	scanner := bufio.NewScanner(file)
+
+	scanner.Buffer(nil, 1<<20)
+
+	for scanner.Scan() {
+		if key := scanner.Text(); key != "" {
+			fn(key)
+		}
+	}
+
+	if err = scanner.Err(); err != nil {
+		err = fmt.Errorf("error reading %s: %w", s.path, err)
+	}
+
+	return
+}
+
+// Append buffers a key for writing. Line breaks in the key are percent-encoded.
+func (s *FileStore) Append(key string) (err error) {
+	key = strings.NewReplacer("\r", "%0D", "\n", "%0A").Replace(key)
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, err = s.writer.WriteString(key); err != nil {
+		return
+	}
+
+	err = s.writer.WriteByte('\n')
+
+	return
+}
+
+// Flush writes the buffered keys to the file.
+func (s *FileStore) Flush() (err error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	err = s.writer.Flush()
+
+	return
+}
+
+// Close flushes the buffered keys and closes the file.
+func (s *FileStore) Close() (err error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	err = errors.Join(s.writer.Flush(), s.file.Close())
+
+	if errors.Is(err, fs.ErrClosed) {
+		err = nil
+	}
+
+	return
+}
+
+// Persistent is a Filter backed by a Store: the URLs added for the first time are
+// appended to the store, and the store is replayed into the filter when the Persistent is
+// created, so that the filter survives restarts. A Persistent is safe for concurrent use.
+type Persistent struct {
+	*Filter
+
+	store Store
+}
+
+// NewPersistent creates a Persistent over filter and store, adding the keys already in
+// the store to filter.
+//
+// Parameters:
+//   - filter (*Filter): The filter, sized for the URLs of the store and the URLs to come.
+//   - store (Store): The store.
+//
+// Returns:
+//   - persistent (*Persistent): The persistent filter.
+//   - err (error): An error if the store cannot be loaded.
+func NewPersistent(filter *Filter, store Store) (persistent *Persistent, err error) {
+	if err = store.Load(func(key string) { filter.addKey(key) }); err != nil {
+		return
+	}
+
+	persistent = &Persistent{
+		Filter: filter,
+		store:  store,
+	}
+
+	return
+}
+
+// Add adds a URL, as Filter.Add does, and appends its canonical form to the store if it
+// is new.
+//
+// Parameters:
+//   - raw (string): The URL.
+//
+// Returns:
+//   - added (bool): True if the URL was not seen before.
+//   - err (error): An error if the store fails.
+func (p *Persistent) Add(raw string) (added bool, err error) {
+	key := Canonical(raw)
+
+	if added = p.addKey(key); added {
+		err = p.store.Append(key)
+	}
+
+	return
+}
+
+// Close closes the store.
+func (p *Persistent) Close() (err error) {
+	err = p.store.Close()
+
+	return
+}
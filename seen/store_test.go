@@ -0,0 +1,82 @@
+package seen_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.source.hueristiq.com/url/seen"
+)
+
+func TestPersistent_SurvivesRestart(t *testing.T) {
+	t.Parallel()
+
+	path := filepath.Join(t.TempDir(), "seen.txt")
+
+	store, err := seen.OpenFileStore(path)
+
+	require.NoError(t, err)
+
+	visited, err := seen.NewPersistent(seen.New(1000, 0.001), store)
+
+	require.NoError(t, err)
+
+	for _, raw := range []string{"https://example.com/a", "https://EXAMPLE.com:443/a", "https://example.com/b?y=2&x=1"} {
+		_, err = visited.Add(raw)
+
+		require.NoError(t, err)
+	}
+
+	require.NoError(t, visited.Close())
+
+	data, err := os.ReadFile(path)
+
+	require.NoError(t, err)
+	assert.Equal(t, "https://example.com/a\nhttps://example.com/b?x=1&y=2\n", string(data))
+
+	store, err = seen.OpenFileStore(path)
+
+	require.NoError(t, err)
+
+	restarted, err := seen.NewPersistent(seen.New(1000, 0.001), store)
+
+	require.NoError(t, err)
+
+	defer restarted.Close()
+
+	assert.True(t, restarted.Contains("https://example.com/b?x=1&y=2"))
+	assert.Equal(t, uint64(2), restarted.Len())
+
+	added, err := restarted.Add("https://example.com/a")
+
+	require.NoError(t, err)
+	assert.False(t, added)
+
+	added, err = restarted.Add("https://example.com/c")
+
+	require.NoError(t, err)
+	assert.True(t, added)
+}
+
+func TestFileStore_LineBreaks(t *testing.T) {
+	t.Parallel()
+
+	store, err := seen.OpenFileStore(filepath.Join(t.TempDir(), "seen.txt"))
+
+	require.NoError(t, err)
+
+	require.NoError(t, store.Append("not\na url"))
+
+	var keys []string
+
+	require.NoError(t, store.Load(func(key string) { keys = append(keys, key) }))
+	assert.Equal(t, []string{"not%0Aa url"}, keys)
+	require.NoError(t, store.Close())
+	require.NoError(t, store.Close())
+
+	_, err = seen.OpenFileStore(filepath.Join(t.TempDir(), "missing", "seen.txt"))
+
+	require.Error(t, err)
+}
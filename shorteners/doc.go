@@ -0,0 +1,8 @@
+// Package shorteners provides a registry of the domains of well-known URL shorteners
+// (e.g., "bit.ly" or "t.co"), so that pipelines can tag shortened links for follow-up
+// resolution.
+//
+// The built-in list is autogenerated from the curated shorteners.txt, and applications
+// can register additional domains (e.g., their own branded shortener) at runtime with
+// Register. The package never resolves links itself: it makes no network calls.
+package shorteners
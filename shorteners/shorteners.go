@@ -0,0 +1,65 @@
+package shorteners
+
+import (
+	"strings"
+	"sync"
+)
+
+var (
+	// registered holds the shortener domains registered at runtime with Register.
+	registered   = map[string]struct{}{}
+	registeredMu sync.RWMutex
+)
+
+// Register extends the registry of shortener domains at runtime, so that applications can
+// add shorteners missing from Domains (e.g., a company's branded shortener). Domains are
+// lowercased; empty domains are ignored. It is safe for concurrent use.
+//
+// Parameters:
+//   - domains (variadic string): The domains to register (e.g., "go.example.com").
+func Register(domains ...string) {
+	registeredMu.Lock()
+
+	defer registeredMu.Unlock()
+
+	for _, domain := range domains {
+		domain = strings.ToLower(strings.TrimSuffix(domain, "."))
+
+		if domain == "" {
+			continue
+		}
+
+		registered[domain] = struct{}{}
+	}
+}
+
+// IsShortener reports whether host is the domain of a known URL shortener, or one of its
+// subdomains (e.g., "www.bit.ly"), among the built-in Domains and the ones registered with
+// Register. The comparison is case-insensitive.
+//
+// Parameters:
+//   - host (string): The host, without port.
+//
+// Returns:
+//   - isShortener (bool): Whether host is a shortener domain.
+func IsShortener(host string) (isShortener bool) {
+	host = strings.ToLower(strings.TrimSuffix(host, "."))
+
+	registeredMu.RLock()
+
+	defer registeredMu.RUnlock()
+
+	for host != "" {
+		if _, isShortener = domainSet[host]; isShortener {
+			return
+		}
+
+		if _, isShortener = registered[host]; isShortener {
+			return
+		}
+
+		_, host, _ = strings.Cut(host, ".")
+	}
+
+	return
+}
@@ -0,0 +1,79 @@
+// This file is autogenerated by the shorteners generator from shorteners.txt.
+// Please do not edit manually; edit shorteners.txt instead.
+package shorteners
+
+// Domains is a sorted list of the domains of well-known URL shorteners. Subdomains of
+// these domains are shorteners too. Applications can register additional domains at
+// runtime with Register.
+var Domains = []string{
+	`adf.ly`,      // AdFly - ad-supported shortener.
+	`aka.ms`,      // Microsoft.
+	`amzn.to`,     // Amazon.
+	`bit.do`,      // Bit.do.
+	`bit.ly`,      // Bitly.
+	`bitly.com`,   // Bitly.
+	`buff.ly`,     // Buffer.
+	`cutt.ly`,     // Cuttly.
+	`db.tt`,       // Dropbox.
+	`dlvr.it`,     // dlvr.it.
+	`fb.me`,       // Facebook.
+	`g.co`,        // Google.
+	`git.io`,      // GitHub (retired).
+	`goo.gl`,      // Google (retired).
+	`ift.tt`,      // IFTTT.
+	`is.gd`,       // is.gd.
+	`j.mp`,        // Bitly.
+	`lnkd.in`,     // LinkedIn.
+	`ow.ly`,       // Hootsuite.
+	`qr.ae`,       // Quora.
+	`rb.gy`,       // Rebrandly.
+	`rebrand.ly`,  // Rebrandly.
+	`s.id`,        // s.id.
+	`shorturl.at`, // ShortURL.
+	`t.co`,        // X (Twitter).
+	`t.ly`,        // T.LY.
+	`tiny.cc`,     // tiny.cc.
+	`tinyurl.com`, // TinyURL.
+	`trib.al`,     // SocialFlow.
+	`v.gd`,        // v.gd.
+	`wp.me`,       // WordPress.
+	`y2u.be`,      // YouTube (third-party).
+	`youtu.be`,    // YouTube.
+}
+
+// domainSet is the set of the domains in Domains, for constant-time lookups.
+var domainSet = map[string]struct{}{
+	`adf.ly`:      {},
+	`aka.ms`:      {},
+	`amzn.to`:     {},
+	`bit.do`:      {},
+	`bit.ly`:      {},
+	`bitly.com`:   {},
+	`buff.ly`:     {},
+	`cutt.ly`:     {},
+	`db.tt`:       {},
+	`dlvr.it`:     {},
+	`fb.me`:       {},
+	`g.co`:        {},
+	`git.io`:      {},
+	`goo.gl`:      {},
+	`ift.tt`:      {},
+	`is.gd`:       {},
+	`j.mp`:        {},
+	`lnkd.in`:     {},
+	`ow.ly`:       {},
+	`qr.ae`:       {},
+	`rb.gy`:       {},
+	`rebrand.ly`:  {},
+	`s.id`:        {},
+	`shorturl.at`: {},
+	`t.co`:        {},
+	`t.ly`:        {},
+	`tiny.cc`:     {},
+	`tinyurl.com`: {},
+	`trib.al`:     {},
+	`v.gd`:        {},
+	`wp.me`:       {},
+	`y2u.be`:      {},
+	`youtu.be`:    {},
+}
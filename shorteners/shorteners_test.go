@@ -0,0 +1,52 @@
+package shorteners_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.source.hueristiq.com/url/shorteners"
+)
+
+func TestIsShortener(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		host        string
+		isShortener bool
+	}{
+		{"bit.ly", true},
+		{"BIT.LY", true},
+		{"www.bit.ly", true},
+		{"t.co.", true},
+		{"example.com", false},
+		{"notbit.ly", false},
+		{"ly", false},
+		{"", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.host, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, tt.isShortener, shorteners.IsShortener(tt.host))
+		})
+	}
+}
+
+func TestRegister(t *testing.T) {
+	t.Parallel()
+
+	assert.False(t, shorteners.IsShortener("go.shortener-test.example"))
+
+	shorteners.Register("Go.Shortener-Test.Example", "")
+
+	assert.True(t, shorteners.IsShortener("go.shortener-test.example"))
+	assert.True(t, shorteners.IsShortener("x.go.shortener-test.example"))
+	assert.False(t, shorteners.IsShortener("shortener-test.example"))
+}
+
+func TestDomains_Sorted(t *testing.T) {
+	t.Parallel()
+
+	assert.IsNonDecreasing(t, shorteners.Domains)
+}
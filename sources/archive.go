@@ -0,0 +1,158 @@
+package sources
+
+import (
+	"archive/tar"
+	"archive/zip"
+	"bufio"
+	"bytes"
+	"compress/gzip"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// ErrUnsupportedArchive is returned by ExtractFromArchive when the file is not a ZIP, tar
+// or gzip archive.
+var ErrUnsupportedArchive = errors.New("unsupported archive format")
+
+// ExtractFromArchive extracts URLs from the members of a ZIP, tar, gzip-compressed tar or
+// gzip archive, detected from its content rather than its name. Each member is streamed
+// through the extractor, so members larger than memory can be scanned. The Origin of each
+// match is the name of its member. Use WithExtensions to scan only some members.
+//
+// Parameters:
+//   - path (string): The path of the archive.
+//   - opts (variadic OptionFunc): Options configuring the extraction.
+//
+// Returns:
+//   - matches ([]Match): The matches, with offsets into their member.
+//   - err (error): ErrUnsupportedArchive (wrapped) if the file is not a supported archive,
+//     or an error if it cannot be read.
+func ExtractFromArchive(path string, opts ...OptionFunc) (matches []Match, err error) {
+	options := newOptions(opts...)
+
+	file, err := os.Open(path)
+	if err != nil {
+		return
+	}
+
+	defer file.Close()
+
+	info, err := file.Stat()
+	if err != nil {
+		return
+	}
+
+	reader := bufio.NewReader(file)
+
+	header, _ := reader.Peek(512)
+
+	switch {
+	case bytes.HasPrefix(header, []byte("PK\x03\x04")), bytes.HasPrefix(header, []byte("PK\x05\x06")):
+		matches, err = options.extractFromZip(matches, file, info.Size())
+	case bytes.HasPrefix(header, []byte{0x1F, 0x8B}):
+		matches, err = options.extractFromGzip(matches, reader, filepath.Base(path))
+	case isTar(header):
+		matches, err = options.extractFromTar(matches, reader)
+	default:
+		err = fmt.Errorf("%w: %s", ErrUnsupportedArchive, path)
+	}
+
+	return
+}
+
+// isTar reports whether header starts a tar archive.
+func isTar(header []byte) (is bool) {
+	is = len(header) >= 262 && string(header[257:262]) == "ustar"
+
+	return
+}
+
+// extractFromZip extracts the URLs of the members of a ZIP archive.
+func (o *Options) extractFromZip(matches []Match, r io.ReaderAt, size int64) ([]Match, error) {
+	archive, err := zip.NewReader(r, size)
+	if err != nil {
+		return matches, fmt.Errorf("error reading archive: %w", err)
+	}
+
+	for _, member := range archive.File {
+		if member.FileInfo().IsDir() || !o.includes(member.Name) {
+			continue
+		}
+
+		content, err := member.Open()
+		if err != nil {
+			return matches, fmt.Errorf("error reading %s: %w", member.Name, err)
+		}
+
+		matches, err = o.extractFromReader(matches, content, member.Name)
+
+		content.Close()
+
+		if err != nil {
+			return matches, err
+		}
+	}
+
+	return matches, nil
+}
+
+// extractFromGzip extracts the URLs of a gzip archive, which holds either a tar archive or
+// a single member, named after the archive (without its ".gz" extension) unless the gzip
+// header records the original name.
+func (o *Options) extractFromGzip(matches []Match, r io.Reader, name string) ([]Match, error) {
+	decompressed, err := gzip.NewReader(r)
+	if err != nil {
+		return matches, fmt.Errorf("error reading archive: %w", err)
+	}
+
+	defer decompressed.Close()
+
+	reader := bufio.NewReader(decompressed)
+
+	if header, _ := reader.Peek(512); isTar(header) {
+		return o.extractFromTar(matches, reader)
+	}
+
+	name = strings.TrimSuffix(strings.TrimSuffix(name, ".gz"), ".GZ")
+
+	if decompressed.Name != "" {
+		name = decompressed.Name
+	}
+
+	if !o.includes(name) {
+		return matches, nil
+	}
+
+	return o.extractFromReader(matches, reader, name)
+}
+
+// extractFromTar extracts the URLs of the regular files of a tar archive.
+func (o *Options) extractFromTar(matches []Match, r io.Reader) ([]Match, error) {
+	archive := tar.NewReader(r)
+
+	for {
+		header, err := archive.Next()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+
+		if err != nil {
+			return matches, fmt.Errorf("error reading archive: %w", err)
+		}
+
+		if header.Typeflag != tar.TypeReg || !o.includes(header.Name) {
+			continue
+		}
+
+		matches, err = o.extractFromReader(matches, archive, header.Name)
+		if err != nil {
+			return matches, err
+		}
+	}
+
+	return matches, nil
+}
@@ -0,0 +1,153 @@
+package sources_test
+
+import (
+	"archive/tar"
+	"archive/zip"
+	"bytes"
+	"compress/gzip"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	hqgourl "go.source.hueristiq.com/url"
+	"go.source.hueristiq.com/url/sources"
+)
+
+var archiveMembers = []struct {
+	name, content string
+}{
+	{"app/main.js", `fetch("https://api.example.com/v1/users")`},
+	{"app/index.html", `<a href="https://www.example.com/about">About</a>`},
+	{"app/logo.png", `https://binary.example.com`},
+}
+
+func writeZip(t *testing.T, path string) {
+	t.Helper()
+
+	var buffer bytes.Buffer
+
+	archive := zip.NewWriter(&buffer)
+
+	for _, member := range archiveMembers {
+		writer, err := archive.Create(member.name)
+
+		require.NoError(t, err)
+
+		_, err = writer.Write([]byte(member.content))
+
+		require.NoError(t, err)
+	}
+
+	require.NoError(t, archive.Close())
+	require.NoError(t, os.WriteFile(path, buffer.Bytes(), 0o600))
+}
+
+func writeTar(t *testing.T, path string, compress bool) {
+	t.Helper()
+
+	var buffer bytes.Buffer
+
+	archive := tar.NewWriter(&buffer)
+
+	require.NoError(t, archive.WriteHeader(&tar.Header{Name: "app/", Typeflag: tar.TypeDir, Mode: 0o755}))
+
+	for _, member := range archiveMembers {
+		require.NoError(t, archive.WriteHeader(&tar.Header{Name: member.name, Mode: 0o644, Size: int64(len(member.content))}))
+
+		_, err := archive.Write([]byte(member.content))
+
+		require.NoError(t, err)
+	}
+
+	require.NoError(t, archive.Close())
+
+	data := buffer.Bytes()
+
+	if compress {
+		var compressed bytes.Buffer
+
+		writer := gzip.NewWriter(&compressed)
+
+		_, err := writer.Write(data)
+
+		require.NoError(t, err)
+		require.NoError(t, writer.Close())
+
+		data = compressed.Bytes()
+	}
+
+	require.NoError(t, os.WriteFile(path, data, 0o600))
+}
+
+func TestExtractFromArchive(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+
+	writeZip(t, filepath.Join(dir, "site.zip"))
+	writeTar(t, filepath.Join(dir, "site.tar"), false)
+	writeTar(t, filepath.Join(dir, "site.tgz"), true)
+
+	for _, name := range []string{"site.zip", "site.tar", "site.tgz"} {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			matches, err := sources.ExtractFromArchive(filepath.Join(dir, name), sources.WithExtensions("js", ".HTML"), sources.WithExtractor(hqgourl.NewExtractor(hqgourl.ExtractorWithScheme())))
+
+			require.NoError(t, err)
+
+			var found []string
+
+			for _, match := range matches {
+				found = append(found, match.Origin+" "+match.Value)
+			}
+
+			assert.Equal(t, []string{
+				"app/main.js https://api.example.com/v1/users",
+				"app/index.html https://www.example.com/about",
+			}, found)
+		})
+	}
+}
+
+func TestExtractFromArchive_Gzip(t *testing.T) {
+	t.Parallel()
+
+	path := filepath.Join(t.TempDir(), "access.log.gz")
+
+	var compressed bytes.Buffer
+
+	writer := gzip.NewWriter(&compressed)
+
+	_, err := writer.Write([]byte("GET https://example.com/login 200\n"))
+
+	require.NoError(t, err)
+	require.NoError(t, writer.Close())
+	require.NoError(t, os.WriteFile(path, compressed.Bytes(), 0o600))
+
+	matches, err := sources.ExtractFromArchive(path)
+
+	require.NoError(t, err)
+	require.Len(t, matches, 1)
+	assert.Equal(t, "access.log", matches[0].Origin)
+	assert.Equal(t, "https://example.com/login", matches[0].Value)
+	assert.Equal(t, 4, matches[0].Start)
+}
+
+func TestExtractFromArchive_Unsupported(t *testing.T) {
+	t.Parallel()
+
+	path := filepath.Join(t.TempDir(), "notes.txt")
+
+	require.NoError(t, os.WriteFile(path, []byte("https://example.com"), 0o600))
+
+	_, err := sources.ExtractFromArchive(path)
+
+	require.ErrorIs(t, err, sources.ErrUnsupportedArchive)
+
+	_, err = sources.ExtractFromArchive(filepath.Join(t.TempDir(), "missing.zip"))
+
+	require.Error(t, err)
+}
@@ -0,0 +1,15 @@
+// Package sources extracts URLs from common file formats, so that triaging document dumps
+// doesn't require wiring a separate text-extraction step in front of the extractor. Each
+// source turns its input into text and runs an hqgourl.Extractor over it; the matches
+// carry their origin within the input (e.g., the member of a document package).
+//
+// Example:
+//
+//	file, _ := os.Open("report.pdf")
+//
+//	matches, err := sources.ExtractFromPDF(file)
+//
+//	for _, match := range matches {
+//	    fmt.Println(match.Value)
+//	}
+package sources
@@ -0,0 +1,172 @@
+package sources
+
+import (
+	"bytes"
+	"encoding/base64"
+	"fmt"
+	"html"
+	"io"
+	"mime"
+	"mime/multipart"
+	"mime/quotedprintable"
+	"net/mail"
+	"net/textproto"
+	"strconv"
+	"strings"
+)
+
+// emailHeaders are the headers URLs are extracted from. Routing headers (e.g.,
+// "Received") are skipped, as they are full of host names that are rarely of interest.
+var emailHeaders = []string{
+	"From",
+	"Reply-To",
+	"Sender",
+	"Return-Path",
+	"Subject",
+	"List-Unsubscribe",
+	"List-Subscribe",
+	"List-Help",
+	"List-Post",
+	"List-Archive",
+	"List-Owner",
+}
+
+// ExtractFromEmail extracts URLs from an email message (RFC 5322): from its headers (with
+// encoded words decoded) and from the text and HTML parts of its body, walking multipart
+// and attached ("message/rfc822") messages. Bodies are decoded from quoted-printable,
+// which joins the lines soft-wrapped with a trailing "=" (a common way for URLs to be
+// split), and from base64. HTML entities are unescaped, so that e.g. "&amp;" in a link
+// doesn't cut its query. Parts are expected in UTF-8 (or an ASCII-compatible charset).
+//
+// The Origin of each match is the header ("header.Subject") or the body part ("body" for
+// single-part messages, or "body.2.1" for the first part of the second part of a multipart
+// message) it was found in. The origins of attached messages are nested in the origin of
+// their part (e.g., "body.3.header.Subject").
+//
+// Parameters:
+//   - r (io.Reader): The message.
+//   - opts (variadic OptionFunc): Options configuring the extraction.
+//
+// Returns:
+//   - matches ([]Match): The matches, with offsets into the decoded header or part.
+//   - err (error): ErrTooLarge (wrapped) if the message exceeds the maximum size (see
+//     WithMaxSize), or an error if the message cannot be read.
+func ExtractFromEmail(r io.Reader, opts ...OptionFunc) (matches []Match, err error) {
+	options := newOptions(opts...)
+
+	// The decoded parts are never larger than the message, so limiting it is enough.
+	message, err := mail.ReadMessage(newLimitedReader(r, options.MaxSize))
+	if err != nil {
+		err = fmt.Errorf("error reading message: %w", err)
+
+		return
+	}
+
+	matches, err = options.extractFromMessage(matches, message, "")
+
+	return
+}
+
+// extractFromMessage extracts the URLs of the headers and body of message, whose origins
+// are prefixed with prefix.
+func (o *Options) extractFromMessage(matches []Match, message *mail.Message, prefix string) ([]Match, error) {
+	decoder := &mime.WordDecoder{}
+
+	for _, name := range emailHeaders {
+		for _, value := range message.Header[name] {
+			if decoded, err := decoder.DecodeHeader(value); err == nil {
+				value = decoded
+			}
+
+			matches = o.extract(matches, value, prefix+"header."+name)
+		}
+	}
+
+	return o.extractFromPart(matches, textproto.MIMEHeader(message.Header), message.Body, prefix+"body")
+}
+
+// extractFromPart extracts the URLs of a body part, recursing into multipart and attached
+// messages.
+func (o *Options) extractFromPart(matches []Match, header textproto.MIMEHeader, body io.Reader, origin string) ([]Match, error) {
+	mediaType, params, err := mime.ParseMediaType(header.Get("Content-Type"))
+	if err != nil {
+		mediaType = "text/plain"
+	}
+
+	content, err := io.ReadAll(decodeTransferEncoding(header.Get("Content-Transfer-Encoding"), body))
+	if err != nil {
+		return matches, fmt.Errorf("error reading %s: %w", origin, err)
+	}
+
+	switch {
+	case strings.HasPrefix(mediaType, "multipart/"):
+		reader := multipart.NewReader(bytes.NewReader(content), params["boundary"])
+
+		for i := 1; ; i++ {
+			part, err := reader.NextRawPart()
+			if err != nil {
+				// The end of the parts, or a malformed remainder, which is skipped.
+				break
+			}
+
+			matches, err = o.extractFromPart(matches, part.Header, part, origin+"."+strconv.Itoa(i))
+			if err != nil {
+				return matches, err
+			}
+		}
+	case mediaType == "message/rfc822":
+		message, err := mail.ReadMessage(bytes.NewReader(content))
+		if err != nil {
+			return matches, nil //nolint:nilerr // Malformed attached messages are skipped.
+		}
+
+		return o.extractFromMessage(matches, message, origin+".")
+	case mediaType == "text/html":
+		matches = o.extract(matches, html.UnescapeString(string(content)), origin)
+	case strings.HasPrefix(mediaType, "text/"):
+		matches = o.extract(matches, string(content), origin)
+	}
+
+	return matches, nil
+}
+
+// decodeTransferEncoding returns a reader decoding body from its Content-Transfer-Encoding.
+// Bodies without an encoding that contain soft line breaks are decoded as quoted-printable.
+func decodeTransferEncoding(encoding string, body io.Reader) (decoded io.Reader) {
+	switch strings.ToLower(strings.TrimSpace(encoding)) {
+	case "quoted-printable":
+		decoded = quotedprintable.NewReader(body)
+	case "base64":
+		decoded = base64.NewDecoder(base64.StdEncoding, body)
+	case "":
+		content, err := io.ReadAll(body)
+		if err != nil {
+			decoded = io.MultiReader(bytes.NewReader(content), errorReader{err})
+
+			return
+		}
+
+		decoded = bytes.NewReader(content)
+
+		if bytes.Contains(content, []byte("=\n")) || bytes.Contains(content, []byte("=\r\n")) {
+			if unwrapped, err := io.ReadAll(quotedprintable.NewReader(bytes.NewReader(content))); err == nil {
+				decoded = bytes.NewReader(unwrapped)
+			}
+		}
+	default:
+		decoded = body
+	}
+
+	return
+}
+
+// errorReader is a reader that fails with err.
+type errorReader struct {
+	err error
+}
+
+func (r errorReader) Read(_ []byte) (n int, err error) {
+	err = r.err
+
+	return
+}
@@ -0,0 +1,102 @@
+package sources_test
+
+import (
+	"encoding/base64"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	hqgourl "go.source.hueristiq.com/url"
+	"go.source.hueristiq.com/url/sources"
+)
+
+func TestExtractFromEmail(t *testing.T) {
+	t.Parallel()
+
+	message := strings.Join([]string{
+		"From: Support <support@example.com>",
+		"Subject: =?UTF-8?B?" + base64.StdEncoding.EncodeToString([]byte("Verify at https://subject.example.com")) + "?=",
+		"Received: from mx.example.net by mx.example.org",
+		"List-Unsubscribe: <https://unsubscribe.example.com/u?id=1>",
+		"MIME-Version: 1.0",
+		`Content-Type: multipart/mixed; boundary="outer"`,
+		"",
+		"--outer",
+		`Content-Type: multipart/alternative; boundary="inner"`,
+		"",
+		"--inner",
+		"Content-Type: text/plain; charset=utf-8",
+		"Content-Transfer-Encoding: quoted-printable",
+		"",
+		"Please log in at https://login.example.com/account/verify?token=3Dabc&next=",
+		"=3D/home now.",
+		"--inner",
+		"Content-Type: text/html; charset=utf-8",
+		"Content-Transfer-Encoding: base64",
+		"",
+		base64.StdEncoding.EncodeToString([]byte(`<a href="https://html.example.com/a?x=1&amp;y=2">click</a>`)),
+		"--inner--",
+		"--outer",
+		"Content-Type: image/png",
+		"Content-Transfer-Encoding: base64",
+		"",
+		base64.StdEncoding.EncodeToString([]byte("https://image.example.com")),
+		"--outer",
+		"Content-Type: message/rfc822",
+		"",
+		"Subject: Forwarded",
+		"",
+		"Original link: https://forwarded.example.com",
+		"--outer--",
+		"",
+	}, "\r\n")
+
+	matches, err := sources.ExtractFromEmail(strings.NewReader(message), sources.WithExtractor(hqgourl.NewExtractor(hqgourl.ExtractorWithScheme())))
+
+	require.NoError(t, err)
+
+	var found []string
+
+	for _, match := range matches {
+		found = append(found, match.Origin+" "+match.Value)
+	}
+
+	assert.Equal(t, []string{
+		"header.Subject https://subject.example.com",
+		"header.List-Unsubscribe https://unsubscribe.example.com/u?id=1",
+		"body.1.1 https://login.example.com/account/verify?token=abc&next=/home",
+		"body.1.2 https://html.example.com/a?x=1&y=2",
+		"body.3.body https://forwarded.example.com",
+	}, found)
+}
+
+func TestExtractFromEmail_UnlabelledSoftWraps(t *testing.T) {
+	t.Parallel()
+
+	message := "Subject: Hi\r\n\r\nSee https://example.com/a/very/long/=\r\npath for details.\r\n"
+
+	matches, err := sources.ExtractFromEmail(strings.NewReader(message))
+
+	require.NoError(t, err)
+	assert.Equal(t, []string{"https://example.com/a/very/long/path"}, values(matches))
+
+	_, err = sources.ExtractFromEmail(strings.NewReader(""))
+
+	require.Error(t, err)
+}
+
+func TestExtractFromEmail_MaxSize(t *testing.T) {
+	t.Parallel()
+
+	message := "Subject: Hello\r\n\r\nSee https://example.com" + strings.Repeat(" ", 64<<10) + "\r\n"
+
+	_, err := sources.ExtractFromEmail(strings.NewReader(message), sources.WithMaxSize(32<<10))
+
+	require.ErrorIs(t, err, sources.ErrTooLarge)
+
+	matches, err := sources.ExtractFromEmail(strings.NewReader(message), sources.WithMaxSize(int64(len(message))))
+
+	require.NoError(t, err)
+	assert.Len(t, matches, 1)
+}
@@ -0,0 +1,139 @@
+package sources
+
+import (
+	"bufio"
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+)
+
+// Commit is a commit of a repository, as returned by a CommitIterator.
+type Commit struct {
+	Hash  string       // The hash of the commit.
+	Files []CommitFile // The files changed by the commit.
+}
+
+// CommitFile is a file changed by a commit.
+type CommitFile struct {
+	Path    string    // The slash-separated path of the file in the repository.
+	Content io.Reader // The content of the file in the commit, or the lines the commit added.
+}
+
+// CommitIterator iterates over the history of a repository. It lets callers plug in the
+// git implementation of their choice (e.g., a library reading the object database, or the
+// output of `git log -p`) without this package depending on one.
+type CommitIterator interface {
+	// Next returns the next commit, or io.EOF when there are no more. The contents of the
+	// files of a commit are read before Next is called again.
+	Next() (commit *Commit, err error)
+}
+
+// ExtractFromGit extracts URLs from a git repository: from the files of its working tree
+// and, if history is not nil, from the files changed by each commit it yields. The ".git"
+// directory and binary files (files with a NUL byte in their first 8000 bytes, as git
+// itself decides) are skipped. The Origin of each match is the slash-separated path of its
+// file, and Commit the hash of its commit, or empty for the working tree. Use
+// WithExtensions to scan only some files.
+//
+// Parameters:
+//   - root (string): The root directory of the working tree.
+//   - history (CommitIterator): The commits to scan, or nil to scan the working tree only.
+//   - opts (variadic OptionFunc): Options configuring the extraction.
+//
+// Returns:
+//   - matches ([]Match): The matches, with offsets into their file.
+//   - err (error): An error if the working tree or history cannot be read.
+func ExtractFromGit(root string, history CommitIterator, opts ...OptionFunc) (matches []Match, err error) {
+	options := newOptions(opts...)
+
+	err = filepath.WalkDir(root, func(path string, entry fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+
+		if entry.IsDir() {
+			if entry.Name() == ".git" {
+				return filepath.SkipDir
+			}
+
+			return nil
+		}
+
+		if !entry.Type().IsRegular() || !options.includes(entry.Name()) {
+			return nil
+		}
+
+		relative, err := filepath.Rel(root, path)
+		if err != nil {
+			return err
+		}
+
+		file, err := os.Open(path)
+		if err != nil {
+			return err
+		}
+
+		defer file.Close()
+
+		matches, err = options.extractFromFile(matches, file, filepath.ToSlash(relative), "")
+
+		return err
+	})
+	if err != nil || history == nil {
+		return
+	}
+
+	for {
+		var commit *Commit
+
+		commit, err = history.Next()
+		if errors.Is(err, io.EOF) {
+			err = nil
+
+			break
+		}
+
+		if err != nil {
+			err = fmt.Errorf("error reading history: %w", err)
+
+			return
+		}
+
+		for _, file := range commit.Files {
+			if file.Content == nil || !options.includes(file.Path) {
+				continue
+			}
+
+			matches, err = options.extractFromFile(matches, file.Content, file.Path, commit.Hash)
+			if err != nil {
+				return
+			}
+		}
+	}
+
+	return
+}
+
+// extractFromFile streams the content of a text file through the extractor and appends
+// the matches, tagged with path and commit, to matches. Binary files are skipped.
+func (o *Options) extractFromFile(matches []Match, content io.Reader, path, commit string) ([]Match, error) {
+	reader := bufio.NewReaderSize(content, 8000)
+
+	if header, _ := reader.Peek(8000); bytes.IndexByte(header, 0) >= 0 {
+		return matches, nil
+	}
+
+	found, err := o.extractFromReader(nil, reader, path)
+
+	for _, match := range found {
+		match.Commit = commit
+
+		matches = append(matches, match)
+	}
+
+	return matches, err
+}
@@ -0,0 +1,95 @@
+package sources_test
+
+import (
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	hqgourl "go.source.hueristiq.com/url"
+	"go.source.hueristiq.com/url/sources"
+)
+
+type commits []*sources.Commit
+
+func (c *commits) Next() (commit *sources.Commit, err error) {
+	if len(*c) == 0 {
+		err = io.EOF
+
+		return
+	}
+
+	commit, *c = (*c)[0], (*c)[1:]
+
+	return
+}
+
+func TestExtractFromGit(t *testing.T) {
+	t.Parallel()
+
+	root := t.TempDir()
+
+	files := map[string]string{
+		"config/app.yaml":   "endpoint: https://api.example.com/v2\n",
+		"README.md":         "Docs at https://docs.example.com\n",
+		".git/config":       "url = https://git.example.com/repo.git\n",
+		"assets/blob.bin":   "\x00\x01https://binary.example.com",
+		"src/client/api.js": "const base = 'https://internal.example.com';\n",
+	}
+
+	for name, content := range files {
+		path := filepath.Join(root, filepath.FromSlash(name))
+
+		require.NoError(t, os.MkdirAll(filepath.Dir(path), 0o755))
+		require.NoError(t, os.WriteFile(path, []byte(content), 0o600))
+	}
+
+	history := &commits{
+		{Hash: "a1b2c3", Files: []sources.CommitFile{
+			{Path: "config/app.yaml", Content: strings.NewReader("endpoint: https://staging.example.com/v1\n")},
+			{Path: "deleted.txt"},
+		}},
+		{Hash: "d4e5f6", Files: []sources.CommitFile{
+			{Path: "src/client/api.js", Content: strings.NewReader("const base = 'https://old.example.com';\n")},
+		}},
+	}
+
+	matches, err := sources.ExtractFromGit(root, history, sources.WithExtractor(hqgourl.NewExtractor(hqgourl.ExtractorWithScheme())))
+
+	require.NoError(t, err)
+
+	var found []string
+
+	for _, match := range matches {
+		found = append(found, match.Commit+" "+match.Origin+" "+match.Value)
+	}
+
+	assert.Equal(t, []string{
+		" README.md https://docs.example.com",
+		" config/app.yaml https://api.example.com/v2",
+		" src/client/api.js https://internal.example.com",
+		"a1b2c3 config/app.yaml https://staging.example.com/v1",
+		"d4e5f6 src/client/api.js https://old.example.com",
+	}, found)
+}
+
+func TestExtractFromGit_WorkingTreeOnly(t *testing.T) {
+	t.Parallel()
+
+	root := t.TempDir()
+
+	require.NoError(t, os.WriteFile(filepath.Join(root, "main.go"), []byte(`var docs = "https://example.com/go"`), 0o600))
+	require.NoError(t, os.WriteFile(filepath.Join(root, "notes.txt"), []byte(`https://example.com/notes`), 0o600))
+
+	matches, err := sources.ExtractFromGit(root, nil, sources.WithExtensions(".go"))
+
+	require.NoError(t, err)
+	assert.Equal(t, []string{"https://example.com/go"}, values(matches))
+
+	_, err = sources.ExtractFromGit(filepath.Join(root, "missing"), nil)
+
+	require.Error(t, err)
+}
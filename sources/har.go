@@ -0,0 +1,214 @@
+package sources
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	hqgourl "go.source.hueristiq.com/url"
+)
+
+// har is the part of an HTTP Archive (HAR) file the URLs are extracted from.
+type har struct {
+	Log struct {
+		Entries []struct {
+			Request struct {
+				URL string `json:"url"`
+			} `json:"request"`
+			Response struct {
+				RedirectURL string `json:"redirectURL"`
+				Content     struct {
+					MimeType string `json:"mimeType"`
+					Text     string `json:"text"`
+					Encoding string `json:"encoding"`
+				} `json:"content"`
+			} `json:"response"`
+		} `json:"entries"`
+	} `json:"log"`
+}
+
+// ExtractFromHAR extracts URLs from an HTTP Archive (HAR) file, as exported by browsers'
+// developer tools: the URLs of the requests and the redirect targets of the responses, as
+// is, and the URLs in the response bodies (decoded from base64 if needed), through the
+// extractor. Bodies of images, audio, video and fonts are skipped.
+//
+// The Origin of each match is its location in the file, e.g. "entries.3.request.url",
+// "entries.3.response.redirectURL" or "entries.3.response.content". Request and redirect
+// URLs are matches of type hqgourl.MatchTypeURL spanning the whole value.
+//
+// Parameters:
+//   - r (io.Reader): The HAR file.
+//   - opts (variadic OptionFunc): Options configuring the extraction.
+//
+// Returns:
+//   - matches ([]Match): The matches.
+//   - err (error): An error if r cannot be read or decoded.
+func ExtractFromHAR(r io.Reader, opts ...OptionFunc) (matches []Match, err error) {
+	options := newOptions(opts...)
+
+	var archive har
+
+	if err = json.NewDecoder(r).Decode(&archive); err != nil {
+		err = fmt.Errorf("error reading HAR: %w", err)
+
+		return
+	}
+
+	for i, entry := range archive.Log.Entries {
+		prefix := "entries." + strconv.Itoa(i)
+
+		matches = appendValue(matches, entry.Request.URL, prefix+".request.url")
+		matches = appendValue(matches, entry.Response.RedirectURL, prefix+".response.redirectURL")
+
+		content := entry.Response.Content
+
+		if isBinaryMimeType(content.MimeType) {
+			continue
+		}
+
+		text := content.Text
+
+		if content.Encoding == "base64" {
+			decoded, err := base64.StdEncoding.DecodeString(text)
+			if err != nil {
+				continue
+			}
+
+			text = string(decoded)
+		}
+
+		matches = options.extract(matches, text, prefix+".response.content")
+	}
+
+	return
+}
+
+// burpItem is an item of a Burp Suite XML export.
+type burpItem struct {
+	URL      string      `xml:"url"`
+	Request  burpMessage `xml:"request"`
+	Response burpMessage `xml:"response"`
+}
+
+// burpMessage is a raw HTTP message of a Burp Suite XML export, optionally in base64.
+type burpMessage struct {
+	Base64 bool   `xml:"base64,attr"`
+	Data   string `xml:",chardata"`
+}
+
+// ExtractFromBurp extracts URLs from a Burp Suite XML export (of items from the proxy
+// history or site map): the URLs of the items, as is, and the URLs in the raw requests
+// and responses (decoded from base64 if needed), through the extractor. Items are read
+// one at a time, so large exports can be processed.
+//
+// The Origin of each match is its location in the file, e.g. "items.3.url",
+// "items.3.request" or "items.3.response". Item URLs are matches of type
+// hqgourl.MatchTypeURL spanning the whole value.
+//
+// Parameters:
+//   - r (io.Reader): The XML export.
+//   - opts (variadic OptionFunc): Options configuring the extraction.
+//
+// Returns:
+//   - matches ([]Match): The matches.
+//   - err (error): An error if r cannot be read or decoded.
+func ExtractFromBurp(r io.Reader, opts ...OptionFunc) (matches []Match, err error) {
+	options := newOptions(opts...)
+
+	decoder := xml.NewDecoder(r)
+
+	for i := 0; ; {
+		var token xml.Token
+
+		token, err = decoder.Token()
+		if errors.Is(err, io.EOF) {
+			err = nil
+
+			break
+		}
+
+		if err != nil {
+			err = fmt.Errorf("error reading Burp export: %w", err)
+
+			return
+		}
+
+		start, ok := token.(xml.StartElement)
+		if !ok || start.Name.Local != "item" {
+			continue
+		}
+
+		var item burpItem
+
+		if err = decoder.DecodeElement(&item, &start); err != nil {
+			err = fmt.Errorf("error reading Burp export: %w", err)
+
+			return
+		}
+
+		prefix := "items." + strconv.Itoa(i)
+
+		matches = appendValue(matches, strings.TrimSpace(item.URL), prefix+".url")
+		matches = options.extract(matches, item.Request.text(), prefix+".request")
+		matches = options.extract(matches, item.Response.text(), prefix+".response")
+
+		i++
+	}
+
+	return
+}
+
+// text returns the decoded message, or an empty string if it cannot be decoded.
+func (m burpMessage) text() (text string) {
+	if !m.Base64 {
+		text = m.Data
+
+		return
+	}
+
+	decoded, err := base64.StdEncoding.DecodeString(strings.TrimSpace(m.Data))
+	if err != nil {
+		return
+	}
+
+	text = string(decoded)
+
+	return
+}
+
+// appendValue appends value, a URL given as is by the input, as a match spanning the
+// whole value. Empty values are skipped.
+func appendValue(matches []Match, value, origin string) []Match {
+	if value == "" {
+		return matches
+	}
+
+	return append(matches, Match{
+		Match: hqgourl.Match{
+			Value: value,
+			Start: 0,
+			End:   len(value),
+			Type:  hqgourl.MatchTypeURL,
+		},
+		Origin: origin,
+	})
+}
+
+// isBinaryMimeType reports whether mimeType is the type of binary content (images, audio,
+// video and fonts).
+func isBinaryMimeType(mimeType string) (binary bool) {
+	for _, prefix := range []string{"image/", "audio/", "video/", "font/"} {
+		if strings.HasPrefix(mimeType, prefix) {
+			binary = mimeType != "image/svg+xml"
+
+			return
+		}
+	}
+
+	return
+}
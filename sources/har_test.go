@@ -0,0 +1,98 @@
+package sources_test
+
+import (
+	"encoding/base64"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	hqgourl "go.source.hueristiq.com/url"
+	"go.source.hueristiq.com/url/sources"
+)
+
+func origins(matches []sources.Match) (found []string) {
+	for _, match := range matches {
+		found = append(found, match.Origin+" "+match.Value)
+	}
+
+	return
+}
+
+func TestExtractFromHAR(t *testing.T) {
+	t.Parallel()
+
+	archive := `{"log": {"version": "1.2", "entries": [
+		{
+			"request": {"method": "GET", "url": "https://www.example.com/"},
+			"response": {"status": 200, "redirectURL": "", "content": {"mimeType": "text/html", "text": "<script src=\"https://cdn.example.com/app.js\"></script>"}}
+		},
+		{
+			"request": {"method": "GET", "url": "https://example.com/login"},
+			"response": {"status": 302, "redirectURL": "https://sso.example.com/auth", "content": {"mimeType": "application/json", "encoding": "base64", "text": "` + base64.StdEncoding.EncodeToString([]byte(`{"next":"https://api.example.com/v1"}`)) + `"}}
+		},
+		{
+			"request": {"method": "GET", "url": "https://example.com/logo.png"},
+			"response": {"status": 200, "content": {"mimeType": "image/png", "text": "https://binary.example.com"}}
+		}
+	]}}`
+
+	matches, err := sources.ExtractFromHAR(strings.NewReader(archive), sources.WithExtractor(hqgourl.NewExtractor(hqgourl.ExtractorWithScheme())))
+
+	require.NoError(t, err)
+	assert.Equal(t, []string{
+		"entries.0.request.url https://www.example.com/",
+		"entries.0.response.content https://cdn.example.com/app.js",
+		"entries.1.request.url https://example.com/login",
+		"entries.1.response.redirectURL https://sso.example.com/auth",
+		"entries.1.response.content https://api.example.com/v1",
+		"entries.2.request.url https://example.com/logo.png",
+	}, origins(matches))
+
+	assert.Equal(t, hqgourl.MatchTypeURL, matches[0].Type)
+	assert.Equal(t, len("https://www.example.com/"), matches[0].End)
+
+	_, err = sources.ExtractFromHAR(strings.NewReader("{"))
+
+	require.Error(t, err)
+}
+
+func TestExtractFromBurp(t *testing.T) {
+	t.Parallel()
+
+	request := "GET /account HTTP/1.1\r\nHost: example.com\r\nReferer: https://ref.example.com/page\r\n\r\n"
+	response := "HTTP/1.1 200 OK\r\nContent-Type: text/html\r\n\r\n<a href='https://link.example.com/x'>x</a>"
+
+	export := `<?xml version="1.0"?>
+<!DOCTYPE items [<!ELEMENT items (item*)>]>
+<items burpVersion="2024.1">
+  <item>
+    <time>Mon Jan 01 00:00:00 UTC 2024</time>
+    <url><![CDATA[https://example.com/account]]></url>
+    <host ip="192.0.2.1">example.com</host>
+    <request base64="true"><![CDATA[` + base64.StdEncoding.EncodeToString([]byte(request)) + `]]></request>
+    <response base64="true"><![CDATA[` + base64.StdEncoding.EncodeToString([]byte(response)) + `]]></response>
+  </item>
+  <item>
+    <url><![CDATA[https://example.com/plain]]></url>
+    <request base64="false"><![CDATA[GET /plain HTTP/1.1
+Referer: https://plain.example.com/]]></request>
+    <response base64="false"></response>
+  </item>
+</items>`
+
+	matches, err := sources.ExtractFromBurp(strings.NewReader(export), sources.WithExtractor(hqgourl.NewExtractor(hqgourl.ExtractorWithScheme())))
+
+	require.NoError(t, err)
+	assert.Equal(t, []string{
+		"items.0.url https://example.com/account",
+		"items.0.request https://ref.example.com/page",
+		"items.0.response https://link.example.com/x",
+		"items.1.url https://example.com/plain",
+		"items.1.request https://plain.example.com/",
+	}, origins(matches))
+
+	_, err = sources.ExtractFromBurp(strings.NewReader("<items><item><url>"))
+
+	require.Error(t, err)
+}
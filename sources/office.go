@@ -0,0 +1,159 @@
+package sources
+
+import (
+	"archive/zip"
+	"bytes"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"path"
+	"strings"
+)
+
+// ExtractFromOffice extracts URLs from an Office Open XML document (.docx, .xlsx, .pptx,
+// and their macro-enabled variants). It traverses the XML members of the document
+// package: the text of paragraphs, cells and shared strings, and the attribute values,
+// which hold e.g. the targets of hyperlinks in the relationship (".rels") members. The
+// Origin of each match is the member it was found in (e.g., "word/document.xml").
+//
+// Parameters:
+//   - r (io.Reader): The document.
+//   - opts (variadic OptionFunc): Options configuring the extraction.
+//
+// Returns:
+//   - matches ([]Match): The matches, with offsets into the text of their member.
+//   - err (error): ErrTooLarge (wrapped) if the document, or the data decompressed from its
+//     members, exceeds the maximum size (see WithMaxSize), or an error if r cannot be read
+//     or is not a ZIP archive.
+func ExtractFromOffice(r io.Reader, opts ...OptionFunc) (matches []Match, err error) {
+	options := newOptions(opts...)
+
+	data, err := io.ReadAll(newLimitedReader(r, options.MaxSize))
+	if err != nil {
+		err = fmt.Errorf("error reading input: %w", err)
+
+		return
+	}
+
+	archive, err := zip.NewReader(bytes.NewReader(data), int64(len(data)))
+	if err != nil {
+		err = fmt.Errorf("error reading document: %w", err)
+
+		return
+	}
+
+	// The members are decompressed through a single budget, so that the limit applies to
+	// all of them together.
+	budget := newLimitedReader(nil, options.MaxSize)
+
+	for _, member := range archive.File {
+		if extension := path.Ext(member.Name); extension != ".xml" && extension != ".rels" {
+			continue
+		}
+
+		var text string
+
+		text, err = officeMemberText(member, budget)
+		if err != nil {
+			err = fmt.Errorf("error reading %s: %w", member.Name, err)
+
+			return
+		}
+
+		matches = options.extract(matches, text, member.Name)
+	}
+
+	return
+}
+
+// officeMemberText returns the text of an XML member of an Office document, decompressed
+// through budget.
+func officeMemberText(member *zip.File, budget *limitedReader) (text string, err error) {
+	reader, err := member.Open()
+	if err != nil {
+		return
+	}
+
+	defer reader.Close()
+
+	budget.r = reader
+
+	text, err = xmlText(budget)
+
+	return
+}
+
+// officeBlockElements are the (local) names of the elements that end a line of text:
+// paragraphs ("p"), table rows and cells ("tr", "tc", "c"), shared strings ("si") and
+// comments.
+var officeBlockElements = map[string]bool{
+	"p":       true,
+	"tr":      true,
+	"tc":      true,
+	"c":       true,
+	"si":      true,
+	"comment": true,
+}
+
+// officeLinkAttributes are the (local) names of the attributes that hold links: the
+// targets of relationships, the instructions of simple fields (e.g.,
+// `HYPERLINK "https://example.com"`), and the targets and tooltips of hyperlinks. Other
+// attributes are skipped, as they hold namespace and schema URIs.
+var officeLinkAttributes = map[string]bool{
+	"Target":  true,
+	"instr":   true,
+	"href":    true,
+	"tooltip": true,
+}
+
+// xmlText returns the text of an XML document: the character data, with a newline after
+// each block element and a space for tabs and breaks, followed by the values of link
+// attributes, one per line. Runs of text within a paragraph are joined without separator, since
+// editors split words (and URLs) across runs.
+func xmlText(r io.Reader) (text string, err error) {
+	decoder := xml.NewDecoder(r)
+
+	var content, attributes strings.Builder
+
+	for {
+		var token xml.Token
+
+		token, err = decoder.Token()
+		if errors.Is(err, io.EOF) {
+			err = nil
+
+			break
+		}
+
+		if err != nil {
+			return
+		}
+
+		switch token := token.(type) {
+		case xml.StartElement:
+			if token.Name.Local == "tab" || token.Name.Local == "br" {
+				content.WriteByte(' ')
+			}
+
+			for _, attribute := range token.Attr {
+				if !officeLinkAttributes[attribute.Name.Local] {
+					continue
+				}
+
+				attributes.WriteString(attribute.Value)
+				attributes.WriteByte('\n')
+			}
+		case xml.EndElement:
+			if officeBlockElements[token.Name.Local] {
+				content.WriteByte('\n')
+			}
+		case xml.CharData:
+			content.Write(token)
+		}
+	}
+
+	text = content.String() + "\n" + attributes.String()
+
+	return
+}
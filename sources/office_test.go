@@ -0,0 +1,97 @@
+package sources_test
+
+import (
+	"archive/zip"
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.source.hueristiq.com/url/sources"
+)
+
+func TestExtractFromOffice(t *testing.T) {
+	t.Parallel()
+
+	var document bytes.Buffer
+
+	archive := zip.NewWriter(&document)
+
+	members := []struct {
+		name, content string
+	}{
+		{"[Content_Types].xml", `<?xml version="1.0"?><Types xmlns="http://schemas.openxmlformats.org/package/2006/content-types"/>`},
+		{"word/document.xml", `<w:document xmlns:w="http://schemas.openxmlformats.org/wordprocessingml/2006/main"><w:body><w:p><w:r><w:t>See https://exa</w:t></w:r><w:r><w:t>mple.com/docs</w:t></w:r></w:p><w:p><w:fldSimple w:instr=' HYPERLINK "https://field.example.com" '/></w:p></w:body></w:document>`},
+		{"word/_rels/document.xml.rels", `<Relationships xmlns="http://schemas.openxmlformats.org/package/2006/relationships"><Relationship Id="rId1" Type="http://schemas.openxmlformats.org/officeDocument/2006/relationships/hyperlink" Target="https://rels.example.com/x" TargetMode="External"/></Relationships>`},
+		{"xl/sharedStrings.xml", `<sst xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main"><si><t>https://cell.example.com</t></si><si><t>plain</t></si></sst>`},
+		{"word/media/image1.png", `https://binary.example.com`},
+	}
+
+	for _, member := range members {
+		writer, err := archive.Create(member.name)
+
+		require.NoError(t, err)
+
+		_, err = writer.Write([]byte(member.content))
+
+		require.NoError(t, err)
+	}
+
+	require.NoError(t, archive.Close())
+
+	matches, err := sources.ExtractFromOffice(bytes.NewReader(document.Bytes()))
+
+	require.NoError(t, err)
+
+	var found []string
+
+	for _, match := range matches {
+		found = append(found, match.Origin+" "+match.Value)
+	}
+
+	assert.Equal(t, []string{
+		"word/document.xml https://example.com/docs",
+		"word/document.xml https://field.example.com",
+		"word/_rels/document.xml.rels https://rels.example.com/x",
+		"xl/sharedStrings.xml https://cell.example.com",
+	}, found)
+
+	_, err = sources.ExtractFromOffice(strings.NewReader("not a zip"))
+
+	require.Error(t, err)
+}
+
+func TestExtractFromOffice_MaxSize(t *testing.T) {
+	t.Parallel()
+
+	var document bytes.Buffer
+
+	archive := zip.NewWriter(&document)
+
+	// Two members decompressing to 512 KiB each, from about 1 KiB each.
+	for _, name := range []string{"word/document.xml", "word/footer.xml"} {
+		writer, err := archive.Create(name)
+
+		require.NoError(t, err)
+
+		_, err = writer.Write([]byte("<w:t>https://example.com" + strings.Repeat(" ", 512<<10) + "</w:t>"))
+
+		require.NoError(t, err)
+	}
+
+	require.NoError(t, archive.Close())
+
+	_, err := sources.ExtractFromOffice(bytes.NewReader(document.Bytes()), sources.WithMaxSize(768<<10))
+
+	require.ErrorIs(t, err, sources.ErrTooLarge)
+
+	_, err = sources.ExtractFromOffice(bytes.NewReader(document.Bytes()), sources.WithMaxSize(1<<10))
+
+	require.ErrorIs(t, err, sources.ErrTooLarge)
+
+	matches, err := sources.ExtractFromOffice(bytes.NewReader(document.Bytes()), sources.WithMaxSize(2<<20))
+
+	require.NoError(t, err)
+	assert.Len(t, matches, 2)
+}
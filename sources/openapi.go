@@ -0,0 +1,353 @@
+package sources
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/url"
+	"slices"
+	"strconv"
+	"strings"
+
+	hqgourl "go.source.hueristiq.com/url"
+	"go.source.hueristiq.com/url/builder"
+	"gopkg.in/yaml.v3"
+)
+
+// openAPISpec is the part of an OpenAPI 3 or Swagger 2 specification the endpoints are
+// resolved from.
+type openAPISpec struct {
+	// Swagger 2.
+	Host       string                      `json:"host"       yaml:"host"`
+	BasePath   string                      `json:"basePath"   yaml:"basePath"`
+	Schemes    []string                    `json:"schemes"    yaml:"schemes"`
+	Parameters map[string]openAPIParameter `json:"parameters" yaml:"parameters"`
+
+	// OpenAPI 3.
+	Servers    []openAPIServer `json:"servers" yaml:"servers"`
+	Components struct {
+		Parameters map[string]openAPIParameter `json:"parameters" yaml:"parameters"`
+	} `json:"components" yaml:"components"`
+
+	Paths map[string]openAPIPathItem `json:"paths" yaml:"paths"`
+}
+
+// openAPIServer is an OpenAPI 3 server, whose URL may hold "{variable}" templates.
+type openAPIServer struct {
+	URL       string `json:"url" yaml:"url"`
+	Variables map[string]struct {
+		Default string `json:"default" yaml:"default"`
+	} `json:"variables" yaml:"variables"`
+}
+
+// openAPIPathItem is the item of a path, with its operations.
+type openAPIPathItem struct {
+	Servers    []openAPIServer    `json:"servers"    yaml:"servers"`
+	Parameters []openAPIParameter `json:"parameters" yaml:"parameters"`
+	Get        *openAPIOperation  `json:"get"        yaml:"get"`
+	Put        *openAPIOperation  `json:"put"        yaml:"put"`
+	Post       *openAPIOperation  `json:"post"       yaml:"post"`
+	Delete     *openAPIOperation  `json:"delete"     yaml:"delete"`
+	Options    *openAPIOperation  `json:"options"    yaml:"options"`
+	Head       *openAPIOperation  `json:"head"       yaml:"head"`
+	Patch      *openAPIOperation  `json:"patch"      yaml:"patch"`
+	Trace      *openAPIOperation  `json:"trace"      yaml:"trace"`
+}
+
+// openAPIOperation is an operation (an HTTP method) on a path.
+type openAPIOperation struct {
+	Servers    []openAPIServer    `json:"servers"    yaml:"servers"`
+	Parameters []openAPIParameter `json:"parameters" yaml:"parameters"`
+}
+
+// openAPIParameter is a parameter of an operation, possibly a reference to a parameter
+// defined once for the whole specification.
+type openAPIParameter struct {
+	Ref     string `json:"$ref"    yaml:"$ref"`
+	Name    string `json:"name"    yaml:"name"`
+	In      string `json:"in"      yaml:"in"`
+	Example any    `json:"example" yaml:"example"`
+	Default any    `json:"default" yaml:"default"`
+	Enum    []any  `json:"enum"    yaml:"enum"`
+	Schema  *struct {
+		Example any   `json:"example" yaml:"example"`
+		Default any   `json:"default" yaml:"default"`
+		Enum    []any `json:"enum"    yaml:"enum"`
+	} `json:"schema" yaml:"schema"`
+}
+
+// value returns the value of the parameter, taken from its example, default or first enum
+// value (or those of its schema), or the "{name}" template if it has none.
+func (p openAPIParameter) value() (value string) {
+	candidates := []any{p.Example, p.Default}
+
+	if len(p.Enum) > 0 {
+		candidates = append(candidates, p.Enum[0])
+	}
+
+	if p.Schema != nil {
+		candidates = append(candidates, p.Schema.Example, p.Schema.Default)
+
+		if len(p.Schema.Enum) > 0 {
+			candidates = append(candidates, p.Schema.Enum[0])
+		}
+	}
+
+	for _, candidate := range candidates {
+		if candidate != nil {
+			value = fmt.Sprint(candidate)
+
+			return
+		}
+	}
+
+	value = "{" + p.Name + "}"
+
+	return
+}
+
+// ExtractFromOpenAPI extracts the endpoint URLs of an OpenAPI 3 or Swagger 2 specification,
+// in JSON or YAML: each path, for each of its operations, joined to each server (the
+// "servers" of OpenAPI 3, or the "schemes", "host" and "basePath" of Swagger 2) and built
+// with the builder package. Path and query parameters take their example, default or first
+// enum value, or are left as "{name}" templates (escaped in the URL); server variables take
+// their default value. Local references to shared parameters are resolved.
+//
+// The Origin of each match is its operation, e.g. "paths./users/{id}.get"; operations on
+// the same path resolving to the same URL yield a single match. Endpoints of servers with a
+// host are matches of type hqgourl.MatchTypeURL; without host (e.g., the "/api/v3" server
+// of a specification served by the API itself), they are relative matches. Matches span
+// their whole value. The extractor option is not used.
+//
+// Parameters:
+//   - r (io.Reader): The specification.
+//   - opts (variadic OptionFunc): Options configuring the extraction.
+//
+// Returns:
+//   - matches ([]Match): The matches.
+//   - err (error): An error if r cannot be read or decoded.
+func ExtractFromOpenAPI(r io.Reader, _ ...OptionFunc) (matches []Match, err error) {
+	data, err := io.ReadAll(r)
+	if err != nil {
+		err = fmt.Errorf("error reading OpenAPI specification: %w", err)
+
+		return
+	}
+
+	var spec openAPISpec
+
+	if trimmed := bytes.TrimSpace(data); len(trimmed) > 0 && trimmed[0] == '{' {
+		err = json.Unmarshal(trimmed, &spec)
+	} else {
+		err = yaml.Unmarshal(data, &spec)
+	}
+
+	if err != nil {
+		err = fmt.Errorf("error decoding OpenAPI specification: %w", err)
+
+		return
+	}
+
+	paths := make([]string, 0, len(spec.Paths))
+
+	for path := range spec.Paths {
+		paths = append(paths, path)
+	}
+
+	slices.Sort(paths)
+
+	for _, path := range paths {
+		item := spec.Paths[path]
+
+		operations := []struct {
+			method    string
+			operation *openAPIOperation
+		}{
+			{"get", item.Get}, {"put", item.Put}, {"post", item.Post}, {"delete", item.Delete},
+			{"options", item.Options}, {"head", item.Head}, {"patch", item.Patch}, {"trace", item.Trace},
+		}
+
+		seen := map[string]struct{}{}
+
+		for _, o := range operations {
+			if o.operation == nil {
+				continue
+			}
+
+			servers := spec.serverURLs(item.Servers, o.operation.Servers)
+			parameters := spec.parameters(item.Parameters, o.operation.Parameters)
+
+			for _, server := range servers {
+				value, typ := endpoint(server, path, parameters)
+
+				if _, ok := seen[value]; ok {
+					continue
+				}
+
+				seen[value] = struct{}{}
+
+				matches = append(matches, Match{
+					Match: hqgourl.Match{
+						Value: value,
+						Start: 0,
+						End:   len(value),
+						Type:  typ,
+					},
+					Origin: "paths." + path + "." + o.method,
+				})
+			}
+		}
+	}
+
+	return
+}
+
+// serverURLs returns the URLs of the servers of an operation: the ones of the operation,
+// else of its path, else of the specification, with their variables substituted.
+func (spec *openAPISpec) serverURLs(pathServers, operationServers []openAPIServer) (URLs []string) {
+	servers := operationServers
+
+	if len(servers) == 0 {
+		servers = pathServers
+	}
+
+	if len(servers) == 0 {
+		servers = spec.Servers
+	}
+
+	for _, server := range servers {
+		URL := server.URL
+
+		for name, variable := range server.Variables {
+			URL = strings.ReplaceAll(URL, "{"+name+"}", variable.Default)
+		}
+
+		URLs = append(URLs, URL)
+	}
+
+	if len(URLs) > 0 {
+		return
+	}
+
+	// Swagger 2, or OpenAPI 3 without servers ("/").
+	if spec.Host == "" {
+		URLs = append(URLs, spec.BasePath)
+
+		return
+	}
+
+	schemes := spec.Schemes
+
+	if len(schemes) == 0 {
+		schemes = []string{"https"}
+	}
+
+	for _, scheme := range schemes {
+		URLs = append(URLs, scheme+"://"+spec.Host+spec.BasePath)
+	}
+
+	return
+}
+
+// parameters returns the path and query parameters of an operation, with references
+// resolved: the ones of its path, overridden by the ones of the operation with the same
+// name and location.
+func (spec *openAPISpec) parameters(pathParameters, operationParameters []openAPIParameter) (parameters []openAPIParameter) {
+	for _, parameter := range slices.Concat(pathParameters, operationParameters) {
+		if parameter.Ref != "" {
+			name := parameter.Ref[strings.LastIndex(parameter.Ref, "/")+1:]
+
+			switch {
+			case strings.HasPrefix(parameter.Ref, "#/components/parameters/"):
+				parameter = spec.Components.Parameters[name]
+			case strings.HasPrefix(parameter.Ref, "#/parameters/"):
+				parameter = spec.Parameters[name]
+			default:
+				continue
+			}
+		}
+
+		if parameter.In != "path" && parameter.In != "query" {
+			continue
+		}
+
+		i := slices.IndexFunc(parameters, func(p openAPIParameter) bool {
+			return p.Name == parameter.Name && p.In == parameter.In
+		})
+
+		if i >= 0 {
+			parameters[i] = parameter
+		} else {
+			parameters = append(parameters, parameter)
+		}
+	}
+
+	return
+}
+
+// endpoint returns the URL of path on server, with its parameters, built with the builder
+// package if server has a host, and its match type.
+func endpoint(server, path string, parameters []openAPIParameter) (value string, typ hqgourl.MatchType) {
+	// The query is kept in the order of the parameters, as Builder.Param does.
+	var query []string
+
+	for _, parameter := range parameters {
+		switch parameter.In {
+		case "path":
+			path = strings.ReplaceAll(path, "{"+parameter.Name+"}", parameter.value())
+		case "query":
+			query = append(query, parameter.Name, parameter.value())
+		}
+	}
+
+	parsed, err := url.Parse(server)
+	if err != nil || parsed.Host == "" {
+		relative := &url.URL{Path: strings.TrimSuffix(server, "/") + path, RawQuery: encodeQuery(query)}
+
+		value, typ = relative.String(), hqgourl.MatchTypeRelative
+
+		return
+	}
+
+	typ = hqgourl.MatchTypeURL
+
+	b := builder.New().Scheme(parsed.Scheme).Host(parsed.Hostname()).Path(strings.TrimSuffix(parsed.Path, "/") + path)
+
+	if port, err := strconv.Atoi(parsed.Port()); err == nil {
+		b.Port(port)
+	}
+
+	for i := 0; i < len(query); i += 2 {
+		b.Param(query[i], query[i+1])
+	}
+
+	built, err := b.Build()
+	if err != nil {
+		// The builder rejects hosts without a known TLD (e.g., "api.internal"): keep the
+		// server as is.
+		parsed.Path = strings.TrimSuffix(parsed.Path, "/") + path
+		parsed.RawQuery = encodeQuery(query)
+
+		value = parsed.String()
+
+		return
+	}
+
+	value = built.String()
+
+	return
+}
+
+// encodeQuery encodes the key and value pairs of query in order, as Builder.Param does.
+func encodeQuery(query []string) (encoded string) {
+	pairs := make([]string, 0, len(query)/2)
+
+	for i := 0; i < len(query); i += 2 {
+		pairs = append(pairs, url.QueryEscape(query[i])+"="+url.QueryEscape(query[i+1]))
+	}
+
+	encoded = strings.Join(pairs, "&")
+
+	return
+}
@@ -0,0 +1,92 @@
+package sources_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	hqgourl "go.source.hueristiq.com/url"
+	"go.source.hueristiq.com/url/sources"
+)
+
+func TestExtractFromOpenAPI_YAML(t *testing.T) {
+	t.Parallel()
+
+	spec := `openapi: 3.0.3
+servers:
+  - url: https://{region}.api.example.com/v1
+    variables:
+      region:
+        default: eu
+  - url: /v1
+components:
+  parameters:
+    Limit:
+      name: limit
+      in: query
+      schema:
+        type: integer
+        default: 20
+paths:
+  /users:
+    get:
+      parameters:
+        - $ref: '#/components/parameters/Limit'
+        - name: role
+          in: query
+          schema:
+            enum: [admin, user]
+    post: {}
+  /users/{id}:
+    parameters:
+      - name: id
+        in: path
+        required: true
+    delete: {}
+`
+
+	matches, err := sources.ExtractFromOpenAPI(strings.NewReader(spec))
+
+	require.NoError(t, err)
+	assert.Equal(t, []string{
+		"paths./users.get https://eu.api.example.com/v1/users?limit=20&role=admin",
+		"paths./users.get /v1/users?limit=20&role=admin",
+		"paths./users.post https://eu.api.example.com/v1/users",
+		"paths./users.post /v1/users",
+		"paths./users/{id}.delete https://eu.api.example.com/v1/users/%7Bid%7D",
+		"paths./users/{id}.delete /v1/users/%7Bid%7D",
+	}, origins(matches))
+
+	assert.Equal(t, hqgourl.MatchTypeURL, matches[0].Type)
+	assert.Equal(t, hqgourl.MatchTypeRelative, matches[1].Type)
+}
+
+func TestExtractFromOpenAPI_Swagger(t *testing.T) {
+	t.Parallel()
+
+	spec := `{
+	"swagger": "2.0",
+	"host": "petstore.example.com:8443",
+	"basePath": "/api",
+	"schemes": ["https", "http"],
+	"paths": {
+		"/pets/{petId}": {
+			"get": {"parameters": [{"name": "petId", "in": "path", "type": "integer", "example": 42}]},
+			"put": {"parameters": [{"name": "petId", "in": "path", "type": "integer", "example": 42}]}
+		}
+	}
+}`
+
+	matches, err := sources.ExtractFromOpenAPI(strings.NewReader(spec))
+
+	require.NoError(t, err)
+	assert.Equal(t, []string{
+		"paths./pets/{petId}.get https://petstore.example.com:8443/api/pets/42",
+		"paths./pets/{petId}.get http://petstore.example.com:8443/api/pets/42",
+	}, origins(matches))
+
+	_, err = sources.ExtractFromOpenAPI(strings.NewReader("{"))
+
+	require.Error(t, err)
+}
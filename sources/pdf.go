@@ -0,0 +1,335 @@
+package sources
+
+import (
+	"bytes"
+	"compress/zlib"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+)
+
+// ExtractFromPDF extracts URLs from the text layer of a PDF document: the text drawn by
+// its content streams, and the strings of its objects, which hold e.g. the targets of
+// link annotations ("/URI") and the document metadata. Flate-compressed streams are
+// decompressed; streams with other filters (e.g., images) are skipped. Text drawn with
+// fonts that use custom encodings is not decoded, and scanned pages have no text layer.
+//
+// Parameters:
+//   - r (io.Reader): The PDF document.
+//   - opts (variadic OptionFunc): Options configuring the extraction.
+//
+// Returns:
+//   - matches ([]Match): The matches, with offsets into the extracted text.
+//   - err (error): ErrTooLarge (wrapped) if the document, or the data decompressed from its
+//     streams, exceeds the maximum size (see WithMaxSize), or an error if r cannot be read.
+func ExtractFromPDF(r io.Reader, opts ...OptionFunc) (matches []Match, err error) {
+	options := newOptions(opts...)
+
+	data, err := io.ReadAll(newLimitedReader(r, options.MaxSize))
+	if err != nil {
+		err = fmt.Errorf("error reading input: %w", err)
+
+		return
+	}
+
+	text, err := pdfText(data, options.MaxSize)
+	if err != nil {
+		err = fmt.Errorf("error reading streams: %w", err)
+
+		return
+	}
+
+	matches = options.extract(matches, text, "")
+
+	return
+}
+
+// pdfText returns the text layer of a PDF document, decompressing at most maxSize bytes
+// from its streams in total.
+func pdfText(data []byte, maxSize int64) (text string, err error) {
+	var builder strings.Builder
+
+	budget := newLimitedReader(nil, maxSize)
+
+	for {
+		start := bytes.Index(data, []byte("stream"))
+		if start < 0 {
+			writePDFStrings(&builder, data)
+
+			break
+		}
+
+		// Skip stray "endstream" keywords.
+		if bytes.HasSuffix(data[:start], []byte("end")) {
+			writePDFStrings(&builder, data[:start])
+
+			data = data[start+len("stream"):]
+
+			continue
+		}
+
+		dictionary := data[:start]
+
+		// The stream data starts after the end-of-line following the keyword.
+		body := data[start+len("stream"):]
+		body = bytes.TrimPrefix(body, []byte("\r"))
+		body = bytes.TrimPrefix(body, []byte("\n"))
+
+		end := bytes.Index(body, []byte("endstream"))
+		if end < 0 {
+			end = len(body)
+		}
+
+		writePDFStrings(&builder, dictionary)
+
+		content, ok, err := decodePDFStream(dictionary, body[:end], budget)
+		if err != nil {
+			return text, err
+		}
+
+		if ok {
+			writePDFStrings(&builder, content)
+		}
+
+		data = body[end:]
+
+		if end < len(body) {
+			data = body[end+len("endstream"):]
+		}
+	}
+
+	text = builder.String()
+
+	return
+}
+
+// decodePDFStream decodes the data of a stream, given the data preceding it, which ends
+// with the stream dictionary. The decompressed data is read through budget.
+func decodePDFStream(preceding, data []byte, budget *limitedReader) (content []byte, ok bool, err error) {
+	dictionary := preceding
+
+	if i := bytes.LastIndex(preceding, []byte("obj")); i >= 0 {
+		dictionary = preceding[i:]
+	}
+
+	switch {
+	case bytes.Contains(dictionary, []byte("/FlateDecode")):
+		reader, zlibErr := zlib.NewReader(bytes.NewReader(data))
+		if zlibErr != nil {
+			return
+		}
+
+		budget.r = reader
+
+		// Keep what could be decompressed of truncated or corrupt streams.
+		content, err = io.ReadAll(budget)
+		if !errors.Is(err, ErrTooLarge) {
+			err = nil
+		}
+
+		ok = err == nil && len(content) > 0
+	case !bytes.Contains(dictionary, []byte("/Filter")):
+		content, ok = data, true
+	}
+
+	return
+}
+
+// writePDFStrings writes the literal and hexadecimal strings of PDF content to builder,
+// one per line. The strings of an array shown with the TJ operator, which draws text
+// with adjusted spacing, are written on a single line, so that text drawn in pieces
+// stays together.
+func writePDFStrings(builder *strings.Builder, content []byte) {
+	var array [][]byte
+
+	depth := 0
+
+	write := func(value []byte) {
+		if depth > 0 {
+			array = append(array, value)
+
+			return
+		}
+
+		builder.Write(value)
+		builder.WriteByte('\n')
+	}
+
+	for i := 0; i < len(content); i++ {
+		switch c := content[i]; {
+		case c == '(':
+			var literal []byte
+
+			literal, i = pdfLiteralString(content, i+1)
+
+			write(literal)
+		case c == '<' && i+1 < len(content) && content[i+1] != '<':
+			end := bytes.IndexByte(content[i:], '>')
+			if end < 0 {
+				end = len(content) - i
+			}
+
+			write(pdfHexString(content[i+1 : i+end]))
+
+			i += end
+		case c == '<' || c == '>':
+			// Dictionary delimiters ("<<", ">>").
+			if i+1 < len(content) && content[i+1] == c {
+				i++
+			}
+		case c == '[':
+			depth++
+		case c == ']' && depth > 0:
+			depth--
+
+			if depth > 0 {
+				continue
+			}
+
+			separator := []byte("\n")
+
+			if bytes.HasPrefix(bytes.TrimLeft(content[i+1:], " \t\r\n\f\x00"), []byte("TJ")) {
+				separator = nil
+			}
+
+			builder.Write(bytes.Join(array, separator))
+			builder.WriteByte('\n')
+
+			array = nil
+		case c == '%':
+			// Comments run to the end of the line.
+			end := bytes.IndexAny(content[i:], "\r\n")
+			if end < 0 {
+				end = len(content) - i
+			}
+
+			i += end
+		}
+	}
+
+	for _, value := range array {
+		builder.Write(value)
+		builder.WriteByte('\n')
+	}
+}
+
+// pdfLiteralString decodes the literal string starting at content[start], just after its
+// opening parenthesis, and returns it with the index of its closing parenthesis.
+func pdfLiteralString(content []byte, start int) (literal []byte, end int) {
+	depth := 0
+
+	for end = start; end < len(content); end++ {
+		c := content[end]
+
+		switch c {
+		case '\\':
+			end++
+
+			if end >= len(content) {
+				return
+			}
+
+			var decoded byte
+
+			decoded, end = pdfEscape(content, end)
+
+			if decoded != 0 {
+				literal = append(literal, decoded)
+			}
+
+			continue
+		case '(':
+			depth++
+		case ')':
+			if depth == 0 {
+				return
+			}
+
+			depth--
+		}
+
+		literal = append(literal, c)
+	}
+
+	return
+}
+
+// pdfEscape decodes the escape sequence whose character after the backslash is
+// content[i], and returns the decoded byte (0 for a line continuation) with the index of
+// the last byte of the sequence.
+func pdfEscape(content []byte, i int) (decoded byte, end int) {
+	end = i
+
+	switch c := content[i]; c {
+	case 'n':
+		decoded = '\n'
+	case 'r':
+		decoded = '\r'
+	case 't':
+		decoded = '\t'
+	case 'b':
+		decoded = '\b'
+	case 'f':
+		decoded = '\f'
+	case '\r', '\n':
+		if c == '\r' && i+1 < len(content) && content[i+1] == '\n' {
+			end++
+		}
+	default:
+		if c < '0' || c > '7' {
+			decoded = c
+
+			return
+		}
+
+		value := 0
+
+		for end < len(content) && end < i+3 && content[end] >= '0' && content[end] <= '7' {
+			value = value*8 + int(content[end]-'0')
+			end++
+		}
+
+		end--
+
+		decoded = byte(value) //nolint:gosec // Octal escapes are at most \377.
+	}
+
+	return
+}
+
+// pdfHexString decodes the contents of a hexadecimal string, ignoring whitespace. An odd
+// final digit is padded with zero.
+func pdfHexString(hex []byte) (decoded []byte) {
+	var digits []byte
+
+	for _, c := range hex {
+		if value, ok := hexValue(c); ok {
+			digits = append(digits, value)
+		}
+	}
+
+	if len(digits)%2 == 1 {
+		digits = append(digits, 0)
+	}
+
+	for i := 0; i < len(digits); i += 2 {
+		decoded = append(decoded, digits[i]<<4|digits[i+1])
+	}
+
+	return
+}
+
+// hexValue returns the value of a hexadecimal digit.
+func hexValue(c byte) (value byte, ok bool) {
+	switch {
+	case c >= '0' && c <= '9':
+		value, ok = c-'0', true
+	case c >= 'a' && c <= 'f':
+		value, ok = c-'a'+10, true
+	case c >= 'A' && c <= 'F':
+		value, ok = c-'A'+10, true
+	}
+
+	return
+}
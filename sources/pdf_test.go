@@ -0,0 +1,94 @@
+package sources_test
+
+import (
+	"bytes"
+	"compress/zlib"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	hqgourl "go.source.hueristiq.com/url"
+	"go.source.hueristiq.com/url/sources"
+)
+
+func values(matches []sources.Match) (values []string) {
+	for _, match := range matches {
+		values = append(values, match.Value)
+	}
+
+	return
+}
+
+func TestExtractFromPDF(t *testing.T) {
+	t.Parallel()
+
+	var compressed bytes.Buffer
+
+	writer := zlib.NewWriter(&compressed)
+
+	_, err := writer.Write([]byte("BT /F1 12 Tf 72 712 Td [(Visit https://exa) -20 (mple.com/docs)] TJ 0 -14 Td <68747470733a2f2f6865782e6578616d706c652e636f6d> Tj ET"))
+
+	require.NoError(t, err)
+	require.NoError(t, writer.Close())
+
+	var document bytes.Buffer
+
+	document.WriteString("%PDF-1.4\n")
+	document.WriteString("1 0 obj\n<< /Type /Annot /Subtype /Link /A << /S /URI /URI (https://link.example.com/a\\(1\\)) >> >>\nendobj\n")
+	document.WriteString("2 0 obj\n<< /Filter /FlateDecode >>\nstream\n")
+	document.Write(compressed.Bytes())
+	document.WriteString("\nendstream\nendobj\n")
+	document.WriteString("3 0 obj\n<< /Length 20 /Filter /DCTDecode >>\nstream\n(https://image.example.com)\nendstream\nendobj\n")
+	document.WriteString("4 0 obj\n<< /Title (Report) /Author (https://author.example.com) >>\nendobj\n%%EOF\n")
+
+	matches, err := sources.ExtractFromPDF(bytes.NewReader(document.Bytes()), sources.WithExtractor(hqgourl.NewExtractor(hqgourl.ExtractorWithScheme())))
+
+	require.NoError(t, err)
+	assert.Equal(t, []string{
+		"https://link.example.com/a(1)",
+		"https://example.com/docs",
+		"https://hex.example.com",
+		"https://author.example.com",
+	}, values(matches))
+}
+
+func TestExtractFromPDF_Uncompressed(t *testing.T) {
+	t.Parallel()
+
+	document := "%PDF-1.4\n1 0 obj\n<< /Length 44 >>\nstream\nBT (see www.example.com/x) Tj T* (\\150ttp://octal.example.com) Tj ET\nendstream\nendobj\n"
+
+	matches, err := sources.ExtractFromPDF(strings.NewReader(document))
+
+	require.NoError(t, err)
+	assert.Equal(t, []string{"www.example.com/x", "http://octal.example.com"}, values(matches))
+}
+
+func TestExtractFromPDF_MaxSize(t *testing.T) {
+	t.Parallel()
+
+	// A stream decompressing to 1 MiB, from about 1 KiB.
+	var compressed bytes.Buffer
+
+	writer := zlib.NewWriter(&compressed)
+
+	_, err := writer.Write([]byte("(https://example.com)" + strings.Repeat(" ", 1<<20)))
+
+	require.NoError(t, err)
+	require.NoError(t, writer.Close())
+
+	document := "%PDF-1.4\n1 0 obj\n<< /Filter /FlateDecode >>\nstream\n" + compressed.String() + "\nendstream\nendobj\n"
+
+	_, err = sources.ExtractFromPDF(strings.NewReader(document), sources.WithMaxSize(64<<10))
+
+	require.ErrorIs(t, err, sources.ErrTooLarge)
+
+	_, err = sources.ExtractFromPDF(strings.NewReader(document), sources.WithMaxSize(1<<10))
+
+	require.ErrorIs(t, err, sources.ErrTooLarge)
+
+	matches, err := sources.ExtractFromPDF(strings.NewReader(document), sources.WithMaxSize(2<<20))
+
+	require.NoError(t, err)
+	assert.Equal(t, []string{"https://example.com"}, values(matches))
+}
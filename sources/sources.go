@@ -0,0 +1,177 @@
+package sources
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"path"
+	"slices"
+	"strings"
+
+	hqgourl "go.source.hueristiq.com/url"
+)
+
+// Match is a match found by a source: an hqgourl.Match, whose offsets refer to the text
+// extracted from its origin, along with the origin itself.
+type Match struct {
+	hqgourl.Match
+
+	// Origin identifies where in the input the match was found (e.g., the member
+	// "word/document.xml" of a .docx file). It is empty for single-text inputs.
+	Origin string
+	// Commit is the hash of the commit the match was found in, for matches found in the
+	// history of a repository.
+	Commit string
+}
+
+// DefaultMaxSize is the default maximum size, in bytes, of the inputs read in memory and of
+// the data decompressed from them. See WithMaxSize.
+const DefaultMaxSize = 64 << 20
+
+// ErrTooLarge is returned when an input, or the data decompressed from it, exceeds the
+// maximum size set with WithMaxSize.
+var ErrTooLarge = errors.New("input too large")
+
+// Options holds the configuration shared by the sources.
+type Options struct {
+	Extractor  *hqgourl.Extractor // The extractor run over the extracted text.
+	Extensions []string           // The file extensions (e.g., ".js") of the files to scan; all if empty.
+	MaxSize    int64              // The maximum size of inputs read in memory and of decompressed data.
+}
+
+// OptionFunc defines a function type for configuring the Options of a source.
+type OptionFunc func(*Options)
+
+// WithExtractor returns an option function that sets the extractor run over the extracted
+// text. By default, hqgourl.NewExtractor() is used.
+func WithExtractor(extractor *hqgourl.Extractor) OptionFunc {
+	return func(o *Options) {
+		o.Extractor = extractor
+	}
+}
+
+// WithExtensions returns an option function that restricts the files scanned by sources
+// walking several files (e.g., the members of an archive) to the ones with one of the
+// given extensions (e.g., ".js", "html"), compared case-insensitively.
+func WithExtensions(extensions ...string) OptionFunc {
+	return func(o *Options) {
+		for _, extension := range extensions {
+			o.Extensions = append(o.Extensions, "."+strings.TrimPrefix(strings.ToLower(extension), "."))
+		}
+	}
+}
+
+// WithMaxSize returns an option function that sets the maximum size, in bytes, of the
+// inputs that sources read in memory (PDF and Office documents, and email messages), and
+// of the data decompressed from each of them (the streams of a PDF document, or the members
+// of an Office document, all together). This guards against decompression bombs: small
+// inputs that decompress to gigabytes. A size below 1 selects DefaultMaxSize.
+func WithMaxSize(size int64) OptionFunc {
+	return func(o *Options) {
+		o.MaxSize = size
+	}
+}
+
+// includes reports whether the file named name is to be scanned.
+func (o *Options) includes(name string) (included bool) {
+	included = len(o.Extensions) == 0 || slices.Contains(o.Extensions, strings.ToLower(path.Ext(name)))
+
+	return
+}
+
+// newOptions applies opts over the default options.
+func newOptions(opts ...OptionFunc) (options *Options) {
+	options = &Options{}
+
+	for _, opt := range opts {
+		opt(options)
+	}
+
+	if options.Extractor == nil {
+		options.Extractor = hqgourl.NewExtractor()
+	}
+
+	if options.MaxSize < 1 {
+		options.MaxSize = DefaultMaxSize
+	}
+
+	return
+}
+
+// limitedReader reads from r, failing with ErrTooLarge (wrapped) once more than limit
+// bytes have been read in total. Unlike io.LimitReader, which ends silently, it reports
+// the truncation. Its r may be replaced to share the limit between several readers.
+type limitedReader struct {
+	r     io.Reader
+	limit int64
+	read  int64
+	err   error
+}
+
+// newLimitedReader returns a limitedReader reading at most limit bytes from r.
+func newLimitedReader(r io.Reader, limit int64) (reader *limitedReader) {
+	reader = &limitedReader{
+		r:     r,
+		limit: limit,
+	}
+
+	return
+}
+
+func (l *limitedReader) Read(p []byte) (n int, err error) {
+	if l.err != nil {
+		err = l.err
+
+		return
+	}
+
+	// Read up to one byte past the limit, to tell inputs of exactly limit bytes apart.
+	if remaining := l.limit - l.read + 1; int64(len(p)) > remaining {
+		p = p[:remaining]
+	}
+
+	n, err = l.r.Read(p)
+
+	l.read += int64(n)
+
+	if l.read > l.limit {
+		n -= int(l.read - l.limit)
+
+		l.read = l.limit
+		l.err = fmt.Errorf("%w: more than %d bytes", ErrTooLarge, l.limit)
+
+		err = l.err
+	}
+
+	return
+}
+
+// extract runs the extractor over text and appends the matches, tagged with origin, to
+// matches.
+func (o *Options) extract(matches []Match, text, origin string) []Match {
+	for _, match := range o.Extractor.Extract(text) {
+		matches = append(matches, Match{
+			Match:  match,
+			Origin: origin,
+		})
+	}
+
+	return matches
+}
+
+// extractFromReader streams r through the extractor and appends the matches, tagged with
+// origin, to matches.
+func (o *Options) extractFromReader(matches []Match, r io.Reader, origin string) ([]Match, error) {
+	err := o.Extractor.ExtractReader(context.Background(), r, func(match hqgourl.Match) {
+		matches = append(matches, Match{
+			Match:  match,
+			Origin: origin,
+		})
+	})
+	if err != nil {
+		return matches, fmt.Errorf("error reading %s: %w", origin, err)
+	}
+
+	return matches, nil
+}
@@ -0,0 +1,19 @@
+// Package ssrfcheck classifies the destinations of URLs for Server-Side Request Forgery
+// (SSRF) screening, before they are fetched on behalf of untrusted input. Classification
+// is purely syntactic, no DNS lookup is performed: it flags hosts that are IP addresses in
+// private or reserved ranges (including the cloud metadata endpoint 169.254.169.254),
+// localhost aliases, IP addresses obfuscated in the alternative notations HTTP clients
+// accept (e.g., "2130706433" or "0x7f.0.0.1" for "127.0.0.1"), and userinfo written to
+// pass for the host (e.g., "http://expected.com@evil.com").
+//
+// As hostnames may resolve to internal addresses, a URL without findings is not proven
+// safe: callers should still check the addresses they connect to.
+//
+// Example:
+//
+//	result := ssrfcheck.Classify(parsed)
+//
+//	if result.Risky() {
+//	    // Refuse to fetch the URL.
+//	}
+package ssrfcheck
@@ -0,0 +1,163 @@
+package ssrfcheck
+
+import (
+	"net/netip"
+	"slices"
+	"strings"
+
+	hqgourl "go.source.hueristiq.com/url"
+)
+
+// Finding identifies a reason for a URL to be an SSRF risk.
+type Finding string
+
+// The findings Classify reports.
+const (
+	// FindingLoopback flags loopback addresses (127.0.0.0/8 and ::1).
+	FindingLoopback Finding = "loopback"
+	// FindingPrivate flags private addresses (10.0.0.0/8, 172.16.0.0/12, 192.168.0.0/16
+	// and fc00::/7).
+	FindingPrivate Finding = "private"
+	// FindingLinkLocal flags link-local addresses (169.254.0.0/16, which holds the cloud
+	// metadata endpoint, and fe80::/10).
+	FindingLinkLocal Finding = "link-local"
+	// FindingReserved flags unspecified, multicast, broadcast, shared (carrier-grade NAT),
+	// benchmarking, documentation and other special-purpose addresses.
+	FindingReserved Finding = "reserved"
+	// FindingLocalhost flags hostnames that are aliases of localhost (e.g., "localhost",
+	// "app.localhost" or "ip6-localhost").
+	FindingLocalhost Finding = "localhost"
+	// FindingObfuscatedIP flags IP addresses written in an alternative notation (e.g.,
+	// "2130706433", "0x7f.0.0.1" or "[::ffff:127.0.0.1]").
+	FindingObfuscatedIP Finding = "obfuscated-ip"
+	// FindingUserinfoConfusion flags userinfo that looks like a host (e.g.,
+	// "expected.com" in "http://expected.com@evil.com"), to trick readers and naive
+	// allowlists into taking it for the destination. See hqgourl.URL.HostSpoofing.
+	FindingUserinfoConfusion Finding = "userinfo-confusion"
+)
+
+// Result is the classification of a URL.
+type Result struct {
+	// Findings are the reasons for the URL to be an SSRF risk, in the order of the
+	// Finding constants; empty if none was found.
+	Findings []Finding
+	// Addr is the IP address of the host, decoded from alternative notations, and
+	// unmapped from IPv4-mapped IPv6 addresses; invalid if the host is not an IP address.
+	Addr netip.Addr
+}
+
+// Risky reports whether the result has any finding.
+//
+// Returns:
+//   - risky (bool): Whether the URL is an SSRF risk.
+func (r Result) Risky() (risky bool) {
+	risky = len(r.Findings) > 0
+
+	return
+}
+
+// Has reports whether the result has the given finding.
+//
+// Parameters:
+//   - finding (Finding): The finding to look for.
+//
+// Returns:
+//   - has (bool): Whether the result has the finding.
+func (r Result) Has(finding Finding) (has bool) {
+	has = slices.Contains(r.Findings, finding)
+
+	return
+}
+
+// localhostAliases are hostnames that conventionally resolve to a loopback address.
+var localhostAliases = []string{
+	"localhost",
+	"localhost.localdomain",
+	"ip6-localhost",
+	"ip6-loopback",
+}
+
+// reservedPrefixes are the special-purpose ranges (RFC 6890 and successors) that are not
+// covered by the methods of netip.Addr.
+var reservedPrefixes = []netip.Prefix{
+	netip.MustParsePrefix("0.0.0.0/8"),
+	netip.MustParsePrefix("100.64.0.0/10"),
+	netip.MustParsePrefix("192.0.0.0/24"),
+	netip.MustParsePrefix("192.0.2.0/24"),
+	netip.MustParsePrefix("198.18.0.0/15"),
+	netip.MustParsePrefix("198.51.100.0/24"),
+	netip.MustParsePrefix("203.0.113.0/24"),
+	netip.MustParsePrefix("240.0.0.0/4"),
+	netip.MustParsePrefix("64:ff9b:1::/48"),
+	netip.MustParsePrefix("100::/64"),
+	netip.MustParsePrefix("2001:db8::/32"),
+}
+
+// Classify flags the SSRF risks of a URL from its syntax alone, without resolving its host.
+//
+// Parameters:
+//   - URL (*hqgourl.URL): The URL, as returned by hqgourl.Parser.Parse.
+//
+// Returns:
+//   - result (Result): The findings, and the IP address of the host if it is one.
+func Classify(URL *hqgourl.URL) (result Result) {
+	if URL == nil || URL.URL == nil {
+		return
+	}
+
+	host := strings.TrimSuffix(strings.ToLower(URL.Hostname()), ".")
+
+	obfuscated := false
+
+	if addr, err := netip.ParseAddr(host); err == nil {
+		obfuscated = addr.Is4In6()
+		result.Addr = addr.Unmap().WithZone("")
+	} else if addr, ok := hqgourl.ObfuscatedIPv4(host); ok {
+		obfuscated = true
+		result.Addr = addr
+	}
+
+	if result.Addr.IsValid() {
+		if finding, ok := classifyAddr(result.Addr); ok {
+			result.Findings = append(result.Findings, finding)
+		}
+	} else if isLocalhost(host) {
+		result.Findings = append(result.Findings, FindingLocalhost)
+	}
+
+	if obfuscated {
+		result.Findings = append(result.Findings, FindingObfuscatedIP)
+	}
+
+	if _, spoofed := URL.HostSpoofing(); spoofed {
+		result.Findings = append(result.Findings, FindingUserinfoConfusion)
+	}
+
+	return
+}
+
+// classifyAddr returns the finding for the range addr is in, if any.
+func classifyAddr(addr netip.Addr) (finding Finding, ok bool) {
+	ok = true
+
+	switch {
+	case addr.IsLoopback():
+		finding = FindingLoopback
+	case addr.IsPrivate():
+		finding = FindingPrivate
+	case addr.IsLinkLocalUnicast(), addr.IsLinkLocalMulticast():
+		finding = FindingLinkLocal
+	case addr.IsUnspecified(), addr.IsMulticast(), slices.ContainsFunc(reservedPrefixes, func(p netip.Prefix) bool { return p.Contains(addr) }):
+		finding = FindingReserved
+	default:
+		ok = false
+	}
+
+	return
+}
+
+// isLocalhost reports whether the (lowercased) host is an alias of localhost, including
+// the subdomains of "localhost" (RFC 6761).
+func isLocalhost(host string) bool {
+	return slices.Contains(localhostAliases, host) || strings.HasSuffix(host, ".localhost")
+}
@@ -0,0 +1,17 @@
+// Package stix encodes indicators of compromise, as extracted by the ioc package, as
+// STIX 2.1 JSON, so that they can be pushed to TAXII servers and threat intelligence
+// platforms directly.
+//
+// Each distinct indicator becomes a STIX Cyber-observable Object (url, domain-name,
+// ipv4-addr, ipv6-addr or email-addr), an observed-data object referencing it and
+// counting how many times it was found, and an indicator object with a detection pattern
+// for it, wrapped in a bundle.
+//
+// Example:
+//
+//	indicators := ioc.Extract(report)
+//
+//	if err := stix.Encode(os.Stdout, indicators, stix.WithPatternType(stix.PatternTypePCRE)); err != nil {
+//	    log.Fatal(err)
+//	}
+package stix
@@ -0,0 +1,70 @@
+package stix
+
+import (
+	"regexp"
+	"strings"
+
+	"go.source.hueristiq.com/url/ioc"
+)
+
+// PatternType is the language of the detection pattern of an Indicator, from the STIX
+// pattern-type-ov vocabulary (e.g., "stix", "pcre", "snort").
+type PatternType string
+
+const (
+	// PatternTypeSTIX is the STIX Patterning language (e.g., "[url:value = 'https://evil.com']").
+	PatternTypeSTIX PatternType = "stix"
+	// PatternTypePCRE is Perl Compatible Regular Expressions (e.g., "^https://evil\.com$").
+	PatternTypePCRE PatternType = "pcre"
+)
+
+// PatternFunc builds the detection pattern for an indicator of compromise.
+type PatternFunc func(indicator ioc.Indicator) (pattern string)
+
+// patternFuncs are the built-in pattern types.
+var patternFuncs = map[PatternType]PatternFunc{
+	PatternTypeSTIX: STIXPattern,
+	PatternTypePCRE: PCREPattern,
+}
+
+// objectTypes maps the types of indicators to the types of the STIX Cyber-observable
+// Objects they are encoded as.
+var objectTypes = map[ioc.Type]string{
+	ioc.TypeURL:    "url",
+	ioc.TypeDomain: "domain-name",
+	ioc.TypeIPv4:   "ipv4-addr",
+	ioc.TypeIPv6:   "ipv6-addr",
+	ioc.TypeEmail:  "email-addr",
+}
+
+// stixStringEscaper escapes the characters that must be escaped in the string literals
+// of STIX patterns.
+var stixStringEscaper = strings.NewReplacer(`\`, `\\`, `'`, `\'`)
+
+// STIXPattern builds the STIX Patterning comparison matching the value of an indicator,
+// e.g. "[domain-name:value = 'evil.com']".
+//
+// Parameters:
+//   - indicator (ioc.Indicator): The indicator.
+//
+// Returns:
+//   - pattern (string): The STIX pattern.
+func STIXPattern(indicator ioc.Indicator) (pattern string) {
+	pattern = "[" + objectTypes[indicator.Type] + ":value = '" + stixStringEscaper.Replace(indicator.Value) + "']"
+
+	return
+}
+
+// PCREPattern builds the regular expression matching exactly the value of an indicator,
+// e.g. "^evil\.com$".
+//
+// Parameters:
+//   - indicator (ioc.Indicator): The indicator.
+//
+// Returns:
+//   - pattern (string): The PCRE pattern.
+func PCREPattern(indicator ioc.Indicator) (pattern string) {
+	pattern = "^" + regexp.QuoteMeta(indicator.Value) + "$"
+
+	return
+}
@@ -0,0 +1,333 @@
+package stix
+
+import (
+	"bytes"
+	"crypto/rand"
+	"crypto/sha1" //nolint:gosec // UUIDv5, which deterministic STIX identifiers are, is defined with SHA-1.
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"time"
+
+	"go.source.hueristiq.com/url/ioc"
+)
+
+var (
+	// ErrUnsupportedPatternType is returned when no PatternFunc is known for the pattern type.
+	ErrUnsupportedPatternType = errors.New("unsupported pattern type")
+	// ErrUnsupportedIndicator is returned when an indicator has a type that has no STIX
+	// Cyber-observable Object.
+	ErrUnsupportedIndicator = errors.New("unsupported indicator type")
+)
+
+// specVersion is the version of the STIX specification objects are encoded with.
+const specVersion = "2.1"
+
+// timestampLayout is the layout of STIX timestamps, in UTC with millisecond precision.
+const timestampLayout = "2006-01-02T15:04:05.000Z"
+
+// observableNamespace is the namespace of the UUIDv5 identifiers of STIX Cyber-observable
+// Objects, as defined by the STIX 2.1 specification.
+var observableNamespace = [16]byte{
+	0x00, 0xab, 0xed, 0xb4, 0xaa, 0x42, 0x46, 0x6c, 0x9c, 0x01, 0xfe, 0xd2, 0x33, 0x15, 0xa9, 0xb7,
+}
+
+// Bundle is a STIX bundle, the envelope of a collection of STIX objects.
+type Bundle struct {
+	Type    string `json:"type"`
+	ID      string `json:"id"`
+	Objects []any  `json:"objects"`
+}
+
+// Observable is a STIX Cyber-observable Object with a value: a url, domain-name,
+// ipv4-addr, ipv6-addr or email-addr.
+type Observable struct {
+	Type        string `json:"type"`
+	SpecVersion string `json:"spec_version"`
+	ID          string `json:"id"`
+	Value       string `json:"value"`
+}
+
+// ObservedData is a STIX observed-data object, recording that observables were seen.
+type ObservedData struct {
+	Type           string   `json:"type"`
+	SpecVersion    string   `json:"spec_version"`
+	ID             string   `json:"id"`
+	Created        string   `json:"created"`
+	Modified       string   `json:"modified"`
+	FirstObserved  string   `json:"first_observed"`
+	LastObserved   string   `json:"last_observed"`
+	NumberObserved int      `json:"number_observed"`
+	ObjectRefs     []string `json:"object_refs"`
+}
+
+// Indicator is a STIX indicator object, a pattern detecting suspicious activity.
+type Indicator struct {
+	Type        string      `json:"type"`
+	SpecVersion string      `json:"spec_version"`
+	ID          string      `json:"id"`
+	Created     string      `json:"created"`
+	Modified    string      `json:"modified"`
+	Name        string      `json:"name"`
+	Pattern     string      `json:"pattern"`
+	PatternType PatternType `json:"pattern_type"`
+	ValidFrom   string      `json:"valid_from"`
+}
+
+// Options holds the configuration of NewBundle and Encode.
+type Options struct {
+	PatternType  PatternType // The type of the patterns of indicators (stix by default).
+	PatternFunc  PatternFunc // The function building the patterns of indicators.
+	Time         time.Time   // The creation, observation and validity time of objects (now by default).
+	ObservedData bool        // Whether observed-data objects are encoded (true by default).
+	Indicators   bool        // Whether indicator objects are encoded (true by default).
+}
+
+// OptionFunc defines a function type for configuring the Options of NewBundle and Encode.
+type OptionFunc func(*Options)
+
+// WithPatternType returns an option function that selects one of the built-in pattern
+// types (PatternTypeSTIX or PatternTypePCRE) for the patterns of indicators.
+func WithPatternType(patternType PatternType) OptionFunc {
+	return func(o *Options) {
+		o.PatternType = patternType
+		o.PatternFunc = patternFuncs[patternType]
+	}
+}
+
+// WithPatternFunc returns an option function that builds the patterns of indicators with
+// fn, declaring them of the given pattern type (e.g., "snort" or "yara").
+func WithPatternFunc(patternType PatternType, fn PatternFunc) OptionFunc {
+	return func(o *Options) {
+		o.PatternType = patternType
+		o.PatternFunc = fn
+	}
+}
+
+// WithTime returns an option function that sets the time objects are created, observed
+// and valid from, instead of the time of encoding.
+func WithTime(t time.Time) OptionFunc {
+	return func(o *Options) {
+		o.Time = t
+	}
+}
+
+// WithoutObservedData returns an option function that omits observed-data objects.
+func WithoutObservedData() OptionFunc {
+	return func(o *Options) {
+		o.ObservedData = false
+	}
+}
+
+// WithoutIndicators returns an option function that omits indicator objects.
+func WithoutIndicators() OptionFunc {
+	return func(o *Options) {
+		o.Indicators = false
+	}
+}
+
+// NewBundle builds the STIX bundle for indicators of compromise. Each distinct indicator
+// (by type and value) is encoded once, as an observable, an observed-data object counting
+// its occurrences and an indicator object, in the order of its first occurrence.
+// Observables have deterministic identifiers, so the same value maps to the same object
+// across bundles; other objects have random identifiers.
+//
+// Parameters:
+//   - indicators ([]ioc.Indicator): The indicators, e.g. as returned by ioc.Extract.
+//   - opts (variadic OptionFunc): Options configuring patterns, time and objects.
+//
+// Returns:
+//   - bundle (*Bundle): The bundle.
+//   - err (error): An error wrapping ErrUnsupportedPatternType or ErrUnsupportedIndicator,
+//     or the error of the random source.
+func NewBundle(indicators []ioc.Indicator, opts ...OptionFunc) (bundle *Bundle, err error) {
+	options := &Options{
+		PatternType:  PatternTypeSTIX,
+		PatternFunc:  STIXPattern,
+		ObservedData: true,
+		Indicators:   true,
+	}
+
+	for _, opt := range opts {
+		opt(options)
+	}
+
+	if options.PatternFunc == nil {
+		err = fmt.Errorf("%w: %s", ErrUnsupportedPatternType, options.PatternType)
+
+		return
+	}
+
+	if options.Time.IsZero() {
+		options.Time = time.Now()
+	}
+
+	timestamp := options.Time.UTC().Format(timestampLayout)
+
+	type key struct {
+		Type  ioc.Type
+		Value string
+	}
+
+	var (
+		order  []ioc.Indicator
+		counts = map[key]int{}
+	)
+
+	for _, indicator := range indicators {
+		if _, ok := objectTypes[indicator.Type]; !ok {
+			err = fmt.Errorf("%w: %s", ErrUnsupportedIndicator, indicator.Type)
+
+			return
+		}
+
+		k := key{indicator.Type, indicator.Value}
+
+		if counts[k] == 0 {
+			order = append(order, indicator)
+		}
+
+		counts[k]++
+	}
+
+	bundle = &Bundle{Type: "bundle", Objects: []any{}}
+
+	if bundle.ID, err = randomID("bundle"); err != nil {
+		return
+	}
+
+	for _, indicator := range order {
+		objectType := objectTypes[indicator.Type]
+
+		observable := Observable{
+			Type:        objectType,
+			SpecVersion: specVersion,
+			ID:          observableID(objectType, indicator.Value),
+			Value:       indicator.Value,
+		}
+
+		bundle.Objects = append(bundle.Objects, observable)
+
+		if options.ObservedData {
+			observedData := ObservedData{
+				Type:           "observed-data",
+				SpecVersion:    specVersion,
+				Created:        timestamp,
+				Modified:       timestamp,
+				FirstObserved:  timestamp,
+				LastObserved:   timestamp,
+				NumberObserved: counts[key{indicator.Type, indicator.Value}],
+				ObjectRefs:     []string{observable.ID},
+			}
+
+			if observedData.ID, err = randomID(observedData.Type); err != nil {
+				return
+			}
+
+			bundle.Objects = append(bundle.Objects, observedData)
+		}
+
+		if options.Indicators {
+			stixIndicator := Indicator{
+				Type:        "indicator",
+				SpecVersion: specVersion,
+				Created:     timestamp,
+				Modified:    timestamp,
+				Name:        objectType + ": " + indicator.Value,
+				Pattern:     options.PatternFunc(indicator),
+				PatternType: options.PatternType,
+				ValidFrom:   timestamp,
+			}
+
+			if stixIndicator.ID, err = randomID(stixIndicator.Type); err != nil {
+				return
+			}
+
+			bundle.Objects = append(bundle.Objects, stixIndicator)
+		}
+	}
+
+	return
+}
+
+// Encode writes the STIX bundle for indicators of compromise to w, as JSON. See NewBundle
+// for how indicators are encoded.
+//
+// Parameters:
+//   - w (io.Writer): The writer to write the bundle to.
+//   - indicators ([]ioc.Indicator): The indicators, e.g. as returned by ioc.Extract.
+//   - opts (variadic OptionFunc): Options configuring patterns, time and objects.
+//
+// Returns:
+//   - err (error): An error returned by NewBundle or by w.
+func Encode(w io.Writer, indicators []ioc.Indicator, opts ...OptionFunc) (err error) {
+	bundle, err := NewBundle(indicators, opts...)
+	if err != nil {
+		return
+	}
+
+	encoder := json.NewEncoder(w)
+
+	encoder.SetEscapeHTML(false)
+
+	err = encoder.Encode(bundle)
+
+	return
+}
+
+// randomID returns an identifier for a STIX object of the given type, with a random
+// (version 4) UUID.
+func randomID(objectType string) (id string, err error) {
+	var uuid [16]byte
+
+	if _, err = rand.Read(uuid[:]); err != nil {
+		return
+	}
+
+	uuid[6] = uuid[6]&0x0f | 0x40
+	uuid[8] = uuid[8]&0x3f | 0x80
+
+	id = objectType + "--" + formatUUID(uuid)
+
+	return
+}
+
+// observableID returns the deterministic identifier of a STIX Cyber-observable Object
+// with a value: a version 5 UUID, in the STIX namespace, of its ID contributing
+// properties serialized as canonical JSON.
+func observableID(objectType, value string) (id string) {
+	// A JSON object with a single string member is canonical as encoded, as long as HTML
+	// characters are not escaped.
+	var buf bytes.Buffer
+
+	encoder := json.NewEncoder(&buf)
+
+	encoder.SetEscapeHTML(false)
+
+	_ = encoder.Encode(map[string]string{"value": value})
+
+	hash := sha1.New() //nolint:gosec // See the import.
+
+	hash.Write(observableNamespace[:])
+	hash.Write(bytes.TrimSuffix(buf.Bytes(), []byte("\n")))
+
+	var uuid [16]byte
+
+	copy(uuid[:], hash.Sum(nil))
+
+	uuid[6] = uuid[6]&0x0f | 0x50
+	uuid[8] = uuid[8]&0x3f | 0x80
+
+	id = objectType + "--" + formatUUID(uuid)
+
+	return
+}
+
+// formatUUID formats a UUID in its canonical textual form.
+func formatUUID(uuid [16]byte) string {
+	s := hex.EncodeToString(uuid[:])
+
+	return s[0:8] + "-" + s[8:12] + "-" + s[12:16] + "-" + s[16:20] + "-" + s[20:]
+}
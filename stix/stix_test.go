@@ -0,0 +1,110 @@
+package stix_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.source.hueristiq.com/url/ioc"
+	"go.source.hueristiq.com/url/stix"
+)
+
+func TestNewBundle(t *testing.T) {
+	t.Parallel()
+
+	indicators := ioc.Extract("hxxps://evil[.]com/gate.php?a=1&b=2 resolves evil[.]com, and evil.com again")
+
+	bundle, err := stix.NewBundle(indicators, stix.WithTime(time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC)))
+
+	require.NoError(t, err)
+
+	assert.Equal(t, "bundle", bundle.Type)
+	assert.True(t, strings.HasPrefix(bundle.ID, "bundle--"))
+	require.Len(t, bundle.Objects, 6)
+
+	url, ok := bundle.Objects[0].(stix.Observable)
+
+	require.True(t, ok)
+	assert.Equal(t, "url--94422036-77b8-5fe9-879a-d4fe77243a91", url.ID)
+	assert.Equal(t, "https://evil.com/gate.php?a=1&b=2", url.Value)
+
+	domain, ok := bundle.Objects[3].(stix.Observable)
+
+	require.True(t, ok)
+	assert.Equal(t, "domain-name--5d7b159c-1821-5595-b1f7-2213ba2674e6", domain.ID)
+
+	observedData, ok := bundle.Objects[4].(stix.ObservedData)
+
+	require.True(t, ok)
+	assert.Equal(t, 2, observedData.NumberObserved)
+	assert.Equal(t, []string{domain.ID}, observedData.ObjectRefs)
+	assert.Equal(t, "2024-05-01T12:00:00.000Z", observedData.FirstObserved)
+
+	indicator, ok := bundle.Objects[5].(stix.Indicator)
+
+	require.True(t, ok)
+	assert.True(t, strings.HasPrefix(indicator.ID, "indicator--"))
+	assert.Equal(t, "[domain-name:value = 'evil.com']", indicator.Pattern)
+	assert.Equal(t, stix.PatternTypeSTIX, indicator.PatternType)
+	assert.Equal(t, "2024-05-01T12:00:00.000Z", indicator.ValidFrom)
+}
+
+func TestNewBundle_Options(t *testing.T) {
+	t.Parallel()
+
+	indicators := []ioc.Indicator{{Type: ioc.TypeIPv4, Value: "203.0.113.7"}}
+
+	bundle, err := stix.NewBundle(indicators, stix.WithPatternType(stix.PatternTypePCRE), stix.WithoutObservedData())
+
+	require.NoError(t, err)
+	require.Len(t, bundle.Objects, 2)
+	assert.Equal(t, `^203\.0\.113\.7$`, bundle.Objects[1].(stix.Indicator).Pattern)
+
+	snort := func(indicator ioc.Indicator) string {
+		return "alert ip any any -> " + indicator.Value + " any (msg:\"IOC\"; sid:1000001;)"
+	}
+
+	bundle, err = stix.NewBundle(indicators, stix.WithPatternFunc("snort", snort), stix.WithoutIndicators())
+
+	require.NoError(t, err)
+	require.Len(t, bundle.Objects, 2)
+	assert.Equal(t, "observed-data", bundle.Objects[1].(stix.ObservedData).Type)
+
+	bundle, err = stix.NewBundle(indicators, stix.WithPatternFunc("snort", snort))
+
+	require.NoError(t, err)
+	assert.Equal(t, stix.PatternType("snort"), bundle.Objects[2].(stix.Indicator).PatternType)
+
+	_, err = stix.NewBundle(indicators, stix.WithPatternType("yara"))
+
+	require.ErrorIs(t, err, stix.ErrUnsupportedPatternType)
+
+	_, err = stix.NewBundle([]ioc.Indicator{{Type: "hash", Value: "d41d8cd98f00b204e9800998ecf8427e"}})
+
+	require.ErrorIs(t, err, stix.ErrUnsupportedIndicator)
+}
+
+func TestEncode(t *testing.T) {
+	t.Parallel()
+
+	var buf bytes.Buffer
+
+	require.NoError(t, stix.Encode(&buf, []ioc.Indicator{{Type: ioc.TypeEmail, Value: "o'brien@evil.com"}}))
+
+	var decoded struct {
+		Type    string           `json:"type"`
+		Objects []map[string]any `json:"objects"`
+	}
+
+	require.NoError(t, json.Unmarshal(buf.Bytes(), &decoded))
+
+	assert.Equal(t, "bundle", decoded.Type)
+	require.Len(t, decoded.Objects, 3)
+	assert.Equal(t, "email-addr", decoded.Objects[0]["type"])
+	assert.Equal(t, "2.1", decoded.Objects[1]["spec_version"])
+	assert.Equal(t, `[email-addr:value = 'o\'brien@evil.com']`, decoded.Objects[2]["pattern"])
+}
@@ -7,4 +7,14 @@
 //     and public suffixes maintained by the Public Suffix List.
 //  2. **Pseudo TLDs**: A list of unofficial or experimental top-level domains commonly used in private networks,
 //     testing environments, and specific applications.
+//
+// Category classifies TLDs by their type in the IANA root zone database (e.g., CCTLD or
+// GTLD), and IsNewGTLD tells the generic TLDs of the 2012 New gTLD Program apart. Country
+// maps country-code TLDs to their ISO 3166-1 country, and RiskScore scores TLDs by how
+// abuse-prone they are, from DefaultRiskScores or a source set with SetRiskSource.
+//
+// GeneratedAt and SourceETags record when, and from which versions of its sources, the
+// official list was generated (zero and nil if unknown); IsStale reports whether it is
+// older than a given age, or of unknown age, and Diff which TLDs another TLDSet (e.g., the
+// list live at the sources) adds or removes.
 package tlds
@@ -0,0 +1,79 @@
+package tlds
+
+import (
+	"strings"
+
+	"golang.org/x/net/idna"
+)
+
+// Type is the type of a TLD in the IANA root zone database, as returned by Category.
+type Type string
+
+const (
+	// GTLD identifies generic TLDs (e.g., "com" or "app").
+	GTLD Type = "generic"
+	// CCTLD identifies country-code TLDs (e.g., "uk" or "рф").
+	CCTLD Type = "country-code"
+	// SponsoredTLD identifies sponsored TLDs, restricted to a community (e.g., "edu" or "gov").
+	SponsoredTLD Type = "sponsored"
+	// GenericRestrictedTLD identifies generic TLDs with eligibility criteria (e.g., "biz" or "pro").
+	GenericRestrictedTLD Type = "generic-restricted"
+	// InfrastructureTLD identifies the infrastructure TLD ("arpa").
+	InfrastructureTLD Type = "infrastructure"
+	// TestTLD identifies TLDs delegated for testing.
+	TestTLD Type = "test"
+	// UnknownTLD identifies TLDs not in the IANA root zone database (e.g., pseudo TLDs).
+	UnknownTLD Type = ""
+)
+
+// legacyGTLDs is the set of the generic TLDs delegated before the 2012 New gTLD Program.
+var legacyGTLDs = map[string]struct{}{
+	"com":  {},
+	"info": {},
+	"mobi": {},
+	"net":  {},
+	"org":  {},
+}
+
+// Category returns the type of a TLD in the IANA root zone database. The lookup is
+// case-insensitive and accepts TLDs in Unicode or A-label form, with or without leading
+// dot (e.g., "UK", ".рф" or "xn--p1ai").
+//
+// Parameters:
+//   - TLD (string): The TLD (e.g., "uk"), as a single label.
+//
+// Returns:
+//   - category (Type): The type of the TLD, or UnknownTLD if it is not in the database.
+func Category(TLD string) (category Type) {
+	category = categories[normalize(TLD)]
+
+	return
+}
+
+// IsNewGTLD reports whether TLD is a generic TLD delegated by the 2012 New gTLD Program
+// (e.g., "app" or "xyz"), i.e. a generic TLD other than "com", "info", "mobi", "net" and
+// "org". Policy engines commonly treat new gTLDs as higher risk.
+func IsNewGTLD(TLD string) (isNew bool) {
+	TLD = normalize(TLD)
+
+	if _, legacy := legacyGTLDs[TLD]; legacy {
+		return
+	}
+
+	isNew = categories[TLD] == GTLD
+
+	return
+}
+
+// normalize returns TLD lowercased, without leading dot and in Unicode form.
+func normalize(TLD string) string {
+	TLD = strings.ToLower(strings.TrimPrefix(TLD, "."))
+
+	if strings.HasPrefix(TLD, "xn--") {
+		if unicodeTLD, err := idna.ToUnicode(TLD); err == nil {
+			TLD = unicodeTLD
+		}
+	}
+
+	return TLD
+}
@@ -0,0 +1,68 @@
+package tlds_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.source.hueristiq.com/url/tlds"
+)
+
+func TestCategory(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		TLD      string
+		category tlds.Type
+		isNew    bool
+	}{
+		{"uk", tlds.CCTLD, false},
+		{".DE", tlds.CCTLD, false},
+		{"рф", tlds.CCTLD, false},
+		{"xn--p1ai", tlds.CCTLD, false},
+		{"com", tlds.GTLD, false},
+		{"app", tlds.GTLD, true},
+		{"edu", tlds.SponsoredTLD, false},
+		{"biz", tlds.GenericRestrictedTLD, false},
+		{"arpa", tlds.InfrastructureTLD, false},
+		{"local", tlds.UnknownTLD, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.TLD, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, tt.category, tlds.Category(tt.TLD))
+			assert.Equal(t, tt.isNew, tlds.IsNewGTLD(tt.TLD))
+		})
+	}
+}
+
+func TestCountry(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		TLD     string
+		country tlds.CountryInfo
+		ok      bool
+	}{
+		{"de", tlds.CountryInfo{Code: "DE", Name: "Germany"}, true},
+		{".DE", tlds.CountryInfo{Code: "DE", Name: "Germany"}, true},
+		{"uk", tlds.CountryInfo{Code: "GB", Name: "United Kingdom"}, true},
+		{"eu", tlds.CountryInfo{Code: "EU", Name: "European Union"}, true},
+		{"рф", tlds.CountryInfo{Code: "RU", Name: "Russian Federation"}, true},
+		{"xn--p1ai", tlds.CountryInfo{Code: "RU", Name: "Russian Federation"}, true},
+		{"com", tlds.CountryInfo{}, false},
+		{"local", tlds.CountryInfo{}, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.TLD, func(t *testing.T) {
+			t.Parallel()
+
+			country, ok := tlds.Country(tt.TLD)
+
+			assert.Equal(t, tt.ok, ok)
+			assert.Equal(t, tt.country, country)
+		})
+	}
+}
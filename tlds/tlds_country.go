@@ -0,0 +1,25 @@
+package tlds
+
+// CountryInfo is the country of a country-code TLD, as returned by Country.
+type CountryInfo struct {
+	Code string // The ISO 3166-1 alpha-2 code of the country (e.g., "DE").
+	Name string // The ISO 3166-1 English short name of the country (e.g., "Germany").
+}
+
+// Country returns the country of a country-code TLD, to geo-tag domains. The lookup is
+// case-insensitive and accepts TLDs in Unicode or A-label form, with or without leading
+// dot (e.g., "DE", ".рф" or "xn--p1ai"). The country codes of ccTLDs mostly match their
+// TLD, with exceptions such as "uk" (GB), internationalized ccTLDs (e.g., "рф" is RU) and
+// the exceptionally reserved codes AC, EU and SU.
+//
+// Parameters:
+//   - TLD (string): The country-code TLD (e.g., "de"), as a single label.
+//
+// Returns:
+//   - country (CountryInfo): The country of the TLD.
+//   - ok (bool): Whether TLD is a country-code TLD of the IANA root zone database.
+func Country(TLD string) (country CountryInfo, ok bool) {
+	country, ok = countries[normalize(TLD)]
+
+	return
+}
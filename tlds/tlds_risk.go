@@ -0,0 +1,126 @@
+package tlds
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// RiskSource provides the risk scores of TLDs, as returned by RiskScore.
+type RiskSource interface {
+	// RiskScore returns the risk score of TLD (lowercase, in Unicode form), between 0 (no
+	// known abuse) and 1 (mostly abusive registrations), and whether the source scores it.
+	RiskScore(TLD string) (score float64, ok bool)
+}
+
+// RiskScores is a RiskSource mapping TLDs (lowercase, in Unicode form) to their risk scores.
+type RiskScores map[string]float64
+
+// RiskScore returns the risk score of TLD, and whether it is in s.
+func (s RiskScores) RiskScore(TLD string) (score float64, ok bool) {
+	score, ok = s[TLD]
+
+	return
+}
+
+var (
+	// riskSource is the RiskSource used by RiskScore, set with SetRiskSource.
+	riskSource   RiskSource = DefaultRiskScores
+	riskSourceMu sync.RWMutex
+)
+
+// SetRiskSource replaces the source of the risk scores returned by RiskScore, e.g. with
+// scores loaded from fresher or in-house abuse statistics with ParseRiskScores. A nil
+// source restores DefaultRiskScores. It is safe for concurrent use.
+//
+// Parameters:
+//   - source (RiskSource): The source of the risk scores.
+func SetRiskSource(source RiskSource) {
+	if source == nil {
+		source = DefaultRiskScores
+	}
+
+	riskSourceMu.Lock()
+
+	defer riskSourceMu.Unlock()
+
+	riskSource = source
+}
+
+// RiskScore returns the risk score of a TLD, so that extraction pipelines can prioritize
+// or flag matches on abuse-prone TLDs (e.g., "tk" or "top"). Scores range from 0 (no known
+// abuse) to 1 (mostly abusive registrations). The lookup is case-insensitive and accepts
+// TLDs in Unicode or A-label form, with or without leading dot.
+//
+// The scores come from DefaultRiskScores, unless replaced with SetRiskSource.
+//
+// Parameters:
+//   - TLD (string): The TLD (e.g., "top"), as a single label.
+//
+// Returns:
+//   - score (float64): The risk score of the TLD, or 0 if the source doesn't score it.
+func RiskScore(TLD string) (score float64) {
+	riskSourceMu.RLock()
+
+	source := riskSource
+
+	riskSourceMu.RUnlock()
+
+	score, _ = source.RiskScore(normalize(TLD))
+
+	return
+}
+
+// ParseRiskScores parses risk scores in the format of the curated list DefaultRiskScores
+// is generated from: one "TLD score" pair per line, optionally followed by "#" and a note,
+// with "#" comment lines and blank lines ignored.
+//
+// Parameters:
+//   - r (io.Reader): The risk scores.
+//
+// Returns:
+//   - scores (RiskScores): The parsed risk scores, keyed with normalized TLDs.
+//   - err (error): An error if a line is malformed or a score is not between 0 and 1.
+func ParseRiskScores(r io.Reader) (scores RiskScores, err error) {
+	scores = RiskScores{}
+
+	scanner := bufio.NewScanner(r)
+
+	for n := 1; scanner.Scan(); n++ {
+		line, _, _ := strings.Cut(scanner.Text(), "#")
+
+		fields := strings.Fields(line)
+
+		if len(fields) == 0 {
+			continue
+		}
+
+		if len(fields) != 2 {
+			scores = nil
+
+			err = fmt.Errorf("line %d: expected \"TLD score\", got %q", n, strings.TrimSpace(line))
+
+			return
+		}
+
+		score, parseErr := strconv.ParseFloat(fields[1], 64)
+		if parseErr != nil || score < 0 || score > 1 {
+			scores = nil
+
+			err = fmt.Errorf("line %d: invalid score %q, expected a number between 0 and 1", n, fields[1])
+
+			return
+		}
+
+		scores[normalize(fields[0])] = score
+	}
+
+	if err = scanner.Err(); err != nil {
+		scores = nil
+	}
+
+	return
+}
@@ -0,0 +1,64 @@
+package tlds_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.source.hueristiq.com/url/tlds"
+)
+
+func TestRiskScore(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		TLD   string
+		score float64
+	}{
+		{"tk", 0.9},
+		{".TOP", 0.8},
+		{"xyz", 0.5},
+		{"com", 0},
+		{"local", 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.TLD, func(t *testing.T) {
+			t.Parallel()
+
+			assert.InDelta(t, tt.score, tlds.RiskScore(tt.TLD), 1e-9)
+		})
+	}
+}
+
+func TestParseRiskScores(t *testing.T) {
+	t.Parallel()
+
+	scores, err := tlds.ParseRiskScores(strings.NewReader("# In-house statistics\n\nTOP 0.95 # phishing\nxn--p1ai 0.3\n"))
+
+	require.NoError(t, err)
+	assert.Equal(t, tlds.RiskScores{"top": 0.95, "рф": 0.3}, scores)
+
+	_, err = tlds.ParseRiskScores(strings.NewReader("top\n"))
+
+	require.Error(t, err)
+
+	_, err = tlds.ParseRiskScores(strings.NewReader("top 2\n"))
+
+	require.Error(t, err)
+}
+
+//nolint:paralleltest // SetRiskSource replaces the source of all RiskScore calls.
+func TestSetRiskSource(t *testing.T) {
+	tlds.SetRiskSource(tlds.RiskScores{"com": 0.1})
+
+	defer tlds.SetRiskSource(nil)
+
+	assert.InDelta(t, 0.1, tlds.RiskScore("com"), 1e-9)
+	assert.InDelta(t, 0.0, tlds.RiskScore("tk"), 1e-9)
+
+	tlds.SetRiskSource(nil)
+
+	assert.InDelta(t, 0.9, tlds.RiskScore("tk"), 1e-9)
+}
@@ -0,0 +1,108 @@
+package tlds
+
+import (
+	"slices"
+	"strings"
+)
+
+// TLDSet is a sorted set of TLDs and eTLDs (e.g., "com" or "co.uk"), lowercased and in
+// Unicode form, such as Official or a list fetched from its sources.
+type TLDSet []string
+
+// NewTLDSet creates a TLDSet holding the given TLDs, normalized (lowercased, without
+// leading dot and in Unicode form), sorted and without duplicates. Blank entries are
+// skipped.
+//
+// Parameters:
+//   - TLDs (...string): The TLDs (e.g., "COM", ".co.uk" or "xn--p1ai").
+//
+// Returns:
+//   - set (TLDSet): The set.
+func NewTLDSet(TLDs ...string) (set TLDSet) {
+	set = make(TLDSet, 0, len(TLDs))
+
+	for _, TLD := range TLDs {
+		if TLD = normalize(strings.TrimSpace(TLD)); TLD != "" {
+			set = append(set, TLD)
+		}
+	}
+
+	slices.Sort(set)
+
+	set = slices.Compact(set)
+
+	return
+}
+
+// Contains reports whether TLD, normalized as by NewTLDSet, is in the set.
+//
+// Parameters:
+//   - TLD (string): The TLD.
+//
+// Returns:
+//   - ok (bool): Whether the set holds TLD.
+func (s TLDSet) Contains(TLD string) (ok bool) {
+	_, ok = slices.BinarySearch(s, normalize(TLD))
+
+	return
+}
+
+// DiffReport lists the differences between two TLDSets.
+type DiffReport struct {
+	Added   []string // The TLDs only in the other set (e.g., newly delegated ones), sorted.
+	Removed []string // The TLDs only in the set compared (e.g., retired ones), sorted.
+}
+
+// Empty reports whether the sets compared hold the same TLDs.
+//
+// Returns:
+//   - empty (bool): Whether there are no differences.
+func (r DiffReport) Empty() (empty bool) {
+	empty = len(r.Added) == 0 && len(r.Removed) == 0
+
+	return
+}
+
+// Diff compares the set with other, reporting the TLDs other adds and removes.
+//
+// Parameters:
+//   - other (TLDSet): The set to compare with, e.g. a newer list.
+//
+// Returns:
+//   - report (DiffReport): The TLDs only in other (Added) and only in the set (Removed).
+func (s TLDSet) Diff(other TLDSet) (report DiffReport) {
+	i, j := 0, 0
+
+	for i < len(s) || j < len(other) {
+		switch {
+		case j == len(other) || i < len(s) && s[i] < other[j]:
+			report.Removed = append(report.Removed, s[i])
+
+			i++
+		case i == len(s) || other[j] < s[i]:
+			report.Added = append(report.Added, other[j])
+
+			j++
+		default:
+			i++
+			j++
+		}
+	}
+
+	return
+}
+
+// Diff compares the embedded list of TLDs (Official) with other, typically the list live
+// at its sources, so that operators can alert when the embedded data drifts and the
+// package should be upgraded.
+//
+// Parameters:
+//   - other (TLDSet): The set to compare with.
+//
+// Returns:
+//   - report (DiffReport): The TLDs only in other (Added) and only in Official (Removed).
+func Diff(other TLDSet) (report DiffReport) {
+	report = NewTLDSet(Official...).Diff(other)
+
+	return
+}
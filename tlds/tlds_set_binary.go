@@ -0,0 +1,110 @@
+package tlds
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"index/suffixarray"
+	"strings"
+)
+
+// binaryMagic starts the binary encoding of TLDSets, followed by binaryVersion.
+const binaryMagic = "TLDSET"
+
+// binaryVersion is the version of the binary encoding of TLDSets.
+const binaryVersion = 1
+
+// ErrInvalidBinary is returned when decoding data that is not a binary encoding of a
+// TLDSet, or one of an unsupported version.
+var ErrInvalidBinary = errors.New("invalid binary TLD set")
+
+// MarshalBinary encodes the set, with the suffix array index the DomainParser of the root
+// package looks TLDs up with, to a compact binary blob: a header, then the TLDs separated by
+// NUL bytes and their suffix array. Services with many worker processes can build the blob
+// once, write it to a file, and restore the index in each process with IndexFromBinary
+// (e.g., from a memory-mapped file) instead of sorting it again.
+//
+// Returns:
+//   - data ([]byte): The binary encoding of the set.
+//   - err (error): An error if the index cannot be encoded.
+func (s TLDSet) MarshalBinary() (data []byte, err error) {
+	var b bytes.Buffer
+
+	b.WriteString(binaryMagic)
+	b.WriteByte(binaryVersion)
+
+	if err = suffixarray.New(indexData(s)).Write(&b); err != nil {
+		return
+	}
+
+	data = b.Bytes()
+
+	return
+}
+
+// UnmarshalBinary decodes a set encoded with MarshalBinary.
+//
+// Parameters:
+//   - data ([]byte): The binary encoding of the set.
+//
+// Returns:
+//   - err (error): ErrInvalidBinary, wrapped, if data is not a valid encoding.
+func (s *TLDSet) UnmarshalBinary(data []byte) (err error) {
+	index, err := IndexFromBinary(data)
+	if err != nil {
+		return
+	}
+
+	TLDs := strings.Trim(string(index.Bytes()), "\x00")
+
+	*s = TLDSet{}
+
+	if TLDs != "" {
+		*s = strings.Split(TLDs, "\x00")
+	}
+
+	return
+}
+
+// IndexFromBinary restores the suffix array index of a set encoded with MarshalBinary,
+// without sorting it again, e.g. to configure a DomainParser of the root package with
+// DomainParserWithTLDIndex. The index doesn't reference data, which can be released (or
+// unmapped) afterwards.
+//
+// Parameters:
+//   - data ([]byte): The binary encoding of the set.
+//
+// Returns:
+//   - index (*suffixarray.Index): The index of the TLDs of the set, separated by NUL bytes.
+//   - err (error): ErrInvalidBinary, wrapped, if data is not a valid encoding.
+func IndexFromBinary(data []byte) (index *suffixarray.Index, err error) {
+	header := len(binaryMagic) + 1
+
+	if len(data) < header || string(data[:len(binaryMagic)]) != binaryMagic {
+		err = fmt.Errorf("%w: missing header", ErrInvalidBinary)
+
+		return
+	}
+
+	if version := data[len(binaryMagic)]; version != binaryVersion {
+		err = fmt.Errorf("%w: unsupported version %d", ErrInvalidBinary, version)
+
+		return
+	}
+
+	index = new(suffixarray.Index)
+
+	if readErr := index.Read(bytes.NewReader(data[header:])); readErr != nil {
+		index = nil
+
+		err = fmt.Errorf("%w: %w", ErrInvalidBinary, readErr)
+	}
+
+	return
+}
+
+// indexData returns the data indexed for the TLDs: the TLDs separated, and surrounded, by
+// NUL bytes, as the DomainParser of the root package indexes them.
+func indexData(TLDs []string) []byte {
+	return []byte("\x00" + strings.Join(TLDs, "\x00") + "\x00")
+}
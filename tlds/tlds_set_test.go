@@ -0,0 +1,72 @@
+package tlds_test
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.source.hueristiq.com/url/tlds"
+)
+
+func TestNewTLDSet(t *testing.T) {
+	t.Parallel()
+
+	set := tlds.NewTLDSet("COM", ".co.uk", "xn--p1ai", "com", " ", "org")
+
+	assert.Equal(t, tlds.TLDSet{"co.uk", "com", "org", "рф"}, set)
+	assert.True(t, set.Contains("CO.UK"))
+	assert.True(t, set.Contains("xn--p1ai"))
+	assert.False(t, set.Contains("net"))
+}
+
+func TestDiff(t *testing.T) {
+	t.Parallel()
+
+	old := tlds.NewTLDSet("com", "net", "org", "zip")
+	live := tlds.NewTLDSet("com", "new", "org")
+
+	assert.Equal(t, tlds.DiffReport{Added: []string{"new"}, Removed: []string{"net", "zip"}}, old.Diff(live))
+	assert.True(t, live.Diff(live).Empty())
+
+	// The embedded list doesn't drift from itself.
+	assert.True(t, tlds.Diff(tlds.NewTLDSet(tlds.Official...)).Empty())
+
+	report := tlds.Diff(tlds.NewTLDSet(append(slices.Clone(tlds.Official), "zzz-not-delegated")...))
+
+	assert.Equal(t, []string{"zzz-not-delegated"}, report.Added)
+	assert.Empty(t, report.Removed)
+}
+
+func TestTLDSet_MarshalBinary(t *testing.T) {
+	t.Parallel()
+
+	set := tlds.NewTLDSet("com", "co.uk", "org", "рф")
+
+	data, err := set.MarshalBinary()
+
+	require.NoError(t, err)
+
+	var decoded tlds.TLDSet
+
+	require.NoError(t, decoded.UnmarshalBinary(data))
+
+	assert.Equal(t, set, decoded)
+
+	index, err := tlds.IndexFromBinary(data)
+
+	require.NoError(t, err)
+
+	assert.Len(t, index.Lookup([]byte("\x00co.uk\x00"), -1), 1)
+
+	empty, err := tlds.NewTLDSet().MarshalBinary()
+
+	require.NoError(t, err)
+	require.NoError(t, decoded.UnmarshalBinary(empty))
+
+	assert.Empty(t, decoded)
+
+	require.ErrorIs(t, decoded.UnmarshalBinary([]byte("not a set")), tlds.ErrInvalidBinary)
+	require.ErrorIs(t, decoded.UnmarshalBinary(append([]byte("TLDSET\x02"), data[7:]...)), tlds.ErrInvalidBinary)
+	require.ErrorIs(t, decoded.UnmarshalBinary(data[:len(data)-3]), tlds.ErrInvalidBinary)
+}
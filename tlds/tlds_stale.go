@@ -0,0 +1,36 @@
+package tlds
+
+import "time"
+
+// GeneratedAt is the time Official was generated at, set by the generated
+// tlds_official_metadata.go. It is zero if unknown, i.e. if Official predates generation
+// metadata, in which case IsStale always reports it stale.
+var GeneratedAt time.Time
+
+// SourceETags maps the URLs of the sources Official was generated from to the ETags they
+// were served with (empty if none), identifying the versions of the sources. It is nil if
+// unknown, like GeneratedAt.
+var SourceETags map[string]string
+
+// IsStale reports whether Official was generated more than maxAge ago. Suffixes are added
+// to (and removed from) the Public Suffix List weekly, so services relying on them may
+// warn when their embedded list is too old and the package should be upgraded.
+//
+// Parameters:
+//   - maxAge (time.Duration): The maximum acceptable age of the list.
+//
+// Returns:
+//   - stale (bool): Whether the list is older than maxAge, or of unknown age.
+func IsStale(maxAge time.Duration) (stale bool) {
+	stale = isStale(GeneratedAt, time.Now(), maxAge)
+
+	return
+}
+
+// isStale reports whether data generated at generatedAt (zero if unknown) is, at now,
+// older than maxAge.
+func isStale(generatedAt, now time.Time, maxAge time.Duration) (stale bool) {
+	stale = generatedAt.IsZero() || now.Sub(generatedAt) > maxAge
+
+	return
+}
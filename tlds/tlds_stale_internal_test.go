@@ -0,0 +1,35 @@
+package tlds
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIsStale_At(t *testing.T) {
+	t.Parallel()
+
+	generatedAt := time.Date(2025, time.January, 1, 0, 0, 0, 0, time.UTC)
+	maxAge := 30 * 24 * time.Hour
+
+	tests := []struct {
+		name        string
+		generatedAt time.Time
+		now         time.Time
+		expected    bool
+	}{
+		{"Fresh", generatedAt, generatedAt.Add(24 * time.Hour), false},
+		{"Exactly max age", generatedAt, generatedAt.Add(maxAge), false},
+		{"Stale", generatedAt, generatedAt.Add(maxAge + time.Second), true},
+		{"Unknown age", time.Time{}, generatedAt, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, tt.expected, isStale(tt.generatedAt, tt.now, maxAge))
+		})
+	}
+}
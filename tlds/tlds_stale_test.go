@@ -0,0 +1,16 @@
+package tlds_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"go.source.hueristiq.com/url/tlds"
+)
+
+func TestIsStale(t *testing.T) {
+	t.Parallel()
+
+	assert.True(t, tlds.IsStale(0))
+	assert.Equal(t, tlds.GeneratedAt.IsZero(), tlds.IsStale(100*365*24*time.Hour))
+}
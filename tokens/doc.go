@@ -0,0 +1,12 @@
+// Package tokens splits parsed URLs into normalized tokens, to build target-specific
+// wordlists (e.g., for content discovery or parameter brute-forcing) from the URLs of a
+// target: the labels of the subdomain, the path segments, and the query parameter names
+// and values, split on separators and camelCase boundaries and lowercased.
+//
+// Example:
+//
+//	parsed, _ := parser.Parse("https://api-v2.example.com/userProfile/edit_settings.php?sessionID=abc")
+//
+//	fmt.Println(tokens.FromURL(parsed))
+//	// Output: [api v2 user profile edit settings php session id abc]
+package tokens
@@ -0,0 +1,137 @@
+package tokens
+
+import (
+	"net/url"
+	"strings"
+	"unicode"
+
+	hqgourl "go.source.hueristiq.com/url"
+)
+
+// FromURL splits the labels of the subdomain, the path segments, and the query parameter
+// names and values of a URL into normalized tokens, as Split does. Tokens are returned in
+// that order, without duplicates; tokens made only of digits (e.g., IDs) are dropped, as
+// they are rarely useful in wordlists.
+//
+// Parameters:
+//   - URL (*hqgourl.URL): The URL, as returned by hqgourl.Parser.Parse.
+//
+// Returns:
+//   - tokens ([]string): The tokens, or nil if URL is nil.
+func FromURL(URL *hqgourl.URL) (tokens []string) {
+	if URL == nil || URL.URL == nil {
+		return
+	}
+
+	seen := map[string]struct{}{}
+
+	add := func(s string) {
+		for _, token := range Split(s) {
+			if isNumeric(token) {
+				continue
+			}
+
+			if _, ok := seen[token]; ok {
+				continue
+			}
+
+			seen[token] = struct{}{}
+
+			tokens = append(tokens, token)
+		}
+	}
+
+	if URL.Domain != nil {
+		add(URL.Domain.Subdomain)
+	}
+
+	add(URL.Path)
+
+	for _, pair := range strings.Split(URL.RawQuery, "&") {
+		name, value, _ := strings.Cut(pair, "=")
+
+		if unescaped, err := url.QueryUnescape(name); err == nil {
+			name = unescaped
+		}
+
+		if unescaped, err := url.QueryUnescape(value); err == nil {
+			value = unescaped
+		}
+
+		add(name)
+		add(value)
+	}
+
+	return
+}
+
+// Split splits s into lowercased tokens: on any character other than letters and digits
+// (e.g., ".", "/", "-" or "_"), and on camelCase boundaries, keeping acronyms together
+// ("HTTPServer" is split into "http" and "server") and digits with the preceding letters
+// ("v2" and "utf8" are kept).
+//
+// Parameters:
+//   - s (string): The string to split (e.g., "userProfile/edit_settings").
+//
+// Returns:
+//   - tokens ([]string): The tokens (e.g., "user", "profile", "edit" and "settings").
+func Split(s string) (tokens []string) {
+	runes := []rune(s)
+	start := -1
+
+	for i, r := range runes {
+		if !unicode.IsLetter(r) && !unicode.IsDigit(r) {
+			if start >= 0 {
+				tokens = append(tokens, strings.ToLower(string(runes[start:i])))
+
+				start = -1
+			}
+
+			continue
+		}
+
+		if start >= 0 && isBoundary(runes, i) {
+			tokens = append(tokens, strings.ToLower(string(runes[start:i])))
+
+			start = -1
+		}
+
+		if start < 0 {
+			start = i
+		}
+	}
+
+	if start >= 0 {
+		tokens = append(tokens, strings.ToLower(string(runes[start:])))
+	}
+
+	return
+}
+
+// isBoundary reports whether a camelCase word starts at runes[i], an uppercase letter
+// following a lowercase letter or a digit ("userProfile"), or the last uppercase letter
+// of an acronym followed by a lowercase letter ("HTTPServer").
+func isBoundary(runes []rune, i int) bool {
+	if i == 0 || !unicode.IsUpper(runes[i]) {
+		return false
+	}
+
+	previous := runes[i-1]
+
+	if unicode.IsLower(previous) || unicode.IsDigit(previous) {
+		return true
+	}
+
+	return unicode.IsUpper(previous) && i+1 < len(runes) && unicode.IsLower(runes[i+1])
+}
+
+// isNumeric reports whether token is made only of digits.
+func isNumeric(token string) bool {
+	for _, r := range token {
+		if !unicode.IsDigit(r) {
+			return false
+		}
+	}
+
+	return true
+}
@@ -0,0 +1,55 @@
+package tokens_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	hqgourl "go.source.hueristiq.com/url"
+	"go.source.hueristiq.com/url/tokens"
+)
+
+func TestSplit(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		input    string
+		expected []string
+	}{
+		{"userProfile", []string{"user", "profile"}},
+		{"edit_settings.php", []string{"edit", "settings", "php"}},
+		{"HTTPServer", []string{"http", "server"}},
+		{"getHTTPResponseCode", []string{"get", "http", "response", "code"}},
+		{"sessionID", []string{"session", "id"}},
+		{"api-v2", []string{"api", "v2"}},
+		{"utf8Decode", []string{"utf8", "decode"}},
+		{"/a//b/", []string{"a", "b"}},
+		{"", nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, tt.expected, tokens.Split(tt.input))
+		})
+	}
+}
+
+func TestFromURL(t *testing.T) {
+	t.Parallel()
+
+	parser := hqgourl.NewParser()
+
+	parsed, err := parser.Parse("https://api-v2.staging.example.com/userProfile/edit_settings.php?sessionID=abc&user_id=42&redirect=%2Fadmin%2FDashboard")
+
+	require.NoError(t, err)
+
+	assert.Equal(t, []string{
+		"api", "v2", "staging",
+		"user", "profile", "edit", "settings", "php",
+		"session", "id", "abc", "redirect", "admin", "dashboard",
+	}, tokens.FromURL(parsed))
+
+	assert.Nil(t, tokens.FromURL(nil))
+}
@@ -6,4 +6,10 @@
 // The constants in this package are autogenerated and contain large ranges of Unicode characters
 // that are deemed valid in specific situations. This helps in validating input and ensuring that
 // only certain characters are processed.
+//
+// Script-specific subsets (e.g., AllowedLatin, AllowedCyrillic or AllowedCJK) and ClassForScripts
+// allow restricting matching to the scripts expected in a given input.
+//
+// GeneratedAt and UnicodeVersion record when, and from which Unicode version, the sets were
+// generated; IsStale reports whether they are older than a given age.
 package unicodes
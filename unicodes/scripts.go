@@ -0,0 +1,99 @@
+package unicodes
+
+import (
+	"strings"
+	"unicode"
+)
+
+// scriptClasses maps the lowercased names of the script-specific sets to their contents.
+var scriptClasses = map[string]string{
+	"arabic":     AllowedArabic,
+	"cjk":        AllowedCJK,
+	"cyrillic":   AllowedCyrillic,
+	"devanagari": AllowedDevanagari,
+	"greek":      AllowedGreek,
+	"hebrew":     AllowedHebrew,
+	"latin":      AllowedLatin,
+	"thai":       AllowedThai,
+}
+
+// ClassForScripts returns the contents of a regular expression character class matching
+// the non-ASCII characters of the given scripts, so that extractors can be restricted to
+// the scripts expected in their input (e.g., Latin and Cyrillic), reducing IDN-based
+// false positives. Like AllowedUcsChar, the result is meant to be used inside "[...]",
+// together with the ASCII ranges.
+//
+// Script names are case-insensitive. The names of the script-specific sets (e.g., "Latin",
+// "Cyrillic" or "CJK") are served from the generated constants; any other script known to
+// the unicode package (e.g., "Georgian", "Inherited") is computed from unicode.Scripts.
+// Unknown names are ignored.
+//
+// Example:
+//
+//	pattern := `[a-zA-Z0-9` + unicodes.ClassForScripts("Latin", "Cyrillic") + `]+`
+//
+// Parameters:
+//   - scripts (variadic string): The script names.
+//
+// Returns:
+//   - class (string): The character class contents.
+func ClassForScripts(scripts ...string) (class string) {
+	var b strings.Builder
+
+	for _, script := range scripts {
+		if contents, ok := scriptClasses[strings.ToLower(script)]; ok {
+			b.WriteString(contents)
+
+			continue
+		}
+
+		for name, table := range unicode.Scripts {
+			if strings.EqualFold(name, script) {
+				writeTableClass(&b, table)
+
+				break
+			}
+		}
+	}
+
+	class = b.String()
+
+	return
+}
+
+// writeTableClass writes the character class contents matching the non-ASCII characters
+// of table to b.
+func writeTableClass(b *strings.Builder, table *unicode.RangeTable) {
+	write := func(lo, hi, stride rune) {
+		if stride != 1 {
+			for cp := lo; cp <= hi; cp += stride {
+				if cp >= 0xA0 {
+					b.WriteRune(cp)
+				}
+			}
+
+			return
+		}
+
+		lo = max(lo, 0xA0)
+
+		if lo > hi {
+			return
+		}
+
+		b.WriteRune(lo)
+
+		if lo < hi {
+			b.WriteRune('-')
+			b.WriteRune(hi)
+		}
+	}
+
+	for _, r := range table.R16 {
+		write(rune(r.Lo), rune(r.Hi), rune(r.Stride))
+	}
+
+	for _, r := range table.R32 {
+		write(rune(r.Lo), rune(r.Hi), rune(r.Stride))
+	}
+}
@@ -0,0 +1,26 @@
+package unicodes_test
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.source.hueristiq.com/url/unicodes"
+)
+
+func TestClassForScripts(t *testing.T) {
+	t.Parallel()
+
+	regex := regexp.MustCompile(`^[a-z` + unicodes.ClassForScripts("Latin", "cyrillic", "Georgian", "NotAScript") + `]+$`)
+
+	for _, s := range []string{"bücher", "пример", "მაგალითი"} {
+		assert.Truef(t, regex.MatchString(s), "failed on: %s", s)
+	}
+
+	for _, s := range []string{"例子", "παράδειγμα", "مثال"} {
+		assert.Falsef(t, regex.MatchString(s), "failed on: %s", s)
+	}
+
+	assert.Empty(t, unicodes.ClassForScripts())
+	assert.Equal(t, unicodes.AllowedCJK, unicodes.ClassForScripts("CJK"))
+}
@@ -0,0 +1,25 @@
+package unicodes
+
+import "time"
+
+// IsStale reports whether the sets of this package were generated more than maxAge ago,
+// in which case they may predate the characters added by recent Unicode versions (see
+// UnicodeVersion).
+//
+// Parameters:
+//   - maxAge (time.Duration): The maximum acceptable age of the sets.
+//
+// Returns:
+//   - stale (bool): Whether the sets are older than maxAge.
+func IsStale(maxAge time.Duration) (stale bool) {
+	stale = isStale(GeneratedAt, time.Now(), maxAge)
+
+	return
+}
+
+// isStale reports whether data generated at generatedAt is, at now, older than maxAge.
+func isStale(generatedAt, now time.Time, maxAge time.Duration) (stale bool) {
+	stale = now.Sub(generatedAt) > maxAge
+
+	return
+}
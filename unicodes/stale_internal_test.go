@@ -0,0 +1,33 @@
+package unicodes
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIsStale_At(t *testing.T) {
+	t.Parallel()
+
+	generatedAt := time.Date(2025, time.January, 1, 0, 0, 0, 0, time.UTC)
+	maxAge := 365 * 24 * time.Hour
+
+	tests := []struct {
+		name     string
+		now      time.Time
+		expected bool
+	}{
+		{"Fresh", generatedAt.Add(24 * time.Hour), false},
+		{"Exactly max age", generatedAt.Add(maxAge), false},
+		{"Stale", generatedAt.Add(maxAge + time.Second), true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, tt.expected, isStale(generatedAt, tt.now, maxAge))
+		})
+	}
+}
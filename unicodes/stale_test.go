@@ -0,0 +1,19 @@
+package unicodes_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"go.source.hueristiq.com/url/unicodes"
+)
+
+func TestIsStale(t *testing.T) {
+	t.Parallel()
+
+	assert.False(t, unicodes.GeneratedAt.IsZero())
+	assert.NotEmpty(t, unicodes.UnicodeVersion)
+
+	assert.True(t, unicodes.IsStale(0))
+	assert.False(t, unicodes.IsStale(time.Since(unicodes.GeneratedAt)+time.Hour))
+}
@@ -0,0 +1,30 @@
+package unicodes
+
+import "unicode"
+
+// AllowedUcsCharTable returns the characters of AllowedUcsChar as a unicode.RangeTable,
+// so that validation code can use unicode.Is directly instead of building a regular
+// expression. The table is shared and must not be modified.
+//
+// Example:
+//
+//	unicode.Is(unicodes.AllowedUcsCharTable(), 'é') // true
+//
+// Returns:
+//   - table (*unicode.RangeTable): The range table.
+func AllowedUcsCharTable() (table *unicode.RangeTable) {
+	table = allowedUcsCharTable
+
+	return
+}
+
+// AllowedUcsCharMinusPuncTable returns the characters of AllowedUcsCharMinusPunc as a
+// unicode.RangeTable. The table is shared and must not be modified.
+//
+// Returns:
+//   - table (*unicode.RangeTable): The range table.
+func AllowedUcsCharMinusPuncTable() (table *unicode.RangeTable) {
+	table = allowedUcsCharMinusPuncTable
+
+	return
+}
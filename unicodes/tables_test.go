@@ -0,0 +1,38 @@
+package unicodes_test
+
+import (
+	"regexp"
+	"testing"
+	"unicode"
+
+	"github.com/stretchr/testify/assert"
+	"go.source.hueristiq.com/url/unicodes"
+)
+
+func TestRangeTables_MatchClasses(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		class string
+		table *unicode.RangeTable
+	}{
+		{unicodes.AllowedUcsChar, unicodes.AllowedUcsCharTable()},
+		{unicodes.AllowedUcsCharMinusPunc, unicodes.AllowedUcsCharMinusPuncTable()},
+	}
+
+	for _, tt := range tests {
+		regex := regexp.MustCompile(`^[` + tt.class + `]$`)
+
+		for cp := rune(0); cp <= unicode.MaxRune; cp++ {
+			if cp >= 0xD800 && cp <= 0xDFFF {
+				continue
+			}
+
+			if regex.MatchString(string(cp)) != unicode.Is(tt.table, cp) {
+				assert.Failf(t, "table and class disagree", "code point: %U", cp)
+
+				return
+			}
+		}
+	}
+}
@@ -1,10 +1,12 @@
 // This file is autogenerated by the unicodes generator. Please do not edit manually.
 package unicodes
 
+import "unicode"
+
 // AllowedUcsChar defines a range of allowed Unicode characters.
 // This set includes various characters spanning multiple blocks of the Unicode specification.
 // It allows for a wide range of characters, including those from languages, symbols, and certain punctuation.
-const AllowedUcsChar = "¡-ᙿᚁ-\u1fff\u200b-‧\u202a-\u202e‰-⁞\u2060-\u2fff、-\ud7ff豈-﷏ﷰ-\uffef𐀀-\U0001fffd𠀀-\U0002fffd𰀀-\U0003fffd\U00040000-\U0004fffd\U00050000-\U0005fffd\U00060000-\U0006fffd\U00070000-\U0007fffd\U00080000-\U0008fffd\U00090000-\U0009fffd\U000a0000-\U000afffd\U000b0000-\U000bfffd\U000c0000-\U000cfffd\U000d0000-\U000dfffd\U000e1000-\U000efffd"
+const AllowedUcsChar = "¡-ᙿᚁ-\u1fff\u200b-‧\u202a-\u202e‰-⁞\u2060-⿿、-\ud7ff豈-﷏ﷰ-\uffef𐀀-\U0001fffd𠀀-\U0002fffd𰀀-\U0003fffd\U00040000-\U0004fffd\U00050000-\U0005fffd\U00060000-\U0006fffd\U00070000-\U0007fffd\U00080000-\U0008fffd\U00090000-\U0009fffd\U000a0000-\U000afffd\U000b0000-\U000bfffd\U000c0000-\U000cfffd\U000d0000-\U000dfffd\U000e1000-\U000efffd"
 
 // AllowedUcsCharMinusPunc defines a range of allowed Unicode characters,
 // excluding certain punctuation marks. This range is used in contexts where
@@ -13,4 +15,249 @@ const AllowedUcsChar = "¡-ᙿᚁ-\u1fff\u200b-‧\u202a-\u202e‰-⁞\u2060-\u2
 //
 // This constant is useful when processing input where punctuation is undesired
 // or needs to be filtered out, such as usernames, identifiers, or file names.
-const AllowedUcsCharMinusPunc = "¢-¦¨-µ¸-¾À-ͽͿ-ΆΈ-ՙՠ-ֈ֊-ֿׁ-ׂׄ-ׇׅ-ײ\u05f5-؈؋؎-ؚ\u061cؠ-٩ٮ-ۓە-ۿ\u070e-߶ߺ-\u082f\u083f-\u085d\u085f-ॣ०-९ॱ-ৼ৾-ੵ\u0a77-૯૱-\u0c76౸-ಃಅ-ෳ\u0df5-๎๐-๙\u0e5c-༃༓༕-྄྆-࿏࿕-࿘\u0fdb-၉ၐ-ჺჼ-፟፩-᙭ᙯ-ᙿᚁ-ᛪᛮ-᜴\u1737-៓ៗ៛-\u17ff᠆᠋-\u1943᥆-\u1a1dᨠ-\u1a9fᪧ\u1aae-᭙᭡-᭼\u1b7f-\u1bfbᰀ-\u1c3a᱀-ᱽᲀ-Ჿ\u1cc8-᳔᳒-\u1fff\u200b-―‘-‟\u202a-\u202e‹-›‿-⁀⁄-⁆⁒⁔\u2060-\u2cf8⳽ⴀ-ⵯ\u2d71-ⷿ⸂-⸅⸉-⸊⸌-⸍⸗⸚⸜-⸝⸠-⸩ⸯ⸺-⸻⹀⹂⹐-⹑⹕-\u2fff〄-〼〾-ヺー-ꓽꔀ-ꘌꘐ-꙲ꙴ-꙽ꙿ-꛱\ua6f8-ꡳ\ua878-\ua8cd꣐-ꣷꣻꣽ-꤭ꤰ-\ua95eꥠ-꧀\ua9ce-\ua9ddꧠ-\uaa5bꩠ-ꫝꫠ-ꫯꫲ-ꯪ꯬-\ud7ff豈-﷏ﷰ-️︗-︘\ufe1a-︯︱-﹄﹇-﹈﹍-﹏\ufe53﹘-﹞﹢-\ufe67﹩\ufe6c-\uff00＄（-）＋－０-９＜-＞Ａ-［］-｠｢-｣ｦ-\uffef𐀀-\U000100ff\U00010103-\U0001039e𐎠-𐏏𐏑-\U0001056e𐕰-\U00010856𐡘-\U0001091e𐤠-\U0001093e\U00010940-\U00010a4f\U00010a59-𐩾𐪀-𐫯\U00010af7-\U00010b38𐭀-\U00010b98\U00010b9d-𐽔\U00010f5a-𐾅\U00010f8a-𑁆\U0001104e-𑂺\U000110bd𑃂-𑄿𑅄-𑅳𑅶-𑇄𑇉-𑇌𑇎-𑇚𑇜\U000111e0-𑈷𑈾-𑊨\U000112aa-𑑊𑑐-𑑙\U0001145c𑑞-𑓅𑓇-𑗀𑗘-𑙀𑙄-\U0001165f\U0001166d-𑚸\U000116ba-𑜻𑜿-𑠺\U0001183c-𑥃\U00011947-𑧡𑧣-𑨾𑩇-𑪙𑪝\U00011aa3-\U00011aff\U00011b0a-𑱀\U00011c46-\U00011c6f𑱲-𑻶\U00011ef9-𑽂𑽐-\U00011ffe𒀀-\U0001246f\U00012475-𒿰\U00012ff3-\U00016a6d𖩰-𖫴\U00016af6-𖬶𖬼-𖭃𖭅-𖺖\U00016e9b-𖿡𖿣-𛲞\U0001bca0-𝪆\U0001da8c-\U0001e95d\U0001e960-\U0001fffd𠀀-\U0002fffd𰀀-\U0003fffd\U00040000-\U0004fffd\U00050000-\U0005fffd\U00060000-\U0006fffd\U00070000-\U0007fffd\U00080000-\U0008fffd\U00090000-\U0009fffd\U000a0000-\U000afffd\U000b0000-\U000bfffd\U000c0000-\U000cfffd\U000d0000-\U000dfffd\U000e1000-\U000efffd"
+const AllowedUcsCharMinusPunc = "¢-¦¨-µ¸-¾À-ͽͿ-ΆΈ-ՙՠ-ֈ֊-ֿׁ-ׂׄ-ׇׅ-ײ\u05f5-؈؋؎-ؚ\u061cؠ-٩ٮ-ۓە-ۿ\u070e-߶ߺ-\u082f\u083f-\u085d\u085f-ॣ०-९ॱ-ৼ৾-ੵ\u0a77-૯૱-\u0c76౸-ಃಅ-ෳ\u0df5-๎๐-๙\u0e5c-༃༓༕-྄྆-࿏࿕-࿘\u0fdb-၉ၐ-ჺჼ-፟፩-᙭ᙯ-ᙿᚁ-ᛪᛮ-᜴\u1737-៓ៗ៛-\u17ff᠆᠋-\u1943᥆-\u1a1dᨠ-\u1a9fᪧ\u1aae-\u1b4d᭐-᭙᭡-᭼ᮀ-\u1bfbᰀ-\u1c3a᱀-ᱽᲀ-Ჿ\u1cc8-᳔᳒-\u1fff\u200b-―‘-‟\u202a-\u202e‹-›‿-⁀⁄-⁆⁒⁔\u2060-\u2cf8⳽ⴀ-ⵯ\u2d71-ⷿ⸂-⸅⸉-⸊⸌-⸍⸗⸚⸜-⸝⸠-⸩ⸯ⸺-⸻⹀⹂⹐-⹑⹕-⿿〄-〼〾-ヺー-ꓽꔀ-ꘌꘐ-꙲ꙴ-꙽ꙿ-꛱\ua6f8-ꡳ\ua878-\ua8cd꣐-ꣷꣻꣽ-꤭ꤰ-\ua95eꥠ-꧀\ua9ce-\ua9ddꧠ-\uaa5bꩠ-ꫝꫠ-ꫯꫲ-ꯪ꯬-\ud7ff豈-﷏ﷰ-️︗-︘\ufe1a-︯︱-﹄﹇-﹈﹍-﹏\ufe53﹘-﹞﹢-\ufe67﹩\ufe6c-\uff00＄（-）＋－０-９＜-＞Ａ-［］-｠｢-｣ｦ-\uffef𐀀-\U000100ff\U00010103-\U0001039e𐎠-𐏏𐏑-\U0001056e𐕰-\U00010856𐡘-\U0001091e𐤠-\U0001093e𐥀-\U00010a4f\U00010a59-𐩾𐪀-𐫯\U00010af7-\U00010b38𐭀-\U00010b98\U00010b9d-\U00010ecf𐻑-𐽔\U00010f5a-𐾅\U00010f8a-𑁆\U0001104e-𑂺\U000110bd𑃂-𑄿𑅄-𑅳𑅶-𑇄𑇉-𑇌𑇎-𑇚𑇜\U000111e0-𑈷𑈾-𑊨\U000112aa-𑏓\U000113d6\U000113d9-𑑊𑑐-𑑙\U0001145c𑑞-𑓅𑓇-𑗀𑗘-𑙀𑙄-\U0001165f\U0001166d-𑚸\U000116ba-𑜻𑜿-𑠺\U0001183c-𑥃\U00011947-𑧡𑧣-𑨾𑩇-𑪙𑪝\U00011aa3-\U00011aff\U00011b0a-𑯠\U00011be2-𑱀\U00011c46-\U00011c6f𑱲-𑻶\U00011ef9-𑽂𑽐-\U00011ffe𒀀-\U0001246f\U00012475-𒿰\U00012ff3-\U00016a6d𖩰-𖫴\U00016af6-𖬶𖬼-𖭃𖭅-𖵬𖵰-𖺖\U00016e9b-𖿡𖿣-𛲞\U0001bca0-𝪆\U0001da8c-\U0001e5fe\U0001e600-\U0001e95d\U0001e960-\U0001fffd𠀀-\U0002fffd𰀀-\U0003fffd\U00040000-\U0004fffd\U00050000-\U0005fffd\U00060000-\U0006fffd\U00070000-\U0007fffd\U00080000-\U0008fffd\U00090000-\U0009fffd\U000a0000-\U000afffd\U000b0000-\U000bfffd\U000c0000-\U000cfffd\U000d0000-\U000dfffd\U000e1000-\U000efffd"
+
+// allowedUcsCharTable is the unicode.RangeTable form of AllowedUcsChar.
+var allowedUcsCharTable = &unicode.RangeTable{
+	R16: []unicode.Range16{
+		{Lo: 0x00a1, Hi: 0x167f, Stride: 1},
+		{Lo: 0x1681, Hi: 0x1fff, Stride: 1},
+		{Lo: 0x200b, Hi: 0x2027, Stride: 1},
+		{Lo: 0x202a, Hi: 0x202e, Stride: 1},
+		{Lo: 0x2030, Hi: 0x205e, Stride: 1},
+		{Lo: 0x2060, Hi: 0x2fff, Stride: 1},
+		{Lo: 0x3001, Hi: 0xd7ff, Stride: 1},
+		{Lo: 0xf900, Hi: 0xfdcf, Stride: 1},
+		{Lo: 0xfdf0, Hi: 0xffef, Stride: 1},
+	},
+	R32: []unicode.Range32{
+		{Lo: 0x10000, Hi: 0x1fffd, Stride: 1},
+		{Lo: 0x20000, Hi: 0x2fffd, Stride: 1},
+		{Lo: 0x30000, Hi: 0x3fffd, Stride: 1},
+		{Lo: 0x40000, Hi: 0x4fffd, Stride: 1},
+		{Lo: 0x50000, Hi: 0x5fffd, Stride: 1},
+		{Lo: 0x60000, Hi: 0x6fffd, Stride: 1},
+		{Lo: 0x70000, Hi: 0x7fffd, Stride: 1},
+		{Lo: 0x80000, Hi: 0x8fffd, Stride: 1},
+		{Lo: 0x90000, Hi: 0x9fffd, Stride: 1},
+		{Lo: 0xa0000, Hi: 0xafffd, Stride: 1},
+		{Lo: 0xb0000, Hi: 0xbfffd, Stride: 1},
+		{Lo: 0xc0000, Hi: 0xcfffd, Stride: 1},
+		{Lo: 0xd0000, Hi: 0xdfffd, Stride: 1},
+		{Lo: 0xe1000, Hi: 0xefffd, Stride: 1},
+	},
+	LatinOffset: 0,
+}
+
+// allowedUcsCharMinusPuncTable is the unicode.RangeTable form of AllowedUcsCharMinusPunc.
+var allowedUcsCharMinusPuncTable = &unicode.RangeTable{
+	R16: []unicode.Range16{
+		{Lo: 0x00a2, Hi: 0x00a6, Stride: 1},
+		{Lo: 0x00a8, Hi: 0x00b5, Stride: 1},
+		{Lo: 0x00b8, Hi: 0x00be, Stride: 1},
+		{Lo: 0x00c0, Hi: 0x037d, Stride: 1},
+		{Lo: 0x037f, Hi: 0x0386, Stride: 1},
+		{Lo: 0x0388, Hi: 0x0559, Stride: 1},
+		{Lo: 0x0560, Hi: 0x0588, Stride: 1},
+		{Lo: 0x058a, Hi: 0x05bf, Stride: 1},
+		{Lo: 0x05c1, Hi: 0x05c2, Stride: 1},
+		{Lo: 0x05c4, Hi: 0x05c5, Stride: 1},
+		{Lo: 0x05c7, Hi: 0x05f2, Stride: 1},
+		{Lo: 0x05f5, Hi: 0x0608, Stride: 1},
+		{Lo: 0x060b, Hi: 0x060b, Stride: 1},
+		{Lo: 0x060e, Hi: 0x061a, Stride: 1},
+		{Lo: 0x061c, Hi: 0x061c, Stride: 1},
+		{Lo: 0x0620, Hi: 0x0669, Stride: 1},
+		{Lo: 0x066e, Hi: 0x06d3, Stride: 1},
+		{Lo: 0x06d5, Hi: 0x06ff, Stride: 1},
+		{Lo: 0x070e, Hi: 0x07f6, Stride: 1},
+		{Lo: 0x07fa, Hi: 0x082f, Stride: 1},
+		{Lo: 0x083f, Hi: 0x085d, Stride: 1},
+		{Lo: 0x085f, Hi: 0x0963, Stride: 1},
+		{Lo: 0x0966, Hi: 0x096f, Stride: 1},
+		{Lo: 0x0971, Hi: 0x09fc, Stride: 1},
+		{Lo: 0x09fe, Hi: 0x0a75, Stride: 1},
+		{Lo: 0x0a77, Hi: 0x0aef, Stride: 1},
+		{Lo: 0x0af1, Hi: 0x0c76, Stride: 1},
+		{Lo: 0x0c78, Hi: 0x0c83, Stride: 1},
+		{Lo: 0x0c85, Hi: 0x0df3, Stride: 1},
+		{Lo: 0x0df5, Hi: 0x0e4e, Stride: 1},
+		{Lo: 0x0e50, Hi: 0x0e59, Stride: 1},
+		{Lo: 0x0e5c, Hi: 0x0f03, Stride: 1},
+		{Lo: 0x0f13, Hi: 0x0f13, Stride: 1},
+		{Lo: 0x0f15, Hi: 0x0f84, Stride: 1},
+		{Lo: 0x0f86, Hi: 0x0fcf, Stride: 1},
+		{Lo: 0x0fd5, Hi: 0x0fd8, Stride: 1},
+		{Lo: 0x0fdb, Hi: 0x1049, Stride: 1},
+		{Lo: 0x1050, Hi: 0x10fa, Stride: 1},
+		{Lo: 0x10fc, Hi: 0x135f, Stride: 1},
+		{Lo: 0x1369, Hi: 0x166d, Stride: 1},
+		{Lo: 0x166f, Hi: 0x167f, Stride: 1},
+		{Lo: 0x1681, Hi: 0x16ea, Stride: 1},
+		{Lo: 0x16ee, Hi: 0x1734, Stride: 1},
+		{Lo: 0x1737, Hi: 0x17d3, Stride: 1},
+		{Lo: 0x17d7, Hi: 0x17d7, Stride: 1},
+		{Lo: 0x17db, Hi: 0x17ff, Stride: 1},
+		{Lo: 0x1806, Hi: 0x1806, Stride: 1},
+		{Lo: 0x180b, Hi: 0x1943, Stride: 1},
+		{Lo: 0x1946, Hi: 0x1a1d, Stride: 1},
+		{Lo: 0x1a20, Hi: 0x1a9f, Stride: 1},
+		{Lo: 0x1aa7, Hi: 0x1aa7, Stride: 1},
+		{Lo: 0x1aae, Hi: 0x1b4d, Stride: 1},
+		{Lo: 0x1b50, Hi: 0x1b59, Stride: 1},
+		{Lo: 0x1b61, Hi: 0x1b7c, Stride: 1},
+		{Lo: 0x1b80, Hi: 0x1bfb, Stride: 1},
+		{Lo: 0x1c00, Hi: 0x1c3a, Stride: 1},
+		{Lo: 0x1c40, Hi: 0x1c7d, Stride: 1},
+		{Lo: 0x1c80, Hi: 0x1cbf, Stride: 1},
+		{Lo: 0x1cc8, Hi: 0x1cd2, Stride: 1},
+		{Lo: 0x1cd4, Hi: 0x1fff, Stride: 1},
+		{Lo: 0x200b, Hi: 0x2015, Stride: 1},
+		{Lo: 0x2018, Hi: 0x201f, Stride: 1},
+		{Lo: 0x202a, Hi: 0x202e, Stride: 1},
+		{Lo: 0x2039, Hi: 0x203a, Stride: 1},
+		{Lo: 0x203f, Hi: 0x2040, Stride: 1},
+		{Lo: 0x2044, Hi: 0x2046, Stride: 1},
+		{Lo: 0x2052, Hi: 0x2052, Stride: 1},
+		{Lo: 0x2054, Hi: 0x2054, Stride: 1},
+		{Lo: 0x2060, Hi: 0x2cf8, Stride: 1},
+		{Lo: 0x2cfd, Hi: 0x2cfd, Stride: 1},
+		{Lo: 0x2d00, Hi: 0x2d6f, Stride: 1},
+		{Lo: 0x2d71, Hi: 0x2dff, Stride: 1},
+		{Lo: 0x2e02, Hi: 0x2e05, Stride: 1},
+		{Lo: 0x2e09, Hi: 0x2e0a, Stride: 1},
+		{Lo: 0x2e0c, Hi: 0x2e0d, Stride: 1},
+		{Lo: 0x2e17, Hi: 0x2e17, Stride: 1},
+		{Lo: 0x2e1a, Hi: 0x2e1a, Stride: 1},
+		{Lo: 0x2e1c, Hi: 0x2e1d, Stride: 1},
+		{Lo: 0x2e20, Hi: 0x2e29, Stride: 1},
+		{Lo: 0x2e2f, Hi: 0x2e2f, Stride: 1},
+		{Lo: 0x2e3a, Hi: 0x2e3b, Stride: 1},
+		{Lo: 0x2e40, Hi: 0x2e40, Stride: 1},
+		{Lo: 0x2e42, Hi: 0x2e42, Stride: 1},
+		{Lo: 0x2e50, Hi: 0x2e51, Stride: 1},
+		{Lo: 0x2e55, Hi: 0x2fff, Stride: 1},
+		{Lo: 0x3004, Hi: 0x303c, Stride: 1},
+		{Lo: 0x303e, Hi: 0x30fa, Stride: 1},
+		{Lo: 0x30fc, Hi: 0xa4fd, Stride: 1},
+		{Lo: 0xa500, Hi: 0xa60c, Stride: 1},
+		{Lo: 0xa610, Hi: 0xa672, Stride: 1},
+		{Lo: 0xa674, Hi: 0xa67d, Stride: 1},
+		{Lo: 0xa67f, Hi: 0xa6f1, Stride: 1},
+		{Lo: 0xa6f8, Hi: 0xa873, Stride: 1},
+		{Lo: 0xa878, Hi: 0xa8cd, Stride: 1},
+		{Lo: 0xa8d0, Hi: 0xa8f7, Stride: 1},
+		{Lo: 0xa8fb, Hi: 0xa8fb, Stride: 1},
+		{Lo: 0xa8fd, Hi: 0xa92d, Stride: 1},
+		{Lo: 0xa930, Hi: 0xa95e, Stride: 1},
+		{Lo: 0xa960, Hi: 0xa9c0, Stride: 1},
+		{Lo: 0xa9ce, Hi: 0xa9dd, Stride: 1},
+		{Lo: 0xa9e0, Hi: 0xaa5b, Stride: 1},
+		{Lo: 0xaa60, Hi: 0xaadd, Stride: 1},
+		{Lo: 0xaae0, Hi: 0xaaef, Stride: 1},
+		{Lo: 0xaaf2, Hi: 0xabea, Stride: 1},
+		{Lo: 0xabec, Hi: 0xd7ff, Stride: 1},
+		{Lo: 0xf900, Hi: 0xfdcf, Stride: 1},
+		{Lo: 0xfdf0, Hi: 0xfe0f, Stride: 1},
+		{Lo: 0xfe17, Hi: 0xfe18, Stride: 1},
+		{Lo: 0xfe1a, Hi: 0xfe2f, Stride: 1},
+		{Lo: 0xfe31, Hi: 0xfe44, Stride: 1},
+		{Lo: 0xfe47, Hi: 0xfe48, Stride: 1},
+		{Lo: 0xfe4d, Hi: 0xfe4f, Stride: 1},
+		{Lo: 0xfe53, Hi: 0xfe53, Stride: 1},
+		{Lo: 0xfe58, Hi: 0xfe5e, Stride: 1},
+		{Lo: 0xfe62, Hi: 0xfe67, Stride: 1},
+		{Lo: 0xfe69, Hi: 0xfe69, Stride: 1},
+		{Lo: 0xfe6c, Hi: 0xff00, Stride: 1},
+		{Lo: 0xff04, Hi: 0xff04, Stride: 1},
+		{Lo: 0xff08, Hi: 0xff09, Stride: 1},
+		{Lo: 0xff0b, Hi: 0xff0b, Stride: 1},
+		{Lo: 0xff0d, Hi: 0xff0d, Stride: 1},
+		{Lo: 0xff10, Hi: 0xff19, Stride: 1},
+		{Lo: 0xff1c, Hi: 0xff1e, Stride: 1},
+		{Lo: 0xff21, Hi: 0xff3b, Stride: 1},
+		{Lo: 0xff3d, Hi: 0xff60, Stride: 1},
+		{Lo: 0xff62, Hi: 0xff63, Stride: 1},
+		{Lo: 0xff66, Hi: 0xffef, Stride: 1},
+	},
+	R32: []unicode.Range32{
+		{Lo: 0x10000, Hi: 0x100ff, Stride: 1},
+		{Lo: 0x10103, Hi: 0x1039e, Stride: 1},
+		{Lo: 0x103a0, Hi: 0x103cf, Stride: 1},
+		{Lo: 0x103d1, Hi: 0x1056e, Stride: 1},
+		{Lo: 0x10570, Hi: 0x10856, Stride: 1},
+		{Lo: 0x10858, Hi: 0x1091e, Stride: 1},
+		{Lo: 0x10920, Hi: 0x1093e, Stride: 1},
+		{Lo: 0x10940, Hi: 0x10a4f, Stride: 1},
+		{Lo: 0x10a59, Hi: 0x10a7e, Stride: 1},
+		{Lo: 0x10a80, Hi: 0x10aef, Stride: 1},
+		{Lo: 0x10af7, Hi: 0x10b38, Stride: 1},
+		{Lo: 0x10b40, Hi: 0x10b98, Stride: 1},
+		{Lo: 0x10b9d, Hi: 0x10ecf, Stride: 1},
+		{Lo: 0x10ed1, Hi: 0x10f54, Stride: 1},
+		{Lo: 0x10f5a, Hi: 0x10f85, Stride: 1},
+		{Lo: 0x10f8a, Hi: 0x11046, Stride: 1},
+		{Lo: 0x1104e, Hi: 0x110ba, Stride: 1},
+		{Lo: 0x110bd, Hi: 0x110bd, Stride: 1},
+		{Lo: 0x110c2, Hi: 0x1113f, Stride: 1},
+		{Lo: 0x11144, Hi: 0x11173, Stride: 1},
+		{Lo: 0x11176, Hi: 0x111c4, Stride: 1},
+		{Lo: 0x111c9, Hi: 0x111cc, Stride: 1},
+		{Lo: 0x111ce, Hi: 0x111da, Stride: 1},
+		{Lo: 0x111dc, Hi: 0x111dc, Stride: 1},
+		{Lo: 0x111e0, Hi: 0x11237, Stride: 1},
+		{Lo: 0x1123e, Hi: 0x112a8, Stride: 1},
+		{Lo: 0x112aa, Hi: 0x113d3, Stride: 1},
+		{Lo: 0x113d6, Hi: 0x113d6, Stride: 1},
+		{Lo: 0x113d9, Hi: 0x1144a, Stride: 1},
+		{Lo: 0x11450, Hi: 0x11459, Stride: 1},
+		{Lo: 0x1145c, Hi: 0x1145c, Stride: 1},
+		{Lo: 0x1145e, Hi: 0x114c5, Stride: 1},
+		{Lo: 0x114c7, Hi: 0x115c0, Stride: 1},
+		{Lo: 0x115d8, Hi: 0x11640, Stride: 1},
+		{Lo: 0x11644, Hi: 0x1165f, Stride: 1},
+		{Lo: 0x1166d, Hi: 0x116b8, Stride: 1},
+		{Lo: 0x116ba, Hi: 0x1173b, Stride: 1},
+		{Lo: 0x1173f, Hi: 0x1183a, Stride: 1},
+		{Lo: 0x1183c, Hi: 0x11943, Stride: 1},
+		{Lo: 0x11947, Hi: 0x119e1, Stride: 1},
+		{Lo: 0x119e3, Hi: 0x11a3e, Stride: 1},
+		{Lo: 0x11a47, Hi: 0x11a99, Stride: 1},
+		{Lo: 0x11a9d, Hi: 0x11a9d, Stride: 1},
+		{Lo: 0x11aa3, Hi: 0x11aff, Stride: 1},
+		{Lo: 0x11b0a, Hi: 0x11be0, Stride: 1},
+		{Lo: 0x11be2, Hi: 0x11c40, Stride: 1},
+		{Lo: 0x11c46, Hi: 0x11c6f, Stride: 1},
+		{Lo: 0x11c72, Hi: 0x11ef6, Stride: 1},
+		{Lo: 0x11ef9, Hi: 0x11f42, Stride: 1},
+		{Lo: 0x11f50, Hi: 0x11ffe, Stride: 1},
+		{Lo: 0x12000, Hi: 0x1246f, Stride: 1},
+		{Lo: 0x12475, Hi: 0x12ff0, Stride: 1},
+		{Lo: 0x12ff3, Hi: 0x16a6d, Stride: 1},
+		{Lo: 0x16a70, Hi: 0x16af4, Stride: 1},
+		{Lo: 0x16af6, Hi: 0x16b36, Stride: 1},
+		{Lo: 0x16b3c, Hi: 0x16b43, Stride: 1},
+		{Lo: 0x16b45, Hi: 0x16d6c, Stride: 1},
+		{Lo: 0x16d70, Hi: 0x16e96, Stride: 1},
+		{Lo: 0x16e9b, Hi: 0x16fe1, Stride: 1},
+		{Lo: 0x16fe3, Hi: 0x1bc9e, Stride: 1},
+		{Lo: 0x1bca0, Hi: 0x1da86, Stride: 1},
+		{Lo: 0x1da8c, Hi: 0x1e5fe, Stride: 1},
+		{Lo: 0x1e600, Hi: 0x1e95d, Stride: 1},
+		{Lo: 0x1e960, Hi: 0x1fffd, Stride: 1},
+		{Lo: 0x20000, Hi: 0x2fffd, Stride: 1},
+		{Lo: 0x30000, Hi: 0x3fffd, Stride: 1},
+		{Lo: 0x40000, Hi: 0x4fffd, Stride: 1},
+		{Lo: 0x50000, Hi: 0x5fffd, Stride: 1},
+		{Lo: 0x60000, Hi: 0x6fffd, Stride: 1},
+		{Lo: 0x70000, Hi: 0x7fffd, Stride: 1},
+		{Lo: 0x80000, Hi: 0x8fffd, Stride: 1},
+		{Lo: 0x90000, Hi: 0x9fffd, Stride: 1},
+		{Lo: 0xa0000, Hi: 0xafffd, Stride: 1},
+		{Lo: 0xb0000, Hi: 0xbfffd, Stride: 1},
+		{Lo: 0xc0000, Hi: 0xcfffd, Stride: 1},
+		{Lo: 0xd0000, Hi: 0xdfffd, Stride: 1},
+		{Lo: 0xe1000, Hi: 0xefffd, Stride: 1},
+	},
+	LatinOffset: 3,
+}
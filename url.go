@@ -1,6 +1,14 @@
 package url
 
-import "net/url"
+import (
+	"net/url"
+	"strconv"
+	"strings"
+
+	"go.source.hueristiq.com/url/darknet"
+	"go.source.hueristiq.com/url/schemes"
+	"go.source.hueristiq.com/url/shorteners"
+)
 
 // URL extends the standard net/url URL struct by embedding it and adding additional fields
 // for handling domain-related information. This extension provides a more detailed representation
@@ -68,4 +76,86 @@ type URL struct {
 	*url.URL
 
 	Domain *Domain
+
+	// Raw is the original input string, as given to Parser.Parse, before the default
+	// scheme is added or anything is normalized. It lets tools report exactly what was
+	// found in the source.
+	Raw string
+
+	// EncodedHost is the host as percent-encoded in the input (e.g., "%65xample.com" for
+	// "http://%65xample.com/"), if it was, in which case Host holds it decoded and
+	// validated. Browsers decode such hosts, which are used to slip past allowlists
+	// comparing hosts as strings, so they are a red flag.
+	EncodedHost string
+}
+
+// PortOrDefault returns the port of the URL: the explicit port if one is present, or else
+// the default port of the scheme as listed in schemes.DefaultPorts (e.g., 443 for "https").
+//
+// Returns:
+//   - port (int): The port, or 0 if the URL has no valid explicit port and its scheme has
+//     no known default port.
+func (u *URL) PortOrDefault() (port int) {
+	if explicit := u.Port(); explicit != "" {
+		port, _ = strconv.Atoi(explicit)
+
+		return
+	}
+
+	port = schemes.DefaultPorts[strings.ToLower(u.Scheme)]
+
+	return
+}
+
+// HasExplicitDefaultPort reports whether the URL spells out the default port of its scheme
+// (e.g., "https://example.com:443"), which normalization usually removes.
+//
+// Returns:
+//   - explicit (bool): True if the explicit port equals the scheme's default port.
+func (u *URL) HasExplicitDefaultPort() (explicit bool) {
+	port := u.Port()
+	if port == "" {
+		return
+	}
+
+	defaultPort, ok := schemes.DefaultPorts[strings.ToLower(u.Scheme)]
+
+	explicit = ok && port == strconv.Itoa(defaultPort)
+
+	return
+}
+
+// IsOnion reports whether the host of the URL is in the ".onion" pseudo-TLD of Tor. Use
+// darknet.ValidateOnionV3 to check that it is a well-formed v3 onion address.
+//
+// Returns:
+//   - onion (bool): True if the host ends with ".onion".
+func (u *URL) IsOnion() (onion bool) {
+	onion = darknet.IsOnion(u.Hostname())
+
+	return
+}
+
+// IsI2P reports whether the host of the URL is in the ".i2p" pseudo-TLD of I2P. Use
+// darknet.ValidateI2P to check that it is a well-formed base32 address.
+//
+// Returns:
+//   - i2p (bool): True if the host ends with ".i2p".
+func (u *URL) IsI2P() (i2p bool) {
+	i2p = darknet.IsI2P(u.Hostname())
+
+	return
+}
+
+// IsShortened reports whether the URL is a link produced by a known URL shortener: its host
+// is a shortener domain (see shorteners.IsShortener) and it has a path, as the home pages
+// of shorteners are not shortened links. Shortened links can be expanded with
+// ExpandShortened.
+//
+// Returns:
+//   - shortened (bool): True if the URL is a shortened link.
+func (u *URL) IsShortened() (shortened bool) {
+	shortened = strings.Trim(u.Path, "/") != "" && shorteners.IsShortener(u.Hostname())
+
+	return
 }
@@ -0,0 +1,100 @@
+package url
+
+import (
+	"regexp"
+	"slices"
+	"strings"
+)
+
+var (
+	// apiVersionRegex matches the version segments of API paths (e.g., "v1" or "v2.1").
+	apiVersionRegex = regexp.MustCompile(`(?i)^v\d+(?:\.\d+)?$`)
+	// apiIDRegex matches the resource identifier segments of REST paths: numbers and UUIDs.
+	apiIDRegex = regexp.MustCompile(`(?i)^(?:\d+|[0-9a-f]{8}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{12})$`)
+	// apiPluralRegex matches the REST-ish plural nouns of collection segments (e.g., "users"),
+	// but not the common singular words ending with "s" (e.g., "status" or "analysis").
+	apiPluralRegex = regexp.MustCompile(`(?i)^[a-z][a-z_-]{2,}[^isu]s$`)
+)
+
+// apiKeywords are the path segments and host labels that mark API endpoints.
+var apiKeywords = []string{"api", "apis", "rest", "graphql", "rpc", "jsonrpc", "xmlrpc", "ws", "services"}
+
+// The weights of the signals LooksLikeAPI adds up to its score.
+const (
+	apiKeywordWeight = 0.4
+	apiVersionWeight = 0.3
+	apiFormatWeight  = 0.3
+	apiPluralWeight  = 0.2
+	apiIDWeight      = 0.1
+	apiHostWeight    = 0.2
+)
+
+// LooksLikeAPI scores how likely the URL is an API endpoint, to prioritize extracted URLs
+// for active testing. The score adds up signals from the path and the host: an API keyword
+// segment (e.g., "/api/" or "/graphql"), a version segment (e.g., "/v1/"), a ".json" or
+// ".xml" extension, REST-ish plural nouns (e.g., "/users") and resource identifiers (e.g.,
+// "/users/42"), and an API keyword label in the subdomain (e.g., "api.example.com").
+// Static assets (see IsStaticAsset) score 0.
+//
+// Returns:
+//   - score (float64): The score, between 0 (not an API endpoint) and 1.
+func (u *URL) LooksLikeAPI() (score float64) {
+	if u.IsStaticAsset() {
+		return
+	}
+
+	keyword, version, plural, ID := false, false, false, false
+
+	for _, segment := range strings.Split(u.Path, "/") {
+		if segment == "" {
+			continue
+		}
+
+		name := strings.ToLower(strings.TrimSuffix(segment, pathExtension(segment)))
+
+		switch {
+		case slices.Contains(apiKeywords, name):
+			keyword = true
+		case apiVersionRegex.MatchString(segment):
+			version = true
+		case apiIDRegex.MatchString(segment):
+			ID = true
+		case apiPluralRegex.MatchString(name):
+			plural = true
+		}
+	}
+
+	if keyword {
+		score += apiKeywordWeight
+	}
+
+	if version {
+		score += apiVersionWeight
+	}
+
+	if extension := u.Extension(); extension == ".json" || extension == ".xml" {
+		score += apiFormatWeight
+	}
+
+	if plural {
+		score += apiPluralWeight
+	}
+
+	if plural && ID {
+		score += apiIDWeight
+	}
+
+	if u.Domain != nil {
+		for _, label := range strings.Split(u.Domain.Subdomain, ".") {
+			if slices.Contains(apiKeywords, strings.ToLower(label)) {
+				score += apiHostWeight
+
+				break
+			}
+		}
+	}
+
+	score = min(score, 1)
+
+	return
+}
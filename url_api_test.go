@@ -0,0 +1,40 @@
+package url_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	hqgourl "go.source.hueristiq.com/url"
+)
+
+func TestURL_LooksLikeAPI(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		raw   string
+		score float64
+	}{
+		{"https://example.com/api/v1/users/42", 1},
+		{"https://example.com/api/v2/orders.json", 1},
+		{"https://api.example.com/v1/status", 0.5},
+		{"https://example.com/graphql", 0.4},
+		{"https://example.com/products/3f2a9c1b-1234-4abc-9def-0123456789ab", 0.3},
+		{"https://example.com/feed.xml", 0.3},
+		{"https://example.com/about", 0},
+		{"https://example.com/address", 0},
+		{"https://example.com/api/logo.png", 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.raw, func(t *testing.T) {
+			t.Parallel()
+
+			parsed, err := hqgourl.NewParser().Parse(tt.raw)
+
+			require.NoError(t, err)
+
+			assert.InDelta(t, tt.score, parsed.LooksLikeAPI(), 1e-9)
+		})
+	}
+}
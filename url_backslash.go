@@ -0,0 +1,39 @@
+package url
+
+import (
+	"slices"
+	"strings"
+)
+
+// specialSchemes are the special schemes of the WHATWG URL Standard, whose URLs browsers
+// parse with backslashes taken for slashes.
+var specialSchemes = []string{"ftp", "file", "http", "https", "ws", "wss"}
+
+// normalizeBackslashes replaces the backslashes of unparsed with slashes before its query
+// and fragment, as browsers do for URLs with a special scheme (e.g., "https:\\example.com\a"
+// for "https://example.com/a") and for scheme-relative and schemeless ones, which they
+// resolve against special base URLs (e.g., "\\example.com\a"). URLs of other schemes
+// (e.g., "mailto:") and Windows paths (e.g., "C:\Users") are left as they are.
+func normalizeBackslashes(unparsed string) (normalized string) {
+	normalized = unparsed
+
+	end := len(unparsed)
+
+	if i := strings.IndexAny(unparsed, "?#"); i >= 0 {
+		end = i
+	}
+
+	if !strings.Contains(unparsed[:end], `\`) {
+		return
+	}
+
+	if i := strings.IndexAny(unparsed[:end], `:/\`); i > 0 && unparsed[i] == ':' {
+		if !slices.Contains(specialSchemes, strings.ToLower(unparsed[:i])) {
+			return
+		}
+	}
+
+	normalized = strings.ReplaceAll(unparsed[:end], `\`, "/") + unparsed[end:]
+
+	return
+}
@@ -0,0 +1,42 @@
+package url
+
+import "sync"
+
+// defaultParser is the Parser returned by DefaultParser, created on first use.
+var defaultParser = sync.OnceValue(func() *Parser {
+	return NewParser()
+})
+
+// defaultExtractor is the extractor returned by DefaultExtractor, compiled on first use.
+var defaultExtractor = sync.OnceValue(func() *CompiledExtractor {
+	// The default configuration is valid.
+	compiled, _ := NewExtractor().Compile()
+
+	return compiled
+})
+
+// DefaultParser returns a Parser with the default configuration (as created by NewParser
+// without options), shared by the whole process. It is created on first use, so that
+// libraries and short-lived CLIs don't each pay for building the suffix array index of a
+// Parser of their own, and is safe for concurrent use.
+//
+// Returns:
+//   - parser (*Parser): The shared default Parser.
+func DefaultParser() (parser *Parser) {
+	parser = defaultParser()
+
+	return
+}
+
+// DefaultExtractor returns an extractor with the default configuration (as created by
+// NewExtractor without options), shared by the whole process. It is compiled on first use
+// and, being a CompiledExtractor, cannot be reconfigured by any of its users, which makes
+// it safe for concurrent use.
+//
+// Returns:
+//   - extractor (*CompiledExtractor): The shared default extractor.
+func DefaultExtractor() (extractor *CompiledExtractor) {
+	extractor = defaultExtractor()
+
+	return
+}
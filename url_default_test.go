@@ -0,0 +1,48 @@
+package url_test
+
+import (
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	hqgourl "go.source.hueristiq.com/url"
+)
+
+func TestDefaultParser(t *testing.T) {
+	t.Parallel()
+
+	assert.Same(t, hqgourl.DefaultParser(), hqgourl.DefaultParser())
+
+	parsed, err := hqgourl.DefaultParser().Parse("https://www.example.co.uk/path")
+
+	require.NoError(t, err)
+
+	assert.Equal(t, "co.uk", parsed.Domain.TLD)
+}
+
+func TestDefaultExtractor(t *testing.T) {
+	t.Parallel()
+
+	var wg sync.WaitGroup
+
+	extractors := make([]*hqgourl.CompiledExtractor, 8)
+
+	for i := range extractors {
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+
+			extractors[i] = hqgourl.DefaultExtractor()
+
+			assert.Len(t, extractors[i].Extract("see https://example.com and info@example.com"), 2)
+		}()
+	}
+
+	wg.Wait()
+
+	for _, extractor := range extractors {
+		assert.Same(t, extractors[0], extractor)
+	}
+}
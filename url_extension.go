@@ -0,0 +1,88 @@
+package url
+
+import (
+	"net/url"
+	"path"
+	"slices"
+	"strings"
+
+	"go.source.hueristiq.com/url/mimetypes"
+)
+
+// Extension returns the file extension of the last segment of the URL's path, lowercased
+// and with leading dot (e.g., ".js" for "https://example.com/static/App.JS?v=2").
+//
+// Returns:
+//   - extension (string): The extension, or an empty string if the last path segment has
+//     none (e.g., "/api/users" or "/docs/").
+func (u *URL) Extension() (extension string) {
+	extension = pathExtension(u.Path)
+
+	return
+}
+
+// GuessContentType guesses the media type of the content the URL serves from the
+// extension of its path (see Extension), using the table of the mimetypes package. The
+// guess ignores what the server actually sends: use it to triage URLs without requests.
+//
+// Returns:
+//   - contentType (string): The media type (e.g., "text/javascript"), or an empty string if
+//     the URL has no extension or its extension is unknown.
+func (u *URL) GuessContentType() (contentType string) {
+	contentType = mimetypes.TypeByExtension(u.Extension())
+
+	return
+}
+
+// pathExtension returns the extension of the last segment of p, lowercased and with
+// leading dot, or an empty string if it has none.
+func pathExtension(p string) (extension string) {
+	extension = strings.ToLower(path.Ext(p))
+
+	if extension == "." {
+		extension = ""
+	}
+
+	return
+}
+
+// extension returns the extension of the path of the matched value, lowercased and with
+// leading dot, or an empty string if it has none (e.g., for emails).
+func (m Match) extension() (extension string) {
+	if m.Type == MatchTypeEmail {
+		return
+	}
+
+	raw := m.Value
+
+	if m.Type == MatchTypeHost {
+		raw = "//" + raw
+	}
+
+	parsed, err := url.Parse(raw)
+	if err != nil {
+		return
+	}
+
+	extension = pathExtension(parsed.Path)
+
+	return
+}
+
+// normalizeExtensions returns extensions lowercased and with leading dot, without empty
+// ones.
+func normalizeExtensions(extensions []string) (normalized []string) {
+	for _, extension := range extensions {
+		extension = strings.ToLower(strings.TrimPrefix(extension, "."))
+
+		if extension == "" {
+			continue
+		}
+
+		if extension = "." + extension; !slices.Contains(normalized, extension) {
+			normalized = append(normalized, extension)
+		}
+	}
+
+	return
+}
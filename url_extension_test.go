@@ -0,0 +1,42 @@
+package url_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	hqgourl "go.source.hueristiq.com/url"
+)
+
+func TestURL_Extension(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		raw         string
+		extension   string
+		contentType string
+	}{
+		{"https://example.com/static/App.JS?v=2", ".js", "text/javascript"},
+		{"https://example.com/api/users.json", ".json", "application/json"},
+		{"https://example.com/logo.png#top", ".png", "image/png"},
+		{"https://example.com/archive.tar.gz", ".gz", "application/gzip"},
+		{"https://example.com/file.unknownext", ".unknownext", ""},
+		{"https://example.com/v1.2/users", "", ""},
+		{"https://example.com/docs/", "", ""},
+		{"https://example.com/dot.", "", ""},
+		{"https://example.com", "", ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.raw, func(t *testing.T) {
+			t.Parallel()
+
+			parsed, err := hqgourl.NewParser().Parse(tt.raw)
+
+			require.NoError(t, err)
+
+			assert.Equal(t, tt.extension, parsed.Extension())
+			assert.Equal(t, tt.contentType, parsed.GuessContentType())
+		})
+	}
+}
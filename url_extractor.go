@@ -1,8 +1,13 @@
 package url
 
 import (
+	"fmt"
+	"log/slog"
 	"regexp"
 	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
 	"unicode/utf8"
 
 	"go.source.hueristiq.com/url/schemes"
@@ -14,59 +19,182 @@ import (
 // It provides options for controlling whether URL schemes and hosts are mandatory,
 // and allows custom regular expression patterns to be specified for these components.
 // This allows fine-grained control over the types of URLs that are extracted from text.
+//
+// An Extractor is safe for concurrent use once configured: the regex (or scanner) used
+// by Extract is compiled on first use and read-only afterwards. Options must not be
+// applied once extraction has started; Apply reports an error if they are, and Compile
+// returns a CompiledExtractor whose configuration cannot change at all.
 type Extractor struct {
-	withScheme        bool   // Specifies if a scheme (e.g., http) is mandatory in extracted URLs.
-	withSchemePattern string // A custom regex pattern for matching URL schemes (optional).
-	withHost          bool   // Specifies if a host (e.g., domain) is mandatory in extracted URLs.
-	withHostPattern   string // A custom regex pattern for matching URL hosts (optional).
+	withScheme        bool                 // Specifies if a scheme (e.g., http) is mandatory in extracted URLs.
+	withSchemePattern string               // A custom regex pattern for matching URL schemes (optional).
+	withHost          bool                 // Specifies if a host (e.g., domain) is mandatory in extracted URLs.
+	withHostPattern   string               // A custom regex pattern for matching URL hosts (optional).
+	knownTLDOnly      bool                 // Specifies if hosts of URLs with a scheme must end with a known TLD.
+	withoutUserInfo   bool                 // Specifies if userinfo (e.g., "user:pass@") is excluded from authorities.
+	withoutIPv4Hosts  bool                 // Specifies if IPv4 addresses are not matched as hosts.
+	withoutIPv6Hosts  bool                 // Specifies if bracketed IPv6 addresses are not matched as hosts.
+	obfuscatedIPs     bool                 // Specifies if obfuscated IPv4 addresses are matched and normalized.
+	withoutFilePaths  bool                 // Specifies if relative matches that look like local file paths are dropped.
+	hostSpoofing      bool                 // Specifies if matches whose userinfo looks like a host are flagged.
+	withoutSpoofing   bool                 // Specifies if matches whose userinfo looks like a host are dropped.
+	validateDarknet   bool                 // Specifies if malformed onion and I2P base32 addresses are dropped.
+	privateUseChars   bool                 // Specifies if Unicode private-use characters are allowed in paths.
+	emojiDomains      bool                 // Specifies if emoji are allowed in the labels of domains.
+	idnForms          bool                 // Specifies if the canonical forms of internationalized hosts are reported.
+	intentFallbacks   bool                 // Specifies if the fallback URLs of intent URIs are surfaced as matches.
+	schemeRelative    bool                 // Specifies if scheme-relative URLs (e.g., "//example.com/path") are matched.
+	lowercase         bool                 // Specifies if the schemes and hosts of matches are lowercased.
+	normalization     UnicodeNormalization // The Unicode normalization form of matches (none by default).
+	stripInvisible    bool                 // Specifies if invisible characters (e.g., ZWSP) are stripped from the input.
+	withExtensions    []string             // The path extensions of the matches to keep (optional).
+	withoutExtensions []string             // The path extensions of the matches to drop (optional).
+	bracketDepth      int                  // The maximum nesting depth of brackets in paths (optional).
+	withoutBrackets   bool                 // Specifies if brackets in paths are not matched as balanced pairs.
+	bracketStrategy   BracketStrategy      // How brackets in paths are handled (balanced by default).
+	engine            Engine               // The engine used by Extract (regex by default).
+	chunkSize         int                  // The chunk size used by chunked extraction (optional).
+	chunkOverlap      int                  // The chunk overlap used by chunked extraction (optional).
+	metrics           Metrics              // Receives the counters and durations of extractions (optional).
+	logger            *slog.Logger         // Receives the filter decisions, at debug level (optional).
+	terminators       string               // The non-ASCII characters ending URLs (e.g., "，").
+
+	regex          *regexp.Regexp // The compiled regex, cached on first use by Extract.
+	regexOnce      sync.Once
+	scanner        *scanner // The scanner, built on first use by Extract with ScannerEngine.
+	scannerOnce    sync.Once
+	recognizer     *recognizer // The scheme and authority recognizer, built on first use by the scanner or classify.
+	recognizerOnce sync.Once
+	compiled       atomic.Bool // Whether the regex or the scanner has been built.
 }
 
+// Engine identifies the engine an Extractor uses to find matches in Extract.
+type Engine int
+
+const (
+	// RegexEngine extracts matches using the composite regular expression built by
+	// CompileRegex. It is the default and the reference behavior.
+	RegexEngine Engine = iota
+	// ScannerEngine extracts matches by tokenizing the input and applying hand-written
+	// recognizers for schemes, hosts and TLDs. It is several times faster than RegexEngine
+	// on large inputs, but recognizes at most one match per whitespace-delimited token.
+	ScannerEngine
+)
+
+// BracketStrategy identifies how an Extractor handles brackets ("()", "[]" and "{}") in
+// the paths of URLs, which may belong to the URL (e.g.,
+// "https://en.wikipedia.org/wiki/Go_(programming_language)") or to the surrounding text
+// (e.g., "(see https://example.com)").
+type BracketStrategy int
+
+const (
+	// BalancedBrackets matches brackets in balanced pairs, so that a closing bracket ends
+	// a URL only if it closes a bracket opened in it. It is the default; the nesting depth
+	// can be set with ExtractorWithBracketDepth.
+	BalancedBrackets BracketStrategy = iota
+	// GreedyBrackets matches brackets as any other character of paths, so that URLs
+	// include closing brackets following them, e.g., in corpora of bare URLs.
+	GreedyBrackets
+	// StrictBrackets never matches brackets in paths, so that URLs end at the first
+	// bracket, e.g., in corpora where URLs are commonly enclosed in brackets.
+	StrictBrackets
+)
+
+// UnicodeNormalization identifies the Unicode normalization form an Extractor converts the
+// values of matches to, so that visually identical IRIs (e.g., with a precomposed "é" or
+// an "e" followed by a combining acute accent) are bytewise identical.
+type UnicodeNormalization int
+
+const (
+	// NoUnicodeNormalization leaves the values of matches as they are in the input. It is
+	// the default.
+	NoUnicodeNormalization UnicodeNormalization = iota
+	// NFCNormalization converts the values of matches to Normalization Form C (canonical
+	// composition), which only merges canonically equivalent sequences.
+	NFCNormalization
+	// NFKCNormalization converts the values of matches to Normalization Form KC
+	// (compatibility composition), which also merges compatibility equivalents (e.g.,
+	// fullwidth "ｅ" and "e", or the ligature "ﬁ" and "fi"), for aggressive deduplication.
+	NFKCNormalization
+)
+
 // CompileRegex constructs and compiles a regular expression based on the Extractor configuration.
 // It builds a regex pattern that can capture various forms of URLs, including those with or without
 // schemes and hosts. The method also supports custom patterns provided by the user, ensuring that the
 // longest possible match for a URL is found, improving accuracy in URL extraction.
 func (e *Extractor) CompileRegex() (regex *regexp.Regexp) {
-	// Set the default scheme pattern or use the user-specified one.
-	schemePattern := ExtractorSchemePattern
+	// Compiling the final regex pattern.
+	regex = regexp.MustCompile(e.Pattern())
 
-	if e.withScheme && e.withSchemePattern != "" {
-		schemePattern = e.withSchemePattern
+	// Ensures the longest possible match is found.
+	regex.Longest()
+
+	return
+}
+
+// CompileRegexTo compiles the composite pattern of the Extractor (see Pattern) with a
+// custom regular expression engine, e.g. the constructor of an RE2 or PCRE binding.
+//
+// Example:
+//
+//	regex, err := hqgourl.CompileRegexTo(extractor, regexp.Compile) // Or the constructor of a binding.
+//
+// Parameters:
+//   - e (*Extractor): The Extractor whose pattern is compiled.
+//   - compile (func(string) (R, error)): The function compiling a pattern with the engine.
+//
+// Returns:
+//   - regex (R): The compiled regular expression.
+//   - err (error): ErrInvalidPattern (wrapped) if a custom pattern is invalid or compile fails.
+func CompileRegexTo[R any](e *Extractor, compile func(pattern string) (R, error)) (regex R, err error) {
+	if err = e.Validate(); err != nil {
+		return
 	}
 
-	// Separate ASCII TLDs from Unicode TLDs for the regular expression.
-	var asciiTLDs, unicodeTLDs []string
+	if regex, err = compile(e.Pattern()); err != nil {
+		err = fmt.Errorf("%w: %w", ErrInvalidPattern, err)
+	}
 
-	for i, tld := range tlds.Official {
-		if tld[0] >= utf8.RuneSelf {
-			asciiTLDs = tlds.Official[:i:i]
-			unicodeTLDs = tlds.Official[i:]
+	return
+}
 
-			break
+// Validate checks that the custom scheme and host patterns, if any, are valid regular
+// expressions, so that configuration errors can be reported instead of causing
+// CompileRegex to panic.
+//
+// Returns:
+//   - err (error): ErrInvalidPattern (wrapped) if a custom pattern does not compile, or nil.
+func (e *Extractor) Validate() (err error) {
+	for _, pattern := range []string{e.withSchemePattern, e.withHostPattern} {
+		if err = validatePattern(pattern); err != nil {
+			return
 		}
 	}
 
-	// Define regular expression components for known TLDs and domains.
-	punycode := `xn--[a-z0-9-]+`
-	knownTLDPattern := `(?:(?i)` + punycode + `|` + anyOf(append(asciiTLDs, tlds.Pseudo...)...) + `\b|` + anyOf(unicodeTLDs...) + `)`
-	domainPattern := `(?:` + _subdomainPattern + knownTLDPattern + `|localhost)`
-
-	// Host and authority patterns for matching URLs with optional ports.
-	hostWithoutPortPattern := `(?:` + domainPattern + `|\[` + ExtractorIPv6Pattern + `\]|\b` + ExtractorIPv4Pattern + `\b)`
-	hostWithPortOptionalPattern := `(?:` + hostWithoutPortPattern + ExtractorPortOptionalPattern + `)`
+	return
+}
 
-	if e.withHost && e.withHostPattern != "" {
-		hostWithPortOptionalPattern = e.withHostPattern
-	}
+// Pattern returns the composite regular expression pattern compiled by CompileRegex, so it
+// can be fed to other regular expression engines (e.g., RE2 or PCRE bindings, or
+// Hyperscan) for high-throughput deployments; see also CompileRegexTo. The pattern uses
+// the RE2 syntax of the regexp package (e.g., "\x{E000}" escapes, "\p{L}" classes, inline
+// "(?i)" flags and "(?P<name>...)" groups), and is meant to be matched with leftmost-longest
+// semantics, like the regex returned by CompileRegex: engines preferring the leftmost
+// alternative may return shorter matches.
+//
+// Returns:
+//   - pattern (string): The composite pattern.
+func (e *Extractor) Pattern() (pattern string) {
+	schemePattern := e.SchemePattern()
 
-	// Authority patterns for matching URLs with optional user info and host.
-	_IAuthorityPattern := `(?:` + _IUserInfoOptionalPattern + hostWithPortOptionalPattern + `)`
+	_IAuthorityPattern := e.AuthorityPattern()
 	_IAuthorityOptionalPattern := _IAuthorityPattern + `?`
 
+	pathCont := e.PathPattern()
+
 	// Define patterns for different types of URLs.
 	webURL := _IAuthorityPattern + `(?:/` + pathCont + `|/)?`
 
-	// Emails pattern.
-	email := `(?P<relaxedEmail>[a-zA-Z0-9._%\-+]+@` + hostWithPortOptionalPattern + `)`
+	email := `(?P<relaxedEmail>` + _emailLocalPartPattern + `@` + e.HostPattern() + `)`
 
 	URLsWithSchemePattern := schemePattern + _IAuthorityOptionalPattern + pathCont
 
@@ -75,14 +203,29 @@ func (e *Extractor) CompileRegex() (regex *regexp.Regexp) {
 		URLsWithSchemePattern = schemePattern + _IAuthorityPattern + `(?:/` + pathCont + `|/)?`
 	}
 
+	// Require a known host after schemes with an authority. With the default scheme pattern,
+	// no-authority schemes (e.g., "tel:") are left as they are, since they have no host.
+	if e.knownTLDOnly && e.withHostPattern == "" {
+		URLsWithSchemePattern = schemePattern + _IAuthorityPattern + `(?:/` + pathCont + `|/)?`
+
+		if schemePattern == ExtractorSchemePattern {
+			URLsWithSchemePattern = `(?:[a-zA-Z][a-zA-Z.\-+]*://` + _IAuthorityPattern + `(?:/` + pathCont + `|/)?|` +
+				ExtractorKnownNoAuthoritySchemePattern + _IAuthorityOptionalPattern + pathCont + `)`
+		}
+	}
+
 	// Combine various URL matching patterns for full URL extraction.
 	URLsWithHostPattern := webURL + `|` + email
 
-	RelativeURLsPattern := `(\/[\w\/?=&#.-]*)|([\w\/?=&#.-]+?(?:\/[\w\/?=&#.-]+)+)`
+	// Scheme-relative URLs (e.g., "//example.com/path") are matched whatever the
+	// requirements on schemes, since they are resolved against the scheme of their page.
+	if e.schemeRelative {
+		URLsWithSchemePattern += `|//` + webURL
+	}
 
-	// Select the final pattern based on the configuration.
-	var pattern string
+	RelativeURLsPattern := _relativeURLsPattern
 
+	// Select the final pattern based on the configuration.
 	switch {
 	case e.withScheme:
 		pattern = URLsWithSchemePattern
@@ -92,11 +235,352 @@ func (e *Extractor) CompileRegex() (regex *regexp.Regexp) {
 		pattern = URLsWithSchemePattern + `|` + URLsWithHostPattern + `|` + RelativeURLsPattern
 	}
 
-	// Compiling the final regex pattern.
-	regex = regexp.MustCompile(pattern)
+	return
+}
 
-	// Ensures the longest possible match is found.
-	regex.Longest()
+// SchemePattern returns the sub-expression matching schemes (including their trailing
+// ":" or "://"): ExtractorSchemePattern, or the pattern configured with
+// ExtractorWithSchemePattern or ExtractorWithKnownSchemes.
+//
+// Like the other sub-pattern getters, it lets advanced users compose their own regular
+// expressions from the ones the Extractor builds, e.g.:
+//
+//	regex := regexp.MustCompile(`(?i)(?:src|href)=["']?(` + extractor.SchemePattern() + extractor.AuthorityPattern() + `)`)
+//
+// Returns:
+//   - pattern (string): The scheme sub-expression.
+func (e *Extractor) SchemePattern() (pattern string) {
+	pattern = ExtractorSchemePattern
+
+	if e.withScheme && e.withSchemePattern != "" {
+		pattern = e.withSchemePattern
+	}
+
+	return
+}
+
+// HostPattern returns the sub-expression matching hosts with an optional port: domains
+// with a known TLD, "localhost", bracketed IPv6 and IPv4 addresses, or the pattern
+// configured with ExtractorWithHostPattern.
+//
+// Returns:
+//   - pattern (string): The host sub-expression.
+func (e *Extractor) HostPattern() (pattern string) {
+	if e.withHost && e.withHostPattern != "" {
+		pattern = e.withHostPattern
+
+		return
+	}
+
+	// Separate ASCII TLDs from Unicode TLDs for the regular expression.
+	var asciiTLDs, unicodeTLDs []string
+
+	for i, tld := range tlds.Official {
+		if tld[0] >= utf8.RuneSelf {
+			asciiTLDs = tlds.Official[:i:i]
+			unicodeTLDs = tlds.Official[i:]
+
+			break
+		}
+	}
+
+	// Define regular expression components for known TLDs and domains.
+	punycode := `xn--[a-z0-9-]+`
+	knownTLDPattern := `(?:(?i)` + punycode + `|` + anyOf(append(asciiTLDs, tlds.Pseudo...)...) + `\b|` + anyOf(unicodeTLDs...) + `)`
+	domainPattern := `(?:` + subdomainPattern(e.emojiDomains) + knownTLDPattern + `|localhost)`
+
+	// Host and authority patterns for matching URLs with optional ports.
+	hostWithoutPortPattern := `(?:` + domainPattern
+
+	if !e.withoutIPv6Hosts {
+		hostWithoutPortPattern += `|\[` + ExtractorIPv6Pattern + `\]`
+	}
+
+	if !e.withoutIPv4Hosts {
+		hostWithoutPortPattern += `|\b` + ExtractorIPv4Pattern + `\b`
+	}
+
+	if e.obfuscatedIPs {
+		hostWithoutPortPattern += `|\b` + ExtractorObfuscatedIPv4Pattern + `\b`
+	}
+
+	hostWithoutPortPattern += `)`
+
+	pattern = `(?:` + hostWithoutPortPattern + ExtractorPortOptionalPattern + `)`
+
+	return
+}
+
+// AuthorityPattern returns the sub-expression matching authorities: an optional userinfo
+// (unless ExtractorWithoutUserInfo is set) followed by the host sub-expression.
+//
+// Returns:
+//   - pattern (string): The authority sub-expression.
+func (e *Extractor) AuthorityPattern() (pattern string) {
+	userInfoOptionalPattern := _IUserInfoOptionalPattern
+
+	if e.withoutUserInfo {
+		userInfoOptionalPattern = ``
+	}
+
+	pattern = `(?:` + userInfoOptionalPattern + e.HostPattern() + `)`
+
+	return
+}
+
+// PathPattern returns the sub-expression matching the path, query and fragment following
+// an authority or scheme, with balanced brackets (see ExtractorWithBracketDepth).
+//
+// Returns:
+//   - pattern (string): The path sub-expression.
+func (e *Extractor) PathPattern() (pattern string) {
+	pattern = pathContPattern(e.privateUseChars, e.bracketStrategy, e.brackets(), terminatorRunes(e.terminators))
+
+	return
+}
+
+// EmailPattern returns the sub-expression matching email addresses: a local part of ASCII
+// and UTF-8 letters, marks and numbers, as permitted for internationalized addresses by
+// RFC 6531, followed by "@" and the host sub-expression.
+//
+// Returns:
+//   - pattern (string): The email sub-expression.
+func (e *Extractor) EmailPattern() (pattern string) {
+	pattern = `(?:` + _emailLocalPartPattern + `@` + e.HostPattern() + `)`
+
+	return
+}
+
+// Extract finds all URLs in text and returns them as matches, in the order in which
+// they appear. The compiled regex (or scanner, depending on the configured engine)
+// is built on the first call and reused afterwards.
+//
+// Parameters:
+//   - text (string): The text to extract URLs from.
+//
+// Returns:
+//   - matches ([]Match): The matches found in text.
+func (e *Extractor) Extract(text string) (matches []Match) {
+	if e.metrics != nil {
+		defer func(start time.Time) { e.observeExtraction(start, len(text), matches) }(time.Now())
+	}
+
+	matches = e.extract(text)
+
+	return
+}
+
+// extract finds all URLs in text, without reporting to the Extractor's metrics.
+func (e *Extractor) extract(text string) (matches []Match) {
+	if e.stripInvisible && strings.ContainsFunc(text, isInvisible) {
+		matches = e.extractStripped(text)
+
+		return
+	}
+
+	matches = e.find(text)
+
+	return
+}
+
+// find finds all URLs in text with the Extractor's engine.
+func (e *Extractor) find(text string) (matches []Match) {
+	if e.engine == ScannerEngine {
+		matches = e.filter(text, e.compiledScanner().scan(text))
+
+		return
+	}
+
+	for _, loc := range e.compiledRegex().FindAllStringIndex(text, -1) {
+		value := text[loc[0]:loc[1]]
+
+		matches = append(matches, Match{
+			Value: value,
+			Start: loc[0],
+			End:   loc[1],
+			Type:  e.classify(value),
+		})
+	}
+
+	matches = e.filter(text, matches)
+
+	return
+}
+
+// compiledRegex returns the regex used by Extract, compiling it on first use.
+func (e *Extractor) compiledRegex() *regexp.Regexp {
+	e.regexOnce.Do(func() {
+		e.regex = e.CompileRegex()
+
+		e.compiled.Store(true)
+	})
+
+	return e.regex
+}
+
+// compiledScanner returns the scanner used by Extract, building it on first use.
+func (e *Extractor) compiledScanner() *scanner {
+	e.scannerOnce.Do(func() {
+		e.scanner = newScanner(e)
+
+		e.compiled.Store(true)
+	})
+
+	return e.scanner
+}
+
+// compiledRecognizer returns the recognizer of schemes and authorities, building it on
+// first use. Unlike the scanner, it is also used with RegexEngine, so building it doesn't
+// mark the Extractor as compiled.
+func (e *Extractor) compiledRecognizer() *recognizer {
+	e.recognizerOnce.Do(func() {
+		e.recognizer = newRecognizer(e)
+	})
+
+	return e.recognizer
+}
+
+// classify determines the MatchType of a value matched by the compiled regex.
+func (e *Extractor) classify(value string) (matchType MatchType) {
+	recognizer := e.compiledRecognizer()
+
+	scheme := recognizer.schemeRegex.FindStringIndex(value)
+
+	if e.schemeRelative && strings.HasPrefix(value, "//") {
+		if authority, _ := recognizer.authorityLength(value[2:]); authority > 0 {
+			matchType = MatchTypeSchemeRelative
+
+			return
+		}
+	}
+
+	switch {
+	case e.withScheme, scheme != nil && scheme[0] == 0:
+		matchType = MatchTypeURL
+	case strings.HasPrefix(value, "/"):
+		matchType = MatchTypeRelative
+	default:
+		authority, isEmail := recognizer.authorityLength(value)
+
+		switch {
+		case isEmail && authority == len(value):
+			matchType = MatchTypeEmail
+		case authority > 0:
+			matchType = MatchTypeHost
+		default:
+			matchType = MatchTypeRelative
+		}
+	}
+
+	return
+}
+
+// subdomainPattern returns the pattern of the labels of a domain preceding its TLD,
+// optionally allowing emoji in labels.
+func subdomainPattern(emoji bool) (pattern string) {
+	if !emoji {
+		pattern = _subdomainPattern
+
+		return
+	}
+
+	pattern = `(?:` + labelPattern(emoji) + `\.)+`
+
+	return
+}
+
+// labelPattern returns the pattern of a label of a domain, optionally allowing emoji.
+func labelPattern(emoji bool) (pattern string) {
+	if !emoji {
+		pattern = _IRICharctersPattern
+
+		return
+	}
+
+	chars := _letter + _mark + _number + _emojiCharacters
+
+	pattern = `[` + chars + `](?:[` + chars + `\-]*[` + chars + `])?`
+
+	return
+}
+
+// brackets returns the maximum nesting depth of balanced brackets matched in paths, or 0
+// if brackets are not matched as balanced pairs.
+func (e *Extractor) brackets() (depth int) {
+	switch {
+	case e.withoutBrackets, e.bracketStrategy != BalancedBrackets:
+		depth = 0
+	case e.bracketDepth > 0:
+		depth = e.bracketDepth
+	default:
+		depth = defaultBracketDepth
+	}
+
+	return
+}
+
+// pathContPattern returns the pattern of the path, query and fragment following an
+// authority or scheme, optionally allowing Unicode private-use characters, and without the
+// (non-ASCII) terminators. Brackets are handled following strategy: with BalancedBrackets,
+// well-balanced brackets are matched nested up to depth levels (none if depth is 0).
+func pathContPattern(privateUseChars bool, strategy BracketStrategy, depth int, terminators []rune) (pattern string) {
+	mid, end := midIChar, endIChar
+
+	switch strategy {
+	case GreedyBrackets:
+		mid += `\[\]\{\}`
+		end += `\)\]\}`
+	case StrictBrackets:
+		mid = strings.Replace(mid, `\(\)`, ``, 1)
+	case BalancedBrackets:
+	}
+
+	if len(terminators) > 0 {
+		mid = strings.Replace(mid, unicodes.AllowedUcsChar, withoutTerminators(unicodes.AllowedUcsCharTable(), terminators), 1)
+		end = strings.Replace(end, unicodes.AllowedUcsCharMinusPunc, withoutTerminators(unicodes.AllowedUcsCharMinusPuncTable(), terminators), 1)
+	}
+
+	if privateUseChars {
+		mid += _IPrivateCharacters
+		end += _IPrivateCharacters
+	}
+
+	if depth == 0 {
+		pattern = `(?:[` + mid + `]*[` + end + `])+`
+
+		return
+	}
+
+	wellAll := wellBracketed(`\(`, `\)`, mid, depth) + `|` +
+		wellBracketed(`\[`, `\]`, mid, depth) + `|` +
+		wellBracketed(`\{`, `\}`, mid, depth)
+
+	pattern = `(?:[` + mid + `]*(?:` + wellAll + `|[` + end + `]))+`
+
+	return
+}
+
+// wellBracketed returns the pattern of a pair of brackets enclosing characters in mid
+// and pairs of the same brackets, nested up to depth levels.
+func wellBracketed(opening, closing, mid string, depth int) (pattern string) {
+	pattern = opening + `[` + mid + `]*` + closing
+
+	for range depth - 1 {
+		pattern = opening + `(?:[` + mid + `]|` + pattern + `)*` + closing
+	}
+
+	return
+}
+
+// validatePattern checks that pattern, if not empty, is a valid regular expression.
+func validatePattern(pattern string) (err error) {
+	if pattern == "" {
+		return
+	}
+
+	if _, err = regexp.Compile(pattern); err != nil {
+		err = fmt.Errorf("%w: %w", ErrInvalidPattern, err)
+	}
 
 	return
 }
@@ -104,6 +588,11 @@ func (e *Extractor) CompileRegex() (regex *regexp.Regexp) {
 // ExtractorOptionFunc defines a function type for configuring Extractor instances.
 // It allows users to pass options that modify the behavior of the Extractor, such as whether
 // to include schemes or hosts in URL extraction.
+//
+// Options should only be applied through NewExtractor or Extractor.Apply. An option called
+// directly (opt(e)) bypasses the check of Apply: on an Extractor that has already extracted,
+// it changes the configuration without rebuilding the cached regex or scanner, so it may be
+// partially ignored, and it races with concurrent calls to Extract.
 type ExtractorOptionFunc func(*Extractor)
 
 // ExtractorInterface defines the interface that Extractor should implement.
@@ -112,6 +601,23 @@ type ExtractorInterface interface {
 	CompileRegex() (regex *regexp.Regexp)
 }
 
+// PatternValidator defines the interface of extractors that can report custom patterns
+// that don't compile, before they are used. It is separate from ExtractorInterface and
+// DomainExtractorInterface so that existing implementations of those keep satisfying them.
+type PatternValidator interface {
+	Validate() (err error)
+}
+
+// MatchExtractor extends ExtractorInterface with Extract, which finds matches whatever the
+// engine, and Validate. It is separate so that existing implementations of
+// ExtractorInterface keep satisfying it.
+type MatchExtractor interface {
+	ExtractorInterface
+	PatternValidator
+
+	Extract(text string) (matches []Match)
+}
+
 const (
 	_alphaCharacterSet          = `a-zA-Z`
 	_digitCHaracterSet          = `0-9`
@@ -129,13 +635,8 @@ const (
 
 	_IPrivateCharacters = `\x{E000}-\x{F8FF}\x{F0000}-\x{FFFFD}\x{100000}-\x{10FFFD}`
 
-	midIChar  = `/?#\\` + midIPathSegmentChar + _IPrivateCharacters
-	endIChar  = `/#` + endIPathSegmentChar + _IPrivateCharacters
-	wellParen = `\((?:[` + midIChar + `]|\([` + midIChar + `]*\))*\)`
-	wellBrack = `\[(?:[` + midIChar + `]|\[[` + midIChar + `]*\])*\]`
-	wellBrace = `\{(?:[` + midIChar + `]|\{[` + midIChar + `]*\})*\}`
-	wellAll   = wellParen + `|` + wellBrack + `|` + wellBrace
-	pathCont  = `(?:[` + midIChar + `]*(?:` + wellAll + `|[` + endIChar + `]))+`
+	midIChar = `/?#\\` + midIPathSegmentChar
+	endIChar = `/#` + endIPathSegmentChar
 
 	_letter              = `\p{L}`
 	_mark                = `\p{M}`
@@ -143,6 +644,17 @@ const (
 	_IRICharctersPattern = `[` + _letter + _mark + _number + `](?:[` + _letter + _mark + _number + `\-]*[` + _letter + _mark + _number + `])?`
 
 	_subdomainPattern = `(?:` + _IRICharctersPattern + `\.)+`
+
+	// _emojiCharacters are the emoji ranges (pictographs, dingbats, symbols, regional
+	// indicators and skin tone modifiers) and the zero width joiner of emoji sequences;
+	// variation selectors are marks.
+	_emojiCharacters = `\x{00A9}\x{00AE}\x{203C}\x{2049}\x{2122}\x{2139}\x{2194}-\x{21AA}\x{231A}-\x{23FF}` +
+		`\x{24C2}\x{25AA}-\x{27BF}\x{2934}\x{2935}\x{2B05}-\x{2B55}\x{3030}\x{303D}\x{3297}\x{3299}` +
+		`\x{1F000}-\x{1FAFF}\x{200D}`
+
+	_relativeURLsPattern = `(\/[\w\/?=&#.-]*)|([\w\/?=&#.-]+?(?:\/[\w\/?=&#.-]+)+)`
+
+	_emailLocalPartPattern = `[a-zA-Z0-9._%\-+` + _letter + _mark + _number + `]+`
 )
 
 var (
@@ -187,12 +699,32 @@ var (
 	// or whether it requires an authority component.
 	ExtractorKnownSchemePattern = `(?:(?i)(?:` + anyOf(schemes.Official...) + `|` + anyOf(schemes.Unofficial...) + `)://|` + anyOf(schemes.NoAuthority...) + `:)`
 
+	// ExtractorWebSocketSchemePattern defines a case-insensitive pattern for matching the
+	// schemes of WebSocket endpoints in schemes.WebSocket (e.g., "ws" and "wss"), followed
+	// by "://".
+	//
+	// This pattern is useful for mining WebSocket endpoints from JavaScript bundles.
+	ExtractorWebSocketSchemePattern = `(?:(?i)` + anyOf(schemes.WebSocket...) + `://)`
+
+	// ExtractorMobileSchemePattern defines a case-insensitive pattern for matching the
+	// deep-link schemes of popular mobile apps in schemes.Mobile (e.g., "intent", "fb" or
+	// "whatsapp"), followed by "://".
+	//
+	// This pattern is useful for mining deep links from APK and IPA string dumps.
+	ExtractorMobileSchemePattern = `(?:(?i)` + anyOf(schemes.Mobile...) + `://)`
+
 	// ExtractorIPv4Pattern defines a pattern for matching valid IPv4 addresses.
 	// It matches four groups of 1 to 3 digits (0-255) separated by periods (e.g., "192.168.0.1").
 	//
 	// This pattern is essential for extracting or validating IPv4 addresses in URLs or hostnames.
 	ExtractorIPv4Pattern = `(?:25[0-5]|2[0-4][0-9]|1[0-9]{2}|[1-9][0-9]|[0-9])\.(?:25[0-5]|2[0-4][0-9]|1[0-9]{2}|[1-9][0-9]|[0-9])\.(?:25[0-5]|2[0-4][0-9]|1[0-9]{2}|[1-9][0-9]|[0-9])\.(?:25[0-5]|2[0-4][0-9]|1[0-9]{2}|[1-9][0-9]|[0-9])`
 
+	// ExtractorObfuscatedIPv4Pattern defines a pattern for matching IPv4 addresses in the
+	// alternative forms accepted by inet_aton(3) (e.g., "0x7f.0.0.1" or "2130706433"): one
+	// to four dot-separated parts in decimal, octal or hexadecimal. It also matches plain
+	// numbers; see ObfuscatedIPv4 for the validation of matched addresses.
+	ExtractorObfuscatedIPv4Pattern = `(?:0[xX][0-9a-fA-F]{1,8}|[0-9]{1,11})(?:\.(?:0[xX][0-9a-fA-F]{1,8}|[0-9]{1,11})){0,3}`
+
 	// ExtractorNonEmptyIPv6Pattern defines a detailed pattern for matching valid, non-empty IPv6 addresses.
 	// It accounts for various valid formats of IPv6 addresses, including those with elisions ("::") and IPv4
 	// address representations.
@@ -236,14 +768,27 @@ var (
 	ExtractorPortOptionalPattern = ExtractorPortPattern + `?`
 )
 
-// Ensure that Extractor implements the ExtractorInterface.
-var _ ExtractorInterface = &Extractor{}
+// defaultBracketDepth is the maximum nesting depth of the balanced brackets matched in
+// paths, unless configured otherwise.
+const defaultBracketDepth = 2
+
+// Ensure that Extractor implements the MatchExtractor.
+var _ MatchExtractor = &Extractor{}
+
+// ExtractorPrivateUseCharsDefault is whether Extractors created by NewExtractor allow
+// Unicode private-use characters (U+E000-U+F8FF and planes 15 and 16) in paths, as RFC
+// 3987 does in queries, unless configured otherwise with ExtractorWithPrivateUseChars or
+// ExtractorWithoutPrivateUseChars. Private-use characters are a red flag in most security
+// contexts, where this can be set to false once, before creating Extractors.
+var ExtractorPrivateUseCharsDefault = true
 
 // NewExtractor creates a new Extractor instance with optional configuration.
 // The options can be used to customize how URLs are extracted, such as whether
 // to include URL schemes or hosts.
 func NewExtractor(opts ...ExtractorOptionFunc) (extractor *Extractor) {
-	extractor = &Extractor{}
+	extractor = &Extractor{
+		privateUseChars: ExtractorPrivateUseCharsDefault,
+	}
 
 	for _, opt := range opts {
 		opt(extractor)
@@ -252,6 +797,15 @@ func NewExtractor(opts ...ExtractorOptionFunc) (extractor *Extractor) {
 	return
 }
 
+// NewWebSocketExtractor creates a new Extractor instance preset to extract WebSocket
+// endpoints (e.g., "wss://example.com/socket"), as configured by
+// ExtractorWithWebSocketSchemes. Further options are applied after the preset.
+func NewWebSocketExtractor(opts ...ExtractorOptionFunc) (extractor *Extractor) {
+	extractor = NewExtractor(append([]ExtractorOptionFunc{ExtractorWithWebSocketSchemes()}, opts...)...)
+
+	return
+}
+
 // ExtractorWithScheme returns an option function that configures the Extractor
 // to require URL schemes in the extraction process.
 func ExtractorWithScheme() ExtractorOptionFunc {
@@ -269,6 +823,35 @@ func ExtractorWithSchemePattern(pattern string) ExtractorOptionFunc {
 	}
 }
 
+// ExtractorWithKnownSchemes returns an option function that configures the Extractor to
+// require one of the known schemes: the official and unofficial ones, including those
+// registered with schemes.RegisterUnofficial before the option is applied, followed by
+// "://", or a no-authority scheme followed by ":". It is equivalent to
+// ExtractorWithSchemePattern(ExtractorKnownSchemePattern), except that runtime
+// registrations are picked up.
+func ExtractorWithKnownSchemes() ExtractorOptionFunc {
+	return func(e *Extractor) {
+		e.withScheme = true
+		e.withSchemePattern = `(?:(?i)(?:` + anyOf(schemes.Official...) + `|` + anyOf(schemes.UnofficialSchemes()...) + `)://|` + anyOf(schemes.NoAuthority...) + `:)`
+	}
+}
+
+// ExtractorWithWebSocketSchemes returns an option function that configures the Extractor
+// to require the scheme of a WebSocket endpoint, one of schemes.WebSocket (e.g., "ws" or
+// "wss"), followed by "://". It is equivalent to
+// ExtractorWithSchemePattern(ExtractorWebSocketSchemePattern).
+func ExtractorWithWebSocketSchemes() ExtractorOptionFunc {
+	return ExtractorWithSchemePattern(ExtractorWebSocketSchemePattern)
+}
+
+// ExtractorWithMobileSchemes returns an option function that configures the Extractor to
+// require the deep-link scheme of a popular mobile app, one of schemes.Mobile (e.g.,
+// "intent" or "fb"), followed by "://". See ParseIntent to decode extracted intent URIs.
+// It is equivalent to ExtractorWithSchemePattern(ExtractorMobileSchemePattern).
+func ExtractorWithMobileSchemes() ExtractorOptionFunc {
+	return ExtractorWithSchemePattern(ExtractorMobileSchemePattern)
+}
+
 // ExtractorWithHost returns an option function that configures the Extractor
 // to require URL hosts in the extraction process.
 func ExtractorWithHost() ExtractorOptionFunc {
@@ -286,23 +869,345 @@ func ExtractorWithHostPattern(pattern string) ExtractorOptionFunc {
 	}
 }
 
-// anyOf is a helper function that constructs a regex pattern from a list of strings.
-// It joins the provided strings into a single regular expression, ensuring that
-// each string is properly escaped for use in regex matching.
-func anyOf(strs ...string) string {
-	var b strings.Builder
+// ExtractorWithKnownTLDOnly returns an option function that requires the host of URLs
+// with a scheme to be a known host (a domain ending with a known TLD, localhost or an IP
+// address), as it already is for URLs without a scheme. By default, anything following
+// a scheme is accepted (e.g., "https://foo.invalidtld/x"), which lets code snippets and
+// templated strings through as false positives.
+func ExtractorWithKnownTLDOnly() ExtractorOptionFunc {
+	return func(e *Extractor) {
+		e.knownTLDOnly = true
+	}
+}
 
-	b.WriteString("(?:")
+// ExtractorWithoutUserInfo returns an option function that removes userinfo (e.g.,
+// "user:pass@") from the authorities matched by the Extractor, for consumers who never
+// want credentials-style authorities. Text such as "hello:world@example.com" then no
+// longer matches as a whole. Email addresses are still matched.
+func ExtractorWithoutUserInfo() ExtractorOptionFunc {
+	return func(e *Extractor) {
+		e.withoutUserInfo = true
+	}
+}
 
-	for i, s := range strs {
-		if i != 0 {
-			b.WriteByte('|')
-		}
+// ExtractorWithoutIPv4Hosts returns an option function that configures the Extractor not
+// to match IPv4 addresses (e.g., "192.168.0.1") as hosts, for inputs where they are more
+// likely to be version numbers or other dotted numbers than URLs. Like other host
+// checks, it applies to URLs without scheme, and to URLs with a scheme only with
+// ExtractorWithKnownTLDOnly. It has no effect with a custom host pattern.
+func ExtractorWithoutIPv4Hosts() ExtractorOptionFunc {
+	return func(e *Extractor) {
+		e.withoutIPv4Hosts = true
+	}
+}
 
-		b.WriteString(regexp.QuoteMeta(s))
+// ExtractorWithoutIPv6Hosts returns an option function that configures the Extractor not
+// to match bracketed IPv6 addresses (e.g., "[2001:db8::1]") as hosts. In prose, they
+// almost never appear, while bracketed numbers such as "[2001]" (citations, years) do;
+// dropping the alternative also makes the regex smaller and faster. Like other host
+// checks, it applies to URLs without scheme, and to URLs with a scheme only with
+// ExtractorWithKnownTLDOnly. It has no effect with a custom host pattern.
+func ExtractorWithoutIPv6Hosts() ExtractorOptionFunc {
+	return func(e *Extractor) {
+		e.withoutIPv6Hosts = true
 	}
+}
 
-	b.WriteByte(')')
+// ExtractorWithObfuscatedIPs returns an option function that configures the Extractor to
+// recognize IPv4 hosts written in the alternative forms phishing URLs use to disguise them
+// (e.g., "http://0x7f.0.0.1/", "http://2130706433/" or "http://0177.0.0.01/"; see
+// ObfuscatedIPv4), and to report their dotted-quad form in the ObfuscatedIP field of
+// matches, flagging them as suspicious. URLs with a scheme are recognized in any of these
+// forms; without scheme, only forms with a hexadecimal part are, as plain numbers are far
+// more likely to be anything else.
+func ExtractorWithObfuscatedIPs() ExtractorOptionFunc {
+	return func(e *Extractor) {
+		e.obfuscatedIPs = true
+	}
+}
+
+// ExtractorWithoutFilePaths returns an option function that configures the Extractor to
+// drop relative matches that look like local file paths rather than URLs (see the FilePath
+// field of Match), e.g. "/usr/bin/env", "./run.sh", "~/docs/file.txt" or
+// "C:/Users/x/file.txt".
+func ExtractorWithoutFilePaths() ExtractorOptionFunc {
+	return func(e *Extractor) {
+		e.withoutFilePaths = true
+	}
+}
+
+// ExtractorWithHostSpoofingDetection returns an option function that configures the
+// Extractor to flag matches whose userinfo looks like a host (e.g.,
+// "https://accounts.google.com@phish.example/", which points to "phish.example") in the
+// HostSpoofing field of Match. See URL.HostSpoofing.
+func ExtractorWithHostSpoofingDetection() ExtractorOptionFunc {
+	return func(e *Extractor) {
+		e.hostSpoofing = true
+	}
+}
+
+// ExtractorWithoutHostSpoofing returns an option function that configures the Extractor
+// to drop matches whose userinfo looks like a host (see ExtractorWithHostSpoofingDetection).
+func ExtractorWithoutHostSpoofing() ExtractorOptionFunc {
+	return func(e *Extractor) {
+		e.withoutSpoofing = true
+	}
+}
+
+// ExtractorWithIntentFallbacks returns an option function that configures the Extractor to
+// surface the fallback URL embedded in Android intent URIs (the "S.browser_fallback_url"
+// extra of "intent://...#Intent;...;end", see ParseIntent) as an additional match, right
+// after the intent URI, with the IntentFallback field set. Browsers open fallback URLs
+// when no app handles the intent, which makes them a common open-redirect vector.
+func ExtractorWithIntentFallbacks() ExtractorOptionFunc {
+	return func(e *Extractor) {
+		e.intentFallbacks = true
+	}
+}
+
+// ExtractorWithSchemeRelativeURLs returns an option function that configures the Extractor
+// to match scheme-relative URLs (e.g., "//cdn.example.com/app.js"), common in HTML and
+// resolved against the scheme of their page, whether or not a scheme or a host is
+// required. Their matches have the MatchTypeSchemeRelative type.
+func ExtractorWithSchemeRelativeURLs() ExtractorOptionFunc {
+	return func(e *Extractor) {
+		e.schemeRelative = true
+	}
+}
+
+// ExtractorWithExtensions returns an option function that configures the Extractor to keep
+// only the matches whose path ends with one of the given file extensions (e.g., ".js" and
+// ".json"), for crawl triage. Extensions are case-insensitive, with or without leading
+// dot; matches without extension, such as emails, are dropped. See URL.Extension.
+//
+// Parameters:
+//   - extensions (variadic string): The extensions to keep (e.g., ".js").
+func ExtractorWithExtensions(extensions ...string) ExtractorOptionFunc {
+	return func(e *Extractor) {
+		e.withExtensions = append(e.withExtensions, normalizeExtensions(extensions)...)
+	}
+}
+
+// ExtractorWithoutExtensions returns an option function that configures the Extractor to
+// drop the matches whose path ends with one of the given file extensions (e.g., ".png" and
+// ".jpg"), for crawl triage. Extensions are case-insensitive, with or without leading dot.
+//
+// Parameters:
+//   - extensions (variadic string): The extensions to drop (e.g., ".png").
+func ExtractorWithoutExtensions(extensions ...string) ExtractorOptionFunc {
+	return func(e *Extractor) {
+		e.withoutExtensions = append(e.withoutExtensions, normalizeExtensions(extensions)...)
+	}
+}
+
+// ExtractorWithDarknetValidation returns an option function that configures the Extractor
+// to drop matches whose host is a malformed onion address (anything in ".onion" but a
+// valid Tor v3 address) or I2P base32 address (in ".b32.i2p"), as validated by the
+// darknet package. It applies to Extract and the functions built on it, not to the regex
+// returned by CompileRegex.
+func ExtractorWithDarknetValidation() ExtractorOptionFunc {
+	return func(e *Extractor) {
+		e.validateDarknet = true
+	}
+}
+
+// ExtractorWithPrivateUseChars returns an option function that configures the Extractor
+// to allow Unicode private-use characters in paths, regardless of
+// ExtractorPrivateUseCharsDefault.
+func ExtractorWithPrivateUseChars() ExtractorOptionFunc {
+	return func(e *Extractor) {
+		e.privateUseChars = true
+	}
+}
+
+// ExtractorWithoutPrivateUseChars returns an option function that configures the Extractor
+// to end matches before Unicode private-use characters, regardless of
+// ExtractorPrivateUseCharsDefault.
+func ExtractorWithoutPrivateUseChars() ExtractorOptionFunc {
+	return func(e *Extractor) {
+		e.privateUseChars = false
+	}
+}
+
+// ExtractorWithEmojiDomains returns an option function that configures the Extractor to
+// match emoji in the labels of domains (e.g., "i❤.ws"), which are registered under some
+// TLDs. IRIToURI converts such hosts to punycode.
+func ExtractorWithEmojiDomains() ExtractorOptionFunc {
+	return func(e *Extractor) {
+		e.emojiDomains = true
+	}
+}
+
+// ExtractorWithIDNForms returns an option function that configures the Extractor to report
+// the canonical forms of internationalized hosts (the NFC-normalized Unicode form, the
+// punycode form and the UTS #39 skeleton) in the IDN field of matches, so that downstream
+// de-spoofing logic doesn't have to re-derive them.
+func ExtractorWithIDNForms() ExtractorOptionFunc {
+	return func(e *Extractor) {
+		e.idnForms = true
+	}
+}
+
+// ExtractorWithLowercaseNormalization returns an option function that configures the
+// Extractor to lowercase the scheme and the host of the values of matches (e.g.,
+// "https://example.com/Path" for "HTTPS://Example.COM/Path"), and the domain of emails,
+// as most deduplication pipelines want. Userinfo, paths, queries and fragments, which may
+// be case-sensitive, are left as they are, and so are the offsets of matches.
+func ExtractorWithLowercaseNormalization() ExtractorOptionFunc {
+	return func(e *Extractor) {
+		e.lowercase = true
+	}
+}
+
+// ExtractorWithInvisibleCharStripping returns an option function that configures the
+// Extractor to strip the invisible characters commonly inserted in URLs by email security
+// tools or spammers, and which otherwise cut them short (see InvisibleChars), before
+// matching. Matches spanning stripped characters have the InvisibleChars field set; their
+// Value is stripped, while their offsets locate them in the original text.
+func ExtractorWithInvisibleCharStripping() ExtractorOptionFunc {
+	return func(e *Extractor) {
+		e.stripInvisible = true
+	}
+}
+
+// ExtractorWithUnicodeNormalization returns an option function that configures the
+// Extractor to convert the values of matches to the given Unicode normalization form, so
+// that visually identical but bytewise different IRIs don't pollute result sets with
+// duplicates. The offsets of matches still locate the original text.
+//
+// Parameters:
+//   - form (UnicodeNormalization): The normalization form (e.g., NFCNormalization).
+func ExtractorWithUnicodeNormalization(form UnicodeNormalization) ExtractorOptionFunc {
+	return func(e *Extractor) {
+		e.normalization = form
+	}
+}
+
+// ExtractorWithBracketDepth returns an option function that limits the nesting depth of
+// the balanced brackets ("()", "[]" and "{}") matched in paths, e.g. in
+// "https://en.wikipedia.org/wiki/Go_(programming_language)". The default depth is 2;
+// deeper nesting means larger regexes and heavier matching. A depth below 1 disables
+// bracket matching, like ExtractorWithoutBracketMatching. The scanner engine doesn't
+// limit the depth of brackets, only whether they are matched.
+func ExtractorWithBracketDepth(depth int) ExtractorOptionFunc {
+	return func(e *Extractor) {
+		e.bracketDepth = depth
+		e.withoutBrackets = depth < 1
+	}
+}
+
+// ExtractorWithoutBracketMatching returns an option function that configures the Extractor
+// not to match balanced brackets in paths, for throughput-focused configurations: matches
+// end before "[" and "{", and closing brackets are trimmed from their end.
+func ExtractorWithoutBracketMatching() ExtractorOptionFunc {
+	return func(e *Extractor) {
+		e.withoutBrackets = true
+	}
+}
+
+// ExtractorWithBracketStrategy returns an option function that sets how brackets in paths
+// are handled: matched in balanced pairs (BalancedBrackets, the default), always matched
+// (GreedyBrackets) or never matched (StrictBrackets). ExtractorWithBracketDepth and
+// ExtractorWithoutBracketMatching only apply to BalancedBrackets.
+func ExtractorWithBracketStrategy(strategy BracketStrategy) ExtractorOptionFunc {
+	return func(e *Extractor) {
+		e.bracketStrategy = strategy
+	}
+}
+
+// ExtractorWithEngine returns an option function that selects the engine used by
+// Extract. RegexEngine is the default; ScannerEngine trades some of the composite
+// regex's precision for speed on large inputs.
+func ExtractorWithEngine(engine Engine) ExtractorOptionFunc {
+	return func(e *Extractor) {
+		e.engine = engine
+	}
+}
+
+// ExtractorWithChunkSize returns an option function that sets the size, in bytes, of
+// the chunks scanned by ExtractContext and ExtractReader. Smaller chunks make
+// cancellation more responsive and bound memory use more tightly; the default is 1 MiB.
+func ExtractorWithChunkSize(size int) ExtractorOptionFunc {
+	return func(e *Extractor) {
+		e.chunkSize = size
+	}
+}
+
+// ExtractorWithChunkOverlap returns an option function that sets the size, in bytes,
+// of the overlap window scanned past each chunk by ExtractContext and ExtractReader.
+// URLs longer than the overlap may be truncated when they cross a chunk boundary;
+// the default is 8000 bytes, the minimum URL length HTTP implementations are expected
+// to support.
+func ExtractorWithChunkOverlap(overlap int) ExtractorOptionFunc {
+	return func(e *Extractor) {
+		e.chunkOverlap = overlap
+	}
+}
+
+// ExtractorWithDefaultTerminators returns an option function that makes URLs end before the
+// characters of ExtractorDefaultTerminators, e.g., "，" in "访问https://example.com/路径，然后".
+// By default, no non-ASCII characters end URLs.
+func ExtractorWithDefaultTerminators() ExtractorOptionFunc {
+	return ExtractorWithTerminators(ExtractorDefaultTerminators)
+}
+
+// ExtractorWithTerminators returns an option function that adds characters to those ending
+// URLs (none by default), e.g., ExtractorCJKTerminators: URLs stop
+// before them, even when they are directly followed by more text, as is common in
+// languages written without spaces. Only non-ASCII characters are taken into account;
+// ASCII punctuation already ends URLs (or not) following RFC 3986.
+func ExtractorWithTerminators(terminators string) ExtractorOptionFunc {
+	return func(e *Extractor) {
+		e.terminators += terminators
+	}
+}
+
+// ExtractorWithoutTerminators returns an option function that removes characters from
+// those ending URLs, so that they are matched inside URLs again, e.g., fullwidth brackets
+// after ExtractorWithDefaultTerminators.
+func ExtractorWithoutTerminators(terminators string) ExtractorOptionFunc {
+	return func(e *Extractor) {
+		e.terminators = strings.Map(func(r rune) rune {
+			if strings.ContainsRune(terminators, r) {
+				return -1
+			}
+
+			return r
+		}, e.terminators)
+	}
+}
+
+// ExtractorWithMetrics returns an option function that reports the matches found, by
+// type, the bytes scanned and the duration of each extraction to metrics.
+func ExtractorWithMetrics(metrics Metrics) ExtractorOptionFunc {
+	return func(e *Extractor) {
+		e.metrics = metrics
+	}
+}
+
+// ExtractorWithLogger returns an option function that logs the decisions of the
+// Extractor's filters at debug level to logger: the matches dropped (e.g., by
+// ExtractorWithoutFilePaths or ExtractorWithDarknetValidation), with the reason, and the
+// fallback URLs of intent URIs added. This helps tune configurations on real data.
+func ExtractorWithLogger(logger *slog.Logger) ExtractorOptionFunc {
+	return func(e *Extractor) {
+		e.logger = logger
+	}
+}
+
+// anyOf is a helper function that constructs a regex pattern from a list of strings.
+// Rather than emitting a flat alternation, the strings are inserted into a prefix trie
+// which is then rendered as a regular expression where shared prefixes are factored
+// out and single-character branches are collapsed into character classes (in the
+// spirit of Perl's Regexp::Trie). For large lists, such as the ~9k known TLDs, this
+// considerably shrinks both the pattern and the compiled regex program. Each string
+// is properly escaped for use in regex matching.
+func anyOf(strs ...string) string {
+	root := newRegexTrieNode()
+
+	for _, s := range strs {
+		root.insert(s)
+	}
 
-	return b.String()
+	return "(?:" + root.pattern() + ")"
 }
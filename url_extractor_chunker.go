@@ -0,0 +1,100 @@
+package url
+
+import "unicode/utf8"
+
+const (
+	// extractorMaxURLLength is the maximum length, in bytes, of URLs that chunked
+	// extraction guarantees not to split. It matches the minimum URL length HTTP
+	// implementations are expected to support (RFC 9110, section 4.1).
+	extractorMaxURLLength = 8000
+	// extractorDefaultChunkSize is the default size, in bytes, of the chunks scanned by
+	// chunked extraction.
+	extractorDefaultChunkSize = 1 << 20
+	// extractorDefaultChunkOverlap is the default size, in bytes, by which each scanned
+	// window extends past its chunk.
+	extractorDefaultChunkOverlap = extractorMaxURLLength
+)
+
+// chunker drives chunked extraction over an input that is consumed window by window.
+// Each window holds a chunk followed by an overlap region; matches are kept only if
+// they start inside the chunk, so that URLs crossing a chunk boundary are found whole
+// (as long as they are shorter than the overlap) by the window of the chunk they start
+// in. Matches starting before the end of the last kept match are suffixes of it (found
+// again by the next window) and are dropped, deduplicating the overlap region, unless
+// they are fallback URLs of intent URIs, which lie within the intent URI.
+type chunker struct {
+	extract func(text string) (matches []Match)
+
+	size    int // The chunk size, in bytes.
+	overlap int // The overlap size, in bytes.
+
+	offset  int // The absolute offset of the next chunk.
+	lastEnd int // The absolute end offset of the last kept match.
+}
+
+// windowLength returns the length of the window to pass to next: the chunk size plus
+// the overlap.
+func (c *chunker) windowLength() int {
+	return c.size + c.overlap
+}
+
+// next scans window, which must start at the chunker's current offset, and returns the
+// kept matches (with absolute offsets) and the number of bytes consumed. If final is
+// true, window extends to the end of the input and is consumed entirely.
+func (c *chunker) next(window string, final bool) (matches []Match, consumed int) {
+	consumed = len(window)
+
+	if !final {
+		consumed = runeBoundary(window, c.size)
+	}
+
+	for _, match := range c.extract(window) {
+		match.Start += c.offset
+		match.End += c.offset
+
+		if match.Start >= c.offset+consumed || (match.Start < c.lastEnd && !match.IntentFallback) {
+			continue
+		}
+
+		matches = append(matches, match)
+
+		c.lastEnd = max(c.lastEnd, match.End)
+	}
+
+	c.offset += consumed
+
+	return
+}
+
+// newChunker creates a chunker using the Extractor's extraction and chunking configuration.
+func newChunker(e *Extractor) (c *chunker) {
+	c = &chunker{
+		extract: e.extract,
+		size:    extractorDefaultChunkSize,
+		overlap: extractorDefaultChunkOverlap,
+	}
+
+	if e.chunkSize > 0 {
+		c.size = e.chunkSize
+	}
+
+	if e.chunkOverlap > 0 {
+		c.overlap = e.chunkOverlap
+	}
+
+	return
+}
+
+// runeBoundary returns the smallest offset greater than or equal to i (capped at len(text))
+// which does not fall in the middle of a UTF-8 encoded rune.
+func runeBoundary(text string, i int) int {
+	if i >= len(text) {
+		return len(text)
+	}
+
+	for i < len(text) && !utf8.RuneStart(text[i]) {
+		i++
+	}
+
+	return i
+}
@@ -0,0 +1,217 @@
+package url
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"regexp"
+	"slices"
+)
+
+// CompiledExtractor is an Extractor frozen at compilation: its configuration cannot change
+// and its regex and scanner are built up front, which makes the contract explicit that
+// it is safe for concurrent use by any number of goroutines, without first-use
+// compilation on the hot path.
+//
+// Example:
+//
+//	compiled, err := hqgourl.NewExtractor(hqgourl.ExtractorWithScheme()).Compile()
+//	if err != nil {
+//	    log.Fatal(err)
+//	}
+//
+//	matches := compiled.Extract(text) // From any goroutine.
+type CompiledExtractor struct {
+	extractor *Extractor
+}
+
+// Ensure that CompiledExtractor implements the MatchExtractor.
+var _ MatchExtractor = &CompiledExtractor{}
+
+// Compile validates the Extractor's configuration and returns a CompiledExtractor with a
+// copy of it, whose regex and scanner are built eagerly. Later changes to the Extractor
+// don't affect the CompiledExtractor.
+//
+// Returns:
+//   - compiled (*CompiledExtractor): The compiled extractor.
+//   - err (error): ErrInvalidPattern (wrapped) if a custom pattern does not compile.
+func (e *Extractor) Compile() (compiled *CompiledExtractor, err error) {
+	if err = e.Validate(); err != nil {
+		return
+	}
+
+	extractor := e.clone()
+
+	extractor.compiledRegex()
+	extractor.compiledScanner()
+
+	compiled = &CompiledExtractor{
+		extractor: extractor,
+	}
+
+	return
+}
+
+// Apply applies options to the Extractor. As Extract compiles the Extractor's regex (or
+// scanner) on first use and reuses it afterwards, options applied later would be silently
+// ignored: Apply refuses them instead, and leaves the Extractor unchanged. Apply must not
+// be called concurrently with Extract.
+//
+// Parameters:
+//   - opts (variadic ExtractorOptionFunc): The options to apply.
+//
+// Returns:
+//   - err (error): ErrExtractorCompiled (wrapped) if Extract has already been called.
+func (e *Extractor) Apply(opts ...ExtractorOptionFunc) (err error) {
+	if e.compiled.Load() {
+		err = fmt.Errorf("%w: options must be applied before Extract", ErrExtractorCompiled)
+
+		return
+	}
+
+	for _, opt := range opts {
+		opt(e)
+	}
+
+	return
+}
+
+// clone returns an uncompiled copy of the Extractor's configuration.
+func (e *Extractor) clone() (clone *Extractor) {
+	clone = &Extractor{
+		withScheme:        e.withScheme,
+		withSchemePattern: e.withSchemePattern,
+		withHost:          e.withHost,
+		withHostPattern:   e.withHostPattern,
+		knownTLDOnly:      e.knownTLDOnly,
+		withoutUserInfo:   e.withoutUserInfo,
+		withoutIPv4Hosts:  e.withoutIPv4Hosts,
+		withoutIPv6Hosts:  e.withoutIPv6Hosts,
+		obfuscatedIPs:     e.obfuscatedIPs,
+		withoutFilePaths:  e.withoutFilePaths,
+		hostSpoofing:      e.hostSpoofing,
+		withoutSpoofing:   e.withoutSpoofing,
+		validateDarknet:   e.validateDarknet,
+		privateUseChars:   e.privateUseChars,
+		emojiDomains:      e.emojiDomains,
+		idnForms:          e.idnForms,
+		intentFallbacks:   e.intentFallbacks,
+		schemeRelative:    e.schemeRelative,
+		lowercase:         e.lowercase,
+		normalization:     e.normalization,
+		stripInvisible:    e.stripInvisible,
+		withExtensions:    slices.Clone(e.withExtensions),
+		withoutExtensions: slices.Clone(e.withoutExtensions),
+		bracketDepth:      e.bracketDepth,
+		withoutBrackets:   e.withoutBrackets,
+		bracketStrategy:   e.bracketStrategy,
+		engine:            e.engine,
+		chunkSize:         e.chunkSize,
+		chunkOverlap:      e.chunkOverlap,
+		metrics:           e.metrics,
+		logger:            e.logger,
+		terminators:       e.terminators,
+	}
+
+	return
+}
+
+// CompileRegex returns the compiled regex. Unlike Extractor.CompileRegex, it doesn't
+// compile a new regex on each call; the regex is safe for concurrent use.
+//
+// Returns:
+//   - regex (*regexp.Regexp): The compiled regex.
+func (c *CompiledExtractor) CompileRegex() (regex *regexp.Regexp) {
+	regex = c.extractor.compiledRegex()
+
+	return
+}
+
+// Validate always returns nil, as the configuration was validated by Compile.
+//
+// Returns:
+//   - err (error): nil.
+func (c *CompiledExtractor) Validate() (err error) {
+	return
+}
+
+// Pattern returns the composite pattern of the regex. See Extractor.Pattern.
+//
+// Returns:
+//   - pattern (string): The composite pattern.
+func (c *CompiledExtractor) Pattern() (pattern string) {
+	pattern = c.extractor.Pattern()
+
+	return
+}
+
+// Stats reports the size of the regex and which matching features are enabled. See
+// Extractor.Stats.
+//
+// Returns:
+//   - stats (ExtractorStats): The statistics for the configuration.
+func (c *CompiledExtractor) Stats() (stats ExtractorStats) {
+	stats = c.extractor.Stats()
+
+	return
+}
+
+// Extract finds all URLs in text. See Extractor.Extract.
+//
+// Parameters:
+//   - text (string): The text to extract URLs from.
+//
+// Returns:
+//   - matches ([]Match): The matches found in text.
+func (c *CompiledExtractor) Extract(text string) (matches []Match) {
+	matches = c.extractor.Extract(text)
+
+	return
+}
+
+// ExtractContext is like Extract, but scans text in chunks and checks ctx between chunks.
+// See Extractor.ExtractContext.
+//
+// Parameters:
+//   - ctx (context.Context): The context controlling cancellation.
+//   - text (string): The text to extract URLs from.
+//
+// Returns:
+//   - matches ([]Match): The matches found in text.
+//   - err (error): An error if ctx was done before extraction completed.
+func (c *CompiledExtractor) ExtractContext(ctx context.Context, text string) (matches []Match, err error) {
+	matches, err = c.extractor.ExtractContext(ctx, text)
+
+	return
+}
+
+// ExtractReader streams text from r and calls fn for each match found, in order. See
+// Extractor.ExtractReader.
+//
+// Parameters:
+//   - ctx (context.Context): The context controlling cancellation.
+//   - r (io.Reader): The reader to extract URLs from.
+//   - fn (func(match Match)): The function called for each match.
+//
+// Returns:
+//   - err (error): An error if reading failed or ctx was done before extraction completed.
+func (c *CompiledExtractor) ExtractReader(ctx context.Context, r io.Reader, fn func(match Match)) (err error) {
+	err = c.extractor.ExtractReader(ctx, r, fn)
+
+	return
+}
+
+// ExtractToJSON writes the matches found in text to w as newline-delimited JSON. See
+// Extractor.ExtractToJSON.
+//
+// Parameters:
+//   - w (io.Writer): The writer the records are written to.
+//   - text (string): The text to extract URLs from.
+//
+// Returns:
+//   - err (error): The first error returned by w, if any.
+func (c *CompiledExtractor) ExtractToJSON(w io.Writer, text string) (err error) {
+	err = c.extractor.ExtractToJSON(w, text)
+
+	return
+}
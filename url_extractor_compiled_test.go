@@ -0,0 +1,72 @@
+package url_test
+
+import (
+	"context"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	hqgourl "go.source.hueristiq.com/url"
+)
+
+func TestExtractor_Compile(t *testing.T) {
+	t.Parallel()
+
+	extractor := hqgourl.NewExtractor(hqgourl.ExtractorWithScheme())
+
+	compiled, err := extractor.Compile()
+
+	require.NoError(t, err)
+	require.NoError(t, compiled.Validate())
+
+	require.NoError(t, extractor.Apply(hqgourl.ExtractorWithHost()))
+
+	text := "see https://example.com and www.example.org"
+
+	var wg sync.WaitGroup
+
+	for range 8 {
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+
+			matches := compiled.Extract(text)
+
+			if assert.Len(t, matches, 1) {
+				assert.Equal(t, "https://example.com", matches[0].Value)
+			}
+		}()
+	}
+
+	wg.Wait()
+
+	matches, err := compiled.ExtractContext(context.Background(), text)
+
+	require.NoError(t, err)
+
+	assert.Len(t, matches, 1)
+	assert.Same(t, compiled.CompileRegex(), compiled.CompileRegex())
+	assert.Equal(t, hqgourl.NewExtractor(hqgourl.ExtractorWithScheme()).Pattern(), compiled.Pattern())
+
+	_, err = hqgourl.NewExtractor(hqgourl.ExtractorWithHostPattern(`(`)).Compile()
+
+	require.ErrorIs(t, err, hqgourl.ErrInvalidPattern)
+}
+
+func TestExtractor_Apply(t *testing.T) {
+	t.Parallel()
+
+	extractor := hqgourl.NewExtractor()
+
+	require.NoError(t, extractor.Apply(hqgourl.ExtractorWithScheme()))
+
+	assert.Len(t, extractor.Extract("https://example.com and www.example.org"), 1)
+
+	err := extractor.Apply(hqgourl.ExtractorWithHost())
+
+	require.ErrorIs(t, err, hqgourl.ErrExtractorCompiled)
+
+	assert.Len(t, extractor.Extract("https://example.com and www.example.org"), 1)
+}
@@ -0,0 +1,120 @@
+package url
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"time"
+	"unicode/utf8"
+)
+
+// ExtractContext is like Extract, but scans text in chunks and checks ctx between
+// chunks, so that extraction over very large inputs (e.g., a multi-GB memory-mapped
+// buffer) can be aborted. Each chunk is scanned together with an overlap window past
+// its end, so URLs crossing a chunk boundary are found whole and reported once.
+// Chunk and overlap sizes can be configured with ExtractorWithChunkSize and
+// ExtractorWithChunkOverlap.
+//
+// If ctx is done before the whole text is scanned, the matches found so far are
+// returned along with an error wrapping ctx.Err().
+//
+// Parameters:
+//   - ctx (context.Context): The context controlling cancellation.
+//   - text (string): The text to extract URLs from.
+//
+// Returns:
+//   - matches ([]Match): The matches found in text, with offsets relative to text.
+//   - err (error): An error if ctx was done before extraction completed.
+func (e *Extractor) ExtractContext(ctx context.Context, text string) (matches []Match, err error) {
+	c := newChunker(e)
+
+	if e.metrics != nil {
+		defer func(start time.Time) { e.observeExtraction(start, c.offset, matches) }(time.Now())
+	}
+
+	for c.offset < len(text) {
+		if err = ctx.Err(); err != nil {
+			err = fmt.Errorf("extraction aborted: %w", err)
+
+			return
+		}
+
+		windowEnd := runeBoundary(text, c.offset+c.windowLength())
+
+		chunkMatches, _ := c.next(text[c.offset:windowEnd], windowEnd == len(text))
+
+		matches = append(matches, chunkMatches...)
+	}
+
+	return
+}
+
+// ExtractReader streams text from r and calls fn for each match found, in order. The
+// input is buffered and scanned in chunks with an overlap window, like ExtractContext,
+// so URLs are not split across read boundaries and memory use is bounded by the chunk
+// and overlap sizes rather than by the input size. ctx is checked between chunks.
+//
+// Parameters:
+//   - ctx (context.Context): The context controlling cancellation.
+//   - r (io.Reader): The reader to extract URLs from.
+//   - fn (func(match Match)): The function called for each match, with offsets relative
+//     to the start of the stream.
+//
+// Returns:
+//   - err (error): An error if reading failed or ctx was done before extraction completed.
+func (e *Extractor) ExtractReader(ctx context.Context, r io.Reader, fn func(match Match)) (err error) {
+	c := newChunker(e)
+
+	if e.metrics != nil {
+		defer func(start time.Time) { e.observeExtraction(start, c.offset, nil) }(time.Now())
+	}
+
+	var buf bytes.Buffer
+
+	EOF := false
+
+	for !EOF || buf.Len() > 0 {
+		if err = ctx.Err(); err != nil {
+			err = fmt.Errorf("extraction aborted: %w", err)
+
+			return
+		}
+
+		// Fill the buffer with a full window, unless the input ends first.
+		if !EOF && buf.Len() < c.windowLength() {
+			_, err = io.CopyN(&buf, r, int64(c.windowLength()-buf.Len()))
+
+			switch {
+			case errors.Is(err, io.EOF):
+				EOF, err = true, nil
+			case err != nil:
+				err = fmt.Errorf("error reading input: %w", err)
+
+				return
+			}
+		}
+
+		end := min(buf.Len(), c.windowLength())
+
+		// Don't end a window in the middle of a UTF-8 encoded rune.
+		for end > 0 && end < buf.Len() && !utf8.RuneStart(buf.Bytes()[end]) {
+			end--
+		}
+
+		matches, consumed := c.next(string(buf.Bytes()[:end]), EOF && end == buf.Len())
+
+		if e.metrics != nil {
+			observeMatches(e.metrics, matches)
+		}
+
+		for _, match := range matches {
+			fn(match)
+		}
+
+		buf.Next(consumed)
+	}
+
+	return
+}
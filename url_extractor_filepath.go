@@ -0,0 +1,51 @@
+package url
+
+import "strings"
+
+// fileSystemRoots are the well-known top-level directories of Unix and macOS file systems,
+// which relative matches starting with are taken for file paths.
+var fileSystemRoots = []string{
+	"/Applications/", "/Library/", "/System/", "/Users/", "/Volumes/",
+	"/bin/", "/boot/", "/dev/", "/etc/", "/home/", "/lib/", "/lib64/", "/mnt/", "/opt/",
+	"/private/", "/proc/", "/root/", "/run/", "/sbin/", "/srv/", "/sys/", "/tmp/", "/usr/", "/var/",
+}
+
+// isFilePath reports whether the relative match of text looks like a local file path
+// rather than a URL: it has no query or fragment, and it follows a drive letter ("C:") or
+// "~", starts with "./" or "../", or starts with a well-known root directory.
+func isFilePath(text string, match Match) bool {
+	value := match.Value
+
+	if strings.ContainsAny(value, "?#") {
+		return false
+	}
+
+	before := text[:match.Start]
+
+	// Windows drive letters, whose "C:" is left out of the match.
+	if n := len(before); n >= 2 && before[n-1] == ':' && isASCIILetter(before[n-2]) && (n == 2 || !isASCIIAlphanumeric(rune(before[n-3]))) {
+		return true
+	}
+
+	// Home directories, whose "~" is left out of the match.
+	if strings.HasSuffix(before, "~") {
+		return true
+	}
+
+	if strings.HasPrefix(value, "./") || strings.HasPrefix(value, "../") {
+		return true
+	}
+
+	for _, root := range fileSystemRoots {
+		if strings.HasPrefix(value, root) || value == strings.TrimSuffix(root, "/") {
+			return true
+		}
+	}
+
+	return false
+}
+
+// isASCIILetter reports whether b is an ASCII letter.
+func isASCIILetter(b byte) bool {
+	return b >= 'a' && b <= 'z' || b >= 'A' && b <= 'Z'
+}
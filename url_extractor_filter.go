@@ -0,0 +1,193 @@
+package url
+
+import (
+	"net/url"
+	"slices"
+	"strings"
+
+	"go.source.hueristiq.com/url/darknet"
+)
+
+// filtering reports whether the Extractor drops or annotates some of the matches of its
+// engine.
+func (e *Extractor) filtering() bool {
+	// Relative matches are classified as file paths or not.
+	relativeURLs := !e.withScheme && !e.withHost
+
+	extensions := len(e.withExtensions) > 0 || len(e.withoutExtensions) > 0
+
+	return e.validateDarknet || e.hostSpoofing || e.withoutSpoofing || e.idnForms || e.obfuscatedIPs || e.intentFallbacks || e.lowercase || e.normalization != NoUnicodeNormalization || extensions || relativeURLs
+}
+
+// filter drops the matches of text rejected by the Extractor's filters, in place unless
+// fallback URLs of intent URIs are added, and annotates the others.
+func (e *Extractor) filter(text string, matches []Match) []Match {
+	if !e.filtering() {
+		return matches
+	}
+
+	kept := matches[:0]
+
+	if e.intentFallbacks {
+		kept = make([]Match, 0, len(matches))
+	}
+
+	for _, match := range matches {
+		if match.Type == MatchTypeRelative {
+			match.FilePath = isFilePath(text, match)
+		}
+
+		if !e.keep(match) {
+			continue
+		}
+
+		kept = append(kept, e.annotate(match))
+
+		if e.intentFallbacks {
+			if fallback, ok := intentFallback(match); ok && e.keep(fallback) {
+				e.debug("added intent fallback", fallback, "intent", match.Value)
+
+				kept = append(kept, e.annotate(fallback))
+			}
+		}
+	}
+
+	return kept
+}
+
+// annotate returns match with the annotations the Extractor is configured to report.
+func (e *Extractor) annotate(match Match) Match {
+	if e.idnForms {
+		match.IDN = idnForms(match.hostname())
+	}
+
+	if e.hostSpoofing {
+		match.HostSpoofing = match.hostSpoofing()
+	}
+
+	if e.obfuscatedIPs {
+		if addr, ok := ObfuscatedIPv4(match.hostname()); ok {
+			match.ObfuscatedIP = addr.String()
+		}
+	}
+
+	// Last, as the other annotations are derived from the original value.
+	match.Value = e.normalization.normalize(match.Value)
+
+	if e.lowercase {
+		match.Value = match.lowercased()
+	}
+
+	return match
+}
+
+// keep reports whether match passes the Extractor's filters, logging why it is dropped
+// otherwise.
+func (e *Extractor) keep(match Match) bool {
+	reason := e.reject(match)
+
+	if reason != "" {
+		e.debug("dropped match", match, "reason", reason)
+	}
+
+	return reason == ""
+}
+
+// reject returns the reason why match is rejected by the Extractor's filters, or an
+// empty string if it passes them.
+func (e *Extractor) reject(match Match) (reason string) {
+	if e.withoutFilePaths && match.FilePath {
+		reason = "file path"
+
+		return
+	}
+
+	if e.withoutSpoofing && match.hostSpoofing() {
+		reason = "host spoofing"
+
+		return
+	}
+
+	if len(e.withExtensions) > 0 || len(e.withoutExtensions) > 0 {
+		extension := match.extension()
+
+		if len(e.withExtensions) > 0 && !slices.Contains(e.withExtensions, extension) {
+			reason = "extension not included"
+
+			return
+		}
+
+		if extension != "" && slices.Contains(e.withoutExtensions, extension) {
+			reason = "extension excluded"
+
+			return
+		}
+	}
+
+	host := match.hostname()
+
+	if e.validateDarknet {
+		if darknet.IsOnion(host) && darknet.ValidateOnionV3(host) != nil {
+			reason = "invalid onion address"
+
+			return
+		}
+
+		if hasSuffixFold(host, ".b32.i2p") && darknet.ValidateI2P(host) != nil {
+			reason = "invalid I2P address"
+
+			return
+		}
+	}
+
+	// Without scheme, numbers are only taken for obfuscated IP hosts with a hexadecimal part.
+	if e.obfuscatedIPs && match.Type != MatchTypeURL {
+		if _, ok := ObfuscatedIPv4(host); ok && !strings.Contains(strings.ToLower(host), "0x") {
+			reason = "schemeless number"
+
+			return
+		}
+	}
+
+	return
+}
+
+// debug logs a filter decision about match at debug level to the Extractor's logger, if
+// any, with the given key-value pairs.
+func (e *Extractor) debug(msg string, match Match, args ...any) {
+	if e.logger == nil {
+		return
+	}
+
+	e.logger.Debug(msg, append([]any{"match", match.Value, "type", match.Type}, args...)...)
+}
+
+// hostname returns the host of the matched value (the domain, for emails), without port
+// or brackets, or an empty string if it has none.
+func (m Match) hostname() (host string) {
+	if m.Type == MatchTypeEmail {
+		_, host, _ = strings.Cut(m.Value, "@")
+
+		return
+	}
+
+	raw := m.Value
+
+	if m.Type == MatchTypeHost {
+		raw = "//" + raw
+	}
+
+	parsed, err := url.Parse(raw)
+	if err != nil {
+		return
+	}
+
+	host = parsed.Hostname()
+
+	return
+}
+
+// hasSuffixFold reports whether s ends with suffix, ignoring case.
+func hasSuffixFold(s, suffix string) bool {
+	return len(s) >= len(suffix) && strings.EqualFold(s[len(s)-len(suffix):], suffix)
+}
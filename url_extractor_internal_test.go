@@ -0,0 +1,98 @@
+package url
+
+import (
+	"reflect"
+	"testing"
+	"time"
+	"unsafe"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestExtractor_Extract_RegexEngineDoesNotBuildScanner(t *testing.T) {
+	t.Parallel()
+
+	extractor := NewExtractor(ExtractorWithSchemeRelativeURLs())
+
+	matches := extractor.Extract("see //example.com and https://example.org")
+
+	assert.Len(t, matches, 2)
+	assert.Nil(t, extractor.scanner)
+	assert.NotNil(t, extractor.recognizer)
+}
+
+type nopMetrics struct{}
+
+func (nopMetrics) AddMatches(_ MatchType, _ int)                {}
+func (nopMetrics) AddParseErrors(_ int)                         {}
+func (nopMetrics) AddBytesScanned(_ int)                        {}
+func (nopMetrics) ObserveDuration(_ Operation, _ time.Duration) {}
+
+func TestExtractor_clone(t *testing.T) {
+	t.Parallel()
+
+	// Fields caching the compiled state, which clone leaves zero.
+	cached := map[string]bool{
+		"regex":          true,
+		"regexOnce":      true,
+		"scanner":        true,
+		"scannerOnce":    true,
+		"recognizer":     true,
+		"recognizerOnce": true,
+		"compiled":       true,
+	}
+
+	extractor := &Extractor{}
+
+	// Set every configuration field to a non-zero value, so that a field clone doesn't
+	// copy is reported, including fields added after this test.
+	value := reflect.ValueOf(extractor).Elem()
+
+	for i := range value.NumField() {
+		field := value.Type().Field(i)
+
+		if cached[field.Name] {
+			continue
+		}
+
+		settable := reflect.NewAt(field.Type, unsafe.Pointer(value.Field(i).UnsafeAddr())).Elem()
+
+		switch field.Type.Kind() {
+		case reflect.Bool:
+			settable.SetBool(true)
+		case reflect.Int:
+			settable.SetInt(1)
+		case reflect.String:
+			settable.SetString("x")
+		case reflect.Slice:
+			settable.Set(reflect.MakeSlice(field.Type, 1, 1))
+		case reflect.Pointer:
+			settable.Set(reflect.New(field.Type.Elem()))
+		case reflect.Interface:
+			settable.Set(reflect.ValueOf(nopMetrics{}))
+		default:
+			t.Fatalf("field %s has a kind (%s) this test doesn't set", field.Name, field.Type.Kind())
+		}
+	}
+
+	extractor.compiledRegex()
+
+	clone := extractor.clone()
+	cloned := reflect.ValueOf(clone).Elem()
+
+	for i := range value.NumField() {
+		field := value.Type().Field(i)
+
+		if cached[field.Name] {
+			continue
+		}
+
+		original := reflect.NewAt(field.Type, unsafe.Pointer(value.Field(i).UnsafeAddr())).Elem().Interface()
+		copied := reflect.NewAt(field.Type, unsafe.Pointer(cloned.Field(i).UnsafeAddr())).Elem().Interface()
+
+		assert.Equal(t, original, copied, "field %s is not copied by clone", field.Name)
+	}
+
+	assert.Nil(t, clone.regex)
+	assert.False(t, clone.compiled.Load())
+}
@@ -0,0 +1,54 @@
+package url
+
+import (
+	"strings"
+	"unicode/utf8"
+)
+
+// InvisibleChars are the invisible characters ExtractorWithInvisibleCharStripping strips:
+// the zero width space (U+200B), the zero width non-joiner (U+200C), the word joiner
+// (U+2060), the soft hyphen (U+00AD) and the zero width no-break space (U+FEFF, also the
+// byte order mark). The zero width joiner (U+200D) is left out, as it joins emoji
+// sequences (see ExtractorWithEmojiDomains).
+const InvisibleChars = "\u200b\u200c\u2060\u00ad\ufeff"
+
+// isInvisible reports whether r is one of InvisibleChars.
+func isInvisible(r rune) bool {
+	return strings.ContainsRune(InvisibleChars, r)
+}
+
+// extractStripped finds all URLs in text stripped of its invisible characters, mapping the
+// offsets of matches back to text and flagging those spanning stripped characters.
+func (e *Extractor) extractStripped(text string) (matches []Match) {
+	var b strings.Builder
+
+	b.Grow(len(text))
+
+	// offsets maps the byte offsets of the stripped text to those of text.
+	offsets := make([]int, 0, len(text))
+
+	for i := 0; i < len(text); {
+		r, size := utf8.DecodeRuneInString(text[i:])
+
+		if !isInvisible(r) {
+			b.WriteString(text[i : i+size])
+
+			for j := range size {
+				offsets = append(offsets, i+j)
+			}
+		}
+
+		i += size
+	}
+
+	matches = e.find(b.String())
+
+	for i, match := range matches {
+		start, end := offsets[match.Start], offsets[match.End-1]+1
+
+		matches[i].Start, matches[i].End = start, end
+		matches[i].InvisibleChars = end-start != match.End-match.Start
+	}
+
+	return
+}
@@ -0,0 +1,30 @@
+package url
+
+import (
+	"encoding/json"
+	"io"
+)
+
+// ExtractToJSON extracts all matches from text and writes them to w as newline-delimited
+// JSON (NDJSON), one record per match in the format of Match.MarshalJSON, so that non-Go
+// tools in a pipeline can consume the results directly.
+//
+// Parameters:
+//   - w (io.Writer): The writer the records are written to.
+//   - text (string): The text to extract URLs from.
+//
+// Returns:
+//   - err (error): The first error returned by w, if any.
+func (e *Extractor) ExtractToJSON(w io.Writer, text string) (err error) {
+	encoder := json.NewEncoder(w)
+
+	encoder.SetEscapeHTML(false)
+
+	for _, match := range e.Extract(text) {
+		if err = encoder.Encode(match); err != nil {
+			return
+		}
+	}
+
+	return
+}
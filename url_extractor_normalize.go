@@ -0,0 +1,73 @@
+package url
+
+import (
+	"strings"
+
+	"golang.org/x/text/unicode/norm"
+)
+
+// normalize returns value converted to the normalization form.
+func (n UnicodeNormalization) normalize(value string) string {
+	switch n {
+	case NFCNormalization:
+		return norm.NFC.String(value)
+	case NFKCNormalization:
+		return norm.NFKC.String(value)
+	case NoUnicodeNormalization:
+	}
+
+	return value
+}
+
+// lowercased returns the matched value with its scheme and host lowercased (see
+// ExtractorWithLowercaseNormalization): the domain of emails, the host of schemeless and
+// scheme-relative URLs, and the scheme and, if it has an authority, the host of URLs.
+func (m Match) lowercased() (value string) {
+	value = m.Value
+
+	authority := 0
+
+	switch m.Type {
+	case MatchTypeRelative:
+		return
+	case MatchTypeEmail:
+		at := strings.LastIndexByte(value, '@')
+
+		value = value[:at+1] + strings.ToLower(value[at+1:])
+
+		return
+	case MatchTypeURL:
+		colon := strings.IndexByte(value, ':')
+		if colon < 0 {
+			return
+		}
+
+		value = strings.ToLower(value[:colon]) + value[colon:]
+
+		// Opaque URLs (e.g., "mailto:") have no host.
+		if !strings.HasPrefix(value[colon+1:], "//") {
+			return
+		}
+
+		authority = colon + 3
+	case MatchTypeSchemeRelative:
+		authority = 2
+	}
+
+	end := len(value)
+
+	if i := strings.IndexAny(value[authority:], "/?#"); i >= 0 {
+		end = authority + i
+	}
+
+	host := authority
+
+	// The userinfo, if any, is kept as it is.
+	if at := strings.LastIndexByte(value[authority:end], '@'); at >= 0 {
+		host += at + 1
+	}
+
+	value = value[:host] + strings.ToLower(value[host:end]) + value[end:]
+
+	return
+}
@@ -0,0 +1,575 @@
+package url
+
+import (
+	"net/netip"
+	"regexp"
+	"slices"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"go.source.hueristiq.com/url/schemes"
+	"go.source.hueristiq.com/url/tlds"
+)
+
+// scanner is the hand-written extraction engine used when an Extractor is configured with
+// ScannerEngine. Instead of running the composite regular expression over the whole input,
+// it splits the input into whitespace-delimited tokens and applies small recognizers for
+// schemes, hosts (known TLDs, IPv4, bracketed IPv6), emails and relative paths to each token.
+// This is considerably faster than the composite regex on large corpora, at the cost of
+// recognizing at most one match per token.
+type scanner struct {
+	e *Extractor
+
+	*recognizer
+
+	// terminators are the characters ending URLs, which also end tokens.
+	terminators []rune
+}
+
+// recognizer recognizes the schemes and authorities of candidates following an
+// Extractor's configuration. It is shared by the scanner and by the classification of the
+// matches of the regex engine, which doesn't need the rest of the scanner.
+type recognizer struct {
+	e *Extractor
+
+	// schemeRegex matches a scheme anywhere in a token. It is compiled from the default
+	// scheme pattern or the user supplied one.
+	schemeRegex *regexp.Regexp
+	// hostRegex matches an optional userinfo followed by the user supplied host pattern
+	// at the start of a string. It is nil unless a custom host pattern is configured.
+	hostRegex *regexp.Regexp
+}
+
+// scan extracts all matches from text.
+func (s *scanner) scan(text string) (matches []Match) {
+	start := -1
+
+	for i, r := range text {
+		if isTokenDelimiter(r) || r >= utf8.RuneSelf && slices.Contains(s.terminators, r) {
+			if start >= 0 {
+				matches = s.appendTokenMatch(matches, text, start, i)
+
+				start = -1
+			}
+
+			continue
+		}
+
+		if start < 0 {
+			start = i
+		}
+	}
+
+	if start >= 0 {
+		matches = s.appendTokenMatch(matches, text, start, len(text))
+	}
+
+	return
+}
+
+// appendTokenMatch runs the recognizers over the token text[start:end] and appends
+// the resulting match, if any, to matches.
+func (s *scanner) appendTokenMatch(matches []Match, text string, start, end int) []Match {
+	token := text[start:end]
+
+	// Like the regex, end the token, and so any match, at the first private-use character.
+	if !s.e.privateUseChars {
+		if i := strings.IndexFunc(token, isPrivateUse); i >= 0 {
+			token = token[:i]
+		}
+	}
+
+	offset, length, matchType, ok := s.recognize(token)
+	if !ok {
+		return matches
+	}
+
+	return append(matches, Match{
+		Value: token[offset : offset+length],
+		Start: start + offset,
+		End:   start + offset + length,
+		Type:  matchType,
+	})
+}
+
+// recognize finds the match in token, returning its offset and length within the token.
+func (s *scanner) recognize(token string) (offset, length int, matchType MatchType, ok bool) {
+	// URLs with a scheme.
+	if strings.IndexByte(token, ':') >= 0 {
+		if loc := s.schemeRegex.FindStringIndex(token); loc != nil {
+			offset = loc[0]
+
+			if length, ok = s.recognizeSchemeURL(token[offset:], loc[1]-loc[0]); ok {
+				matchType = MatchTypeURL
+
+				return
+			}
+		}
+	}
+
+	offset = leadingPunctuationLength(token, s.e.emojiDomains)
+	candidate := token[offset:]
+
+	if s.e.schemeRelative && strings.HasPrefix(candidate, "//") {
+		if length, ok = s.recognizeSchemeRelativeURL(candidate); ok {
+			matchType = MatchTypeSchemeRelative
+
+			return
+		}
+	}
+
+	if s.e.withScheme {
+		return
+	}
+
+	// URLs starting with a host, and emails. A leading "[" is only kept for IPv6 hosts.
+	if length, matchType, ok = s.recognizeHostURL(candidate); ok {
+		return
+	}
+
+	if strings.HasPrefix(candidate, "[") {
+		if length, matchType, ok = s.recognizeHostURL(candidate[1:]); ok {
+			offset++
+
+			return
+		}
+	}
+
+	if s.e.withHost {
+		return
+	}
+
+	// Relative URLs.
+	if loc := relativeURLRegex.FindStringIndex(candidate); loc != nil {
+		length, matchType, ok = loc[1], MatchTypeRelative, true
+	}
+
+	return
+}
+
+// recognizeSchemeURL recognizes a URL starting with a scheme of length schemeLength.
+func (s *scanner) recognizeSchemeURL(candidate string, schemeLength int) (length int, ok bool) {
+	rest := candidate[schemeLength:]
+
+	// A custom host pattern makes the authority mandatory, and so does ExtractorWithKnownTLDOnly
+	// for schemes with an authority.
+	if s.hostRegex != nil || s.e.knownTLDOnly && !s.isNoAuthorityScheme(candidate[:schemeLength]) {
+		authority, _ := s.authorityLength(rest)
+		if authority == 0 {
+			return
+		}
+
+		if authority < len(rest) && rest[authority] != '/' {
+			length, ok = schemeLength+authority, true
+
+			return
+		}
+
+		length, ok = schemeLength+authority+s.pathLength(rest[authority:]), true
+
+		return
+	}
+
+	length = schemeLength + s.pathLength(rest)
+
+	ok = length > schemeLength
+
+	return
+}
+
+// recognizeSchemeRelativeURL recognizes a scheme-relative URL ("//" followed by a host).
+func (s *scanner) recognizeSchemeRelativeURL(candidate string) (length int, ok bool) {
+	authority, _ := s.authorityLength(candidate[2:])
+	if authority == 0 {
+		return
+	}
+
+	length, ok = 2+authority, true
+
+	if length < len(candidate) && candidate[length] == '/' {
+		length += s.pathLength(candidate[length:])
+	}
+
+	// Like the regex, which prefers the longest match, take a longer relative URL.
+	if !s.e.withScheme && !s.e.withHost {
+		if loc := relativeURLRegex.FindStringIndex(candidate); loc != nil && loc[1] > length {
+			length = loc[1]
+		}
+	}
+
+	return
+}
+
+// isNoAuthorityScheme reports whether scheme (including its trailing ":" or "://") is
+// one of the no-authority schemes matched by the default scheme pattern.
+func (s *scanner) isNoAuthorityScheme(scheme string) bool {
+	name, _, _ := strings.Cut(scheme, ":")
+
+	return s.e.SchemePattern() == ExtractorSchemePattern && slices.Contains(schemes.NoAuthority, name)
+}
+
+// recognizeHostURL recognizes a schemeless URL starting with a host, or an email address.
+func (s *scanner) recognizeHostURL(candidate string) (length int, matchType MatchType, ok bool) {
+	authority, isEmail := s.authorityLength(candidate)
+	if authority == 0 {
+		return
+	}
+
+	if isEmail {
+		length, matchType, ok = authority, MatchTypeEmail, true
+
+		return
+	}
+
+	length, matchType, ok = authority, MatchTypeHost, true
+
+	if authority < len(candidate) && candidate[authority] == '/' {
+		length += s.pathLength(candidate[authority:])
+	}
+
+	return
+}
+
+// authorityLength returns the length of the authority (optional userinfo, host and
+// optional port) at the start of candidate, and whether it is an email address.
+func (r *recognizer) authorityLength(candidate string) (length int, isEmail bool) {
+	if r.hostRegex != nil {
+		if loc := r.hostRegex.FindStringIndex(candidate); loc != nil {
+			length = loc[1]
+		}
+
+		isEmail = isEmailAuthority(candidate[:length])
+		length = r.withoutDisallowedUserInfo(candidate[:length], isEmail)
+
+		return
+	}
+
+	userinfo := 0
+
+	if at := strings.IndexByte(candidate, '@'); at > 0 && isUserinfo(candidate[:at]) {
+		userinfo = at + 1
+	}
+
+	host := hostLength(candidate[userinfo:], r.e.emojiDomains)
+
+	if host == 0 && r.e.obfuscatedIPs {
+		host = obfuscatedIPv4Length(candidate[userinfo:])
+	}
+
+	if host == 0 || !r.allowsHost(candidate[userinfo:userinfo+host]) {
+		return
+	}
+
+	length = userinfo + host + portLength(candidate[userinfo+host:])
+
+	isEmail = isEmailAuthority(candidate[:length])
+	length = r.withoutDisallowedUserInfo(candidate[:length], isEmail)
+
+	return
+}
+
+// allowsHost reports whether host, as recognized by hostLength, is allowed by the
+// ExtractorWithoutIPv4Hosts and ExtractorWithoutIPv6Hosts options.
+func (r *recognizer) allowsHost(host string) bool {
+	if strings.HasPrefix(host, "[") {
+		return !r.e.withoutIPv6Hosts
+	}
+
+	if r.e.withoutIPv4Hosts {
+		if addr, err := netip.ParseAddr(host); err == nil && addr.Is4() {
+			return false
+		}
+	}
+
+	return true
+}
+
+// withoutDisallowedUserInfo returns the length of authority, or 0 if the authority has a
+// userinfo (and is not an email address) while ExtractorWithoutUserInfo is set.
+func (r *recognizer) withoutDisallowedUserInfo(authority string, isEmail bool) (length int) {
+	if r.e.withoutUserInfo && !isEmail && strings.Contains(authority, "@") {
+		return
+	}
+
+	length = len(authority)
+
+	return
+}
+
+// isTokenDelimiter reports whether r separates tokens.
+func isTokenDelimiter(r rune) bool {
+	switch r {
+	case '<', '>', '"', '`':
+		return true
+	}
+
+	return unicode.IsSpace(r) || unicode.IsControl(r)
+}
+
+// isPrivateUse reports whether r is a Unicode private-use character.
+func isPrivateUse(r rune) bool {
+	return unicode.Is(unicode.Co, r)
+}
+
+// isEmoji reports whether r is in the emoji ranges matched by ExtractorWithEmojiDomains.
+func isEmoji(r rune) bool {
+	return emojiRegex.MatchString(string(r))
+}
+
+// leadingPunctuationLength returns the length of opening punctuation (e.g., quotes or
+// parentheses) at the start of token that cannot be part of a schemeless URL. With emoji,
+// emoji are kept as the start of a domain.
+func leadingPunctuationLength(token string, emoji bool) (length int) {
+	for i, r := range token {
+		if r == '/' || r == '[' || r == '.' || !(unicode.IsPunct(r) || unicode.IsSymbol(r)) || emoji && isEmoji(r) {
+			break
+		}
+
+		_, size := utf8.DecodeRuneInString(token[i:])
+
+		length = i + size
+	}
+
+	return
+}
+
+// pathLength returns the length of the URL path/query/fragment at the start of s, once
+// trimmed by trimURLEnd, following the Extractor's bracket strategy.
+func (s *scanner) pathLength(path string) (length int) {
+	strategy := s.e.bracketStrategy
+
+	switch {
+	case strategy == StrictBrackets:
+		if i := strings.IndexAny(path, "()[]{}"); i >= 0 {
+			path = path[:i]
+		}
+	case strategy == BalancedBrackets && s.e.withoutBrackets:
+		// Closing brackets are trimmed off, as with StrictBrackets.
+		strategy = StrictBrackets
+	}
+
+	length = trimURLEnd(path, strategy)
+
+	return
+}
+
+// trimURLEnd returns the length of the URL path/query/fragment at the start of s once
+// trailing punctuation (e.g., a sentence's final period) and closing brackets are trimmed
+// off: unbalanced ones with BalancedBrackets, none with GreedyBrackets and all with
+// StrictBrackets.
+func trimURLEnd(s string, strategy BracketStrategy) (length int) {
+	length = len(s)
+
+	for length > 0 {
+		r, size := utf8.DecodeLastRuneInString(s[:length])
+
+		switch {
+		case strings.ContainsRune("/#%$&+=-_~", r):
+			return
+		case r == ')' || r == ']' || r == '}':
+			if strategy == GreedyBrackets || strategy == BalancedBrackets && isBalanced(s[:length], r) {
+				return
+			}
+		case !unicode.IsPunct(r):
+			return
+		}
+
+		length -= size
+	}
+
+	return
+}
+
+// isBalanced reports whether the closing bracket is balanced by an opening bracket in s.
+func isBalanced(s string, closing rune) bool {
+	opening := map[rune]rune{')': '(', ']': '[', '}': '{'}[closing]
+
+	return strings.Count(s, string(opening)) >= strings.Count(s, string(closing))
+}
+
+// isUserinfo reports whether s only contains characters allowed in a userinfo.
+func isUserinfo(s string) bool {
+	for _, r := range s {
+		if !(r >= utf8.RuneSelf && unicode.IsGraphic(r) || isASCIIAlphanumeric(r) || strings.ContainsRune("-._~%!$&'()*+,;=:", r)) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// isEmailAuthority reports whether the authority is an email address, i.e. has a
+// local part (without a password) and no port.
+func isEmailAuthority(authority string) bool {
+	at := strings.LastIndexByte(authority, '@')
+	if at <= 0 {
+		return false
+	}
+
+	return emailLocalPartRegex.MatchString(authority[:at]) && !strings.Contains(authority[at:], ":")
+}
+
+// hostLength returns the length of a known host (domain with a known TLD, localhost,
+// IPv4 address or bracketed IPv6 address) at the start of s. With emoji, the labels of
+// domains may contain emoji.
+func hostLength(s string, emoji bool) (length int) {
+	if strings.HasPrefix(s, "[") {
+		end := strings.IndexByte(s, ']')
+		if end < 0 {
+			return
+		}
+
+		if addr, err := netip.ParseAddr(s[1:end]); err == nil && addr.Is6() {
+			length = end + 1
+		}
+
+		return
+	}
+
+	for i, r := range s {
+		if r != '.' && r != '-' && !unicode.IsLetter(r) && !unicode.IsMark(r) && !unicode.IsNumber(r) && !(emoji && isEmoji(r)) {
+			break
+		}
+
+		length = i + utf8.RuneLen(r)
+	}
+
+	host := strings.TrimRight(s[:length], ".")
+
+	length = len(host)
+
+	if addr, err := netip.ParseAddr(host); err == nil && addr.Is4() {
+		return
+	}
+
+	if !isKnownDomain(host) {
+		length = 0
+	}
+
+	return
+}
+
+// obfuscatedIPv4Length returns the length of an obfuscated IPv4 address (see
+// ObfuscatedIPv4) at the start of s.
+func obfuscatedIPv4Length(s string) (length int) {
+	for length < len(s) && (s[length] == '.' || isASCIIAlphanumeric(rune(s[length]))) {
+		length++
+	}
+
+	host := strings.TrimRight(s[:length], ".")
+
+	length = len(host)
+
+	if _, ok := ObfuscatedIPv4(host); !ok {
+		length = 0
+	}
+
+	return
+}
+
+// isKnownDomain reports whether host is "localhost" or a domain with a known TLD.
+func isKnownDomain(host string) bool {
+	host = strings.ToLower(host)
+
+	if host == "localhost" {
+		return true
+	}
+
+	dot := strings.LastIndexByte(host, '.')
+	if dot <= 0 {
+		return false
+	}
+
+	for _, label := range strings.Split(host, ".") {
+		if label == "" || strings.HasPrefix(label, "-") || strings.HasSuffix(label, "-") {
+			return false
+		}
+	}
+
+	TLD := host[dot+1:]
+
+	_, known := scannerKnownTLDs[TLD]
+
+	return known || strings.HasPrefix(TLD, "xn--")
+}
+
+// portLength returns the length of a port (e.g., ":8080") at the start of s.
+func portLength(s string) (length int) {
+	if !strings.HasPrefix(s, ":") {
+		return
+	}
+
+	digits := 0
+
+	for digits < len(s)-1 && digits < 5 && s[1+digits] >= '0' && s[1+digits] <= '9' {
+		digits++
+	}
+
+	if digits > 0 {
+		length = 1 + digits
+	}
+
+	return
+}
+
+// isASCIIAlphanumeric reports whether r is an ASCII letter or digit.
+func isASCIIAlphanumeric(r rune) bool {
+	return r >= 'a' && r <= 'z' || r >= 'A' && r <= 'Z' || r >= '0' && r <= '9'
+}
+
+// newScanner creates a scanner for the configuration of the given Extractor.
+func newScanner(e *Extractor) (s *scanner) {
+	s = &scanner{
+		e:           e,
+		recognizer:  e.compiledRecognizer(),
+		terminators: terminatorRunes(e.terminators),
+	}
+
+	return
+}
+
+// newRecognizer creates a recognizer for the configuration of the given Extractor.
+func newRecognizer(e *Extractor) (r *recognizer) {
+	r = &recognizer{
+		e:           e,
+		schemeRegex: regexp.MustCompile(e.SchemePattern()),
+	}
+
+	if e.withHostPattern != "" {
+		r.hostRegex = regexp.MustCompile(`^` + _IUserInfoOptionalPattern + `(?:` + e.withHostPattern + `)`)
+		r.hostRegex.Longest()
+	}
+
+	return
+}
+
+var (
+	// scannerKnownTLDs is the set of known TLDs used by the scanner's host recognizer.
+	// Only the last label of a domain is looked up, so the set holds single labels.
+	scannerKnownTLDs = func() (set map[string]struct{}) {
+		set = map[string]struct{}{}
+
+		for _, list := range [][]string{tlds.Official, tlds.Pseudo} {
+			for _, TLD := range list {
+				if !strings.Contains(TLD, ".") {
+					set[TLD] = struct{}{}
+				}
+			}
+		}
+
+		return
+	}()
+
+	relativeURLRegex = func() (regex *regexp.Regexp) {
+		regex = regexp.MustCompile(`^(?:` + _relativeURLsPattern + `)`)
+
+		regex.Longest()
+
+		return
+	}()
+
+	emailLocalPartRegex = regexp.MustCompile(`^` + _emailLocalPartPattern + `$`)
+
+	emojiRegex = regexp.MustCompile(`^[` + _emojiCharacters + `]$`)
+)
@@ -0,0 +1,91 @@
+package url
+
+import "regexp/syntax"
+
+// ExtractorStats describes the regular expression an Extractor compiles and the matching
+// features it enables. It is meant to help tune configurations for performance-sensitive
+// deployments: the larger the pattern and program, the slower compilation and matching.
+type ExtractorStats struct {
+	PatternLength int    // The length, in bytes, of the composite regex pattern.
+	ProgramSize   int    // The number of instructions in the compiled regex program (an estimate of its size).
+	Engine        Engine // The engine used by Extract.
+
+	Emails               bool                 // Whether emails are matched.
+	RelativeURLs         bool                 // Whether relative URLs are matched.
+	SchemeRelativeURLs   bool                 // Whether scheme-relative URLs are matched.
+	FilePaths            bool                 // Whether relative matches that look like local file paths are kept.
+	HostSpoofing         bool                 // Whether matches whose userinfo looks like a host are flagged (or dropped).
+	IPv4Hosts            bool                 // Whether IPv4 hosts are matched.
+	IPv6Hosts            bool                 // Whether bracketed IPv6 hosts are matched.
+	ObfuscatedIPs        bool                 // Whether obfuscated IPv4 hosts are matched and normalized.
+	CustomSchemePattern  bool                 // Whether a custom scheme pattern is used.
+	CustomHostPattern    bool                 // Whether a custom host pattern is used.
+	KnownTLDOnly         bool                 // Whether hosts of URLs with a scheme must end with a known TLD.
+	UserInfo             bool                 // Whether userinfo is matched in authorities.
+	DarknetValidation    bool                 // Whether malformed onion and I2P base32 addresses are dropped.
+	PrivateUseChars      bool                 // Whether Unicode private-use characters are matched in paths.
+	EmojiDomains         bool                 // Whether emoji are matched in the labels of domains.
+	IDNForms             bool                 // Whether the canonical forms of internationalized hosts are reported.
+	Lowercase            bool                 // Whether the schemes and hosts of matches are lowercased.
+	UnicodeNormalization UnicodeNormalization // The Unicode normalization form of matches.
+	InvisibleChars       bool                 // Whether invisible characters are stripped from the input.
+	IntentFallbacks      bool                 // Whether the fallback URLs of intent URIs are surfaced as matches.
+	ExtensionFilter      bool                 // Whether matches are kept or dropped by the file extension of their path.
+	BracketStrategy      BracketStrategy      // How brackets in paths are handled.
+	BracketDepth         int                  // The maximum nesting depth of balanced brackets in paths (0 if not matched).
+	Terminators          string               // The non-ASCII characters ending URLs, sorted.
+}
+
+// Stats reports the size of the regular expression built from the Extractor's configuration
+// and which matching features are enabled. The pattern is built and compiled to a regex
+// program on each call, so the result should be computed once and cached if needed.
+//
+// Returns:
+//   - stats (ExtractorStats): The statistics for the Extractor's configuration.
+func (e *Extractor) Stats() (stats ExtractorStats) {
+	pattern := e.Pattern()
+
+	stats = ExtractorStats{
+		PatternLength:        len(pattern),
+		Engine:               e.engine,
+		Emails:               !e.withScheme,
+		RelativeURLs:         !e.withScheme && !e.withHost,
+		SchemeRelativeURLs:   e.schemeRelative,
+		FilePaths:            !e.withScheme && !e.withHost && !e.withoutFilePaths,
+		HostSpoofing:         e.hostSpoofing || e.withoutSpoofing,
+		IPv4Hosts:            e.withHostPattern == "" && !e.withoutIPv4Hosts,
+		IPv6Hosts:            e.withHostPattern == "" && !e.withoutIPv6Hosts,
+		ObfuscatedIPs:        e.withHostPattern == "" && e.obfuscatedIPs,
+		CustomSchemePattern:  e.withScheme && e.withSchemePattern != "",
+		CustomHostPattern:    e.withHostPattern != "",
+		KnownTLDOnly:         e.knownTLDOnly,
+		UserInfo:             !e.withoutUserInfo,
+		DarknetValidation:    e.validateDarknet,
+		PrivateUseChars:      e.privateUseChars,
+		EmojiDomains:         e.emojiDomains,
+		IDNForms:             e.idnForms,
+		Lowercase:            e.lowercase,
+		UnicodeNormalization: e.normalization,
+		InvisibleChars:       e.stripInvisible,
+		IntentFallbacks:      e.intentFallbacks,
+		ExtensionFilter:      len(e.withExtensions) > 0 || len(e.withoutExtensions) > 0,
+		BracketStrategy:      e.bracketStrategy,
+		BracketDepth:         e.brackets(),
+		Terminators:          string(terminatorRunes(e.terminators)),
+	}
+
+	// An invalid custom pattern leaves the program size unknown (zero).
+	re, err := syntax.Parse(pattern, syntax.Perl)
+	if err != nil {
+		return
+	}
+
+	prog, err := syntax.Compile(re.Simplify())
+	if err != nil {
+		return
+	}
+
+	stats.ProgramSize = len(prog.Inst)
+
+	return
+}
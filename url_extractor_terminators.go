@@ -0,0 +1,90 @@
+package url
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+const (
+	// ExtractorCJKTerminators are the fullwidth and ideographic punctuation marks of
+	// Chinese, Japanese and Korean text (commas, full stops, colons, exclamation and
+	// question marks, brackets and quotation marks), which are not separated from URLs by
+	// spaces.
+	ExtractorCJKTerminators = "，。、；：！？「」『』【】《》〈〉（）"
+	// ExtractorArabicTerminators are the Arabic comma, semicolon and question mark.
+	ExtractorArabicTerminators = "،؛؟"
+	// ExtractorDevanagariTerminators are the Devanagari danda and double danda, which end
+	// sentences in Hindi and other languages of India.
+	ExtractorDevanagariTerminators = "।॥"
+)
+
+// ExtractorDefaultTerminators are the characters ending URLs in Extractors configured with
+// ExtractorWithDefaultTerminators: the CJK, Arabic and Devanagari terminators, except for
+// the fullwidth exclamation and question marks, which titles in the paths of CJK URLs
+// commonly contain. It can be changed once, before creating Extractors.
+var ExtractorDefaultTerminators = "，。、；：「」『』【】《》〈〉（）،؛" + ExtractorDevanagariTerminators
+
+// withoutTerminators returns the regex character class body of the characters of table,
+// except for terminators.
+func withoutTerminators(table *unicode.RangeTable, terminators []rune) (class string) {
+	var b strings.Builder
+
+	write := func(lo, hi rune) {
+		switch {
+		case lo > hi:
+		case lo == hi:
+			fmt.Fprintf(&b, `\x{%X}`, lo)
+		default:
+			fmt.Fprintf(&b, `\x{%X}-\x{%X}`, lo, hi)
+		}
+	}
+
+	ranges := make([][2]rune, 0, len(table.R16)+len(table.R32))
+
+	for _, r := range table.R16 {
+		ranges = append(ranges, [2]rune{rune(r.Lo), rune(r.Hi)})
+	}
+
+	for _, r := range table.R32 {
+		ranges = append(ranges, [2]rune{rune(r.Lo), rune(r.Hi)})
+	}
+
+	for _, r := range ranges {
+		lo := r[0]
+
+		for _, terminator := range terminators {
+			if terminator < lo || terminator > r[1] {
+				continue
+			}
+
+			write(lo, terminator-1)
+
+			lo = terminator + 1
+		}
+
+		write(lo, r[1])
+	}
+
+	class = b.String()
+
+	return
+}
+
+// terminatorRunes returns the non-ASCII characters of terminators, sorted and
+// deduplicated. ASCII punctuation is already kept from ending URLs by the RFC 3986 rules.
+func terminatorRunes(terminators string) (runes []rune) {
+	for _, r := range terminators {
+		if r >= utf8.RuneSelf {
+			runes = append(runes, r)
+		}
+	}
+
+	slices.Sort(runes)
+
+	runes = slices.Compact(runes)
+
+	return
+}
@@ -0,0 +1,159 @@
+package url
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+)
+
+// UNCToFileURL converts a Windows UNC path (e.g., `\\server\share\dir\file.txt`) to the
+// equivalent file URL (e.g., "file://server/share/dir/file.txt"), percent-encoding the
+// path segments as needed. Long UNC paths (`\\?\UNC\server\share\...`) are supported, and
+// forward slashes are accepted as separators.
+//
+// Parameters:
+//   - unc (string): The UNC path.
+//
+// Returns:
+//   - fileURL (string): The file URL.
+//   - err (error): ErrEmptyInput, or ErrInvalidURL (wrapped) if unc is not a UNC path.
+func UNCToFileURL(unc string) (fileURL string, err error) {
+	if unc == "" {
+		err = fmt.Errorf("%w: UNC path", ErrEmptyInput)
+
+		return
+	}
+
+	normalized := strings.ReplaceAll(unc, `\`, "/")
+
+	if rest, found := strings.CutPrefix(normalized, "//?/"); found {
+		if len(rest) < 4 || !strings.EqualFold(rest[:4], "UNC/") {
+			err = fmt.Errorf("%w: not a UNC path: %q", ErrInvalidURL, unc)
+
+			return
+		}
+
+		normalized = "//" + rest[4:]
+	}
+
+	rest, found := strings.CutPrefix(normalized, "//")
+	if !found {
+		err = fmt.Errorf("%w: not a UNC path: %q", ErrInvalidURL, unc)
+
+		return
+	}
+
+	server, path, _ := strings.Cut(rest, "/")
+	if server == "" {
+		err = fmt.Errorf("%w: UNC path without server: %q", ErrInvalidURL, unc)
+
+		return
+	}
+
+	fileURL = (&url.URL{Scheme: "file", Host: server, Path: "/" + path}).String()
+
+	return
+}
+
+// FileURLToUNC converts a file URL with a host (e.g., "file://server/share/dir/file.txt")
+// to the equivalent Windows UNC path (e.g., `\\server\share\dir\file.txt`), decoding
+// percent-encoded characters.
+//
+// Parameters:
+//   - fileURL (string): The file URL.
+//
+// Returns:
+//   - unc (string): The UNC path.
+//   - err (error): ErrEmptyInput, ErrUnsupportedScheme (wrapped) if the URL is not a file
+//     URL, or ErrInvalidURL (wrapped) if it cannot be parsed or has no (remote) host.
+func FileURLToUNC(fileURL string) (unc string, err error) {
+	parsed, err := parseFileURL(fileURL)
+	if err != nil {
+		return
+	}
+
+	if parsed.Host == "" || strings.EqualFold(parsed.Host, "localhost") {
+		err = fmt.Errorf("%w: file URL without remote host: %q", ErrInvalidURL, fileURL)
+
+		return
+	}
+
+	unc = `\\` + parsed.Host + strings.ReplaceAll(parsed.Path, "/", `\`)
+
+	return
+}
+
+// FileURLToPath decodes a file URL into the local path it refers to, whatever the platform
+// the program runs on, so that logs from Windows and Unix machines can be processed alike:
+//   - URLs with a drive letter (e.g., "file:///C:/Program%20Files/x.exe", or the legacy
+//     "file:///C|/x.exe") decode to Windows paths (`C:\Program Files\x.exe`).
+//   - URLs with a remote host (e.g., "file://server/share/x") decode to UNC paths
+//     (`\\server\share\x`).
+//   - Other URLs (e.g., "file:///etc/hosts" or "file://localhost/etc/hosts") decode to
+//     Unix paths ("/etc/hosts").
+//
+// Parameters:
+//   - fileURL (string): The file URL.
+//
+// Returns:
+//   - path (string): The local path.
+//   - err (error): ErrEmptyInput, ErrUnsupportedScheme (wrapped) if the URL is not a file
+//     URL, or ErrInvalidURL (wrapped) if it cannot be parsed.
+func FileURLToPath(fileURL string) (path string, err error) {
+	parsed, err := parseFileURL(fileURL)
+	if err != nil {
+		return
+	}
+
+	if parsed.Host != "" && !strings.EqualFold(parsed.Host, "localhost") {
+		path = `\\` + parsed.Host + strings.ReplaceAll(parsed.Path, "/", `\`)
+
+		return
+	}
+
+	path = parsed.Path
+
+	// Drive letters: "/C:/dir" (or "/C|/dir"), and "C:/dir" in "file:C:/dir".
+	drive := strings.TrimPrefix(path, "/")
+
+	if len(drive) >= 2 && isASCIILetter(drive[0]) && (drive[1] == ':' || drive[1] == '|') && (len(drive) == 2 || drive[2] == '/') {
+		path = drive[:1] + ":" + strings.ReplaceAll(drive[2:], "/", `\`)
+
+		if len(drive) == 2 {
+			path += `\`
+		}
+	}
+
+	return
+}
+
+// parseFileURL parses a file URL.
+func parseFileURL(fileURL string) (parsed *url.URL, err error) {
+	if fileURL == "" {
+		err = fmt.Errorf("%w: file URL", ErrEmptyInput)
+
+		return
+	}
+
+	parsed, err = url.Parse(fileURL)
+	if err != nil {
+		err = fmt.Errorf("%w: %w", ErrInvalidURL, err)
+
+		return
+	}
+
+	if !strings.EqualFold(parsed.Scheme, "file") {
+		err = fmt.Errorf("%w: %q is not a file URL", ErrUnsupportedScheme, fileURL)
+
+		return
+	}
+
+	// "file:C:/dir" has an opaque part rather than a path.
+	if parsed.Opaque != "" {
+		if parsed.Path, err = url.PathUnescape(parsed.Opaque); err != nil {
+			err = fmt.Errorf("%w: %w", ErrInvalidURL, err)
+		}
+	}
+
+	return
+}
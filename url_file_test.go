@@ -0,0 +1,102 @@
+package url_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	hqgourl "go.source.hueristiq.com/url"
+)
+
+func TestUNCToFileURL(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		unc     string
+		fileURL string
+	}{
+		{`\\server\share\dir\file.txt`, "file://server/share/dir/file.txt"},
+		{`\\server\share\My Documents\a#1.txt`, "file://server/share/My%20Documents/a%231.txt"},
+		{`\\?\UNC\server\share\file.txt`, "file://server/share/file.txt"},
+		{`//server/share`, "file://server/share"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.unc, func(t *testing.T) {
+			t.Parallel()
+
+			fileURL, err := hqgourl.UNCToFileURL(tt.unc)
+
+			require.NoError(t, err)
+
+			assert.Equal(t, tt.fileURL, fileURL)
+		})
+	}
+
+	for _, unc := range []string{`C:\dir`, `\\`, `\\?\C:\dir`} {
+		_, err := hqgourl.UNCToFileURL(unc)
+
+		require.ErrorIs(t, err, hqgourl.ErrInvalidURL)
+	}
+
+	_, err := hqgourl.UNCToFileURL("")
+
+	require.ErrorIs(t, err, hqgourl.ErrEmptyInput)
+}
+
+func TestFileURLToUNC(t *testing.T) {
+	t.Parallel()
+
+	unc, err := hqgourl.FileURLToUNC("file://server/share/My%20Documents/a%231.txt")
+
+	require.NoError(t, err)
+
+	assert.Equal(t, `\\server\share\My Documents\a#1.txt`, unc)
+
+	_, err = hqgourl.FileURLToUNC("file:///etc/hosts")
+
+	require.ErrorIs(t, err, hqgourl.ErrInvalidURL)
+
+	_, err = hqgourl.FileURLToUNC("https://server/share")
+
+	require.ErrorIs(t, err, hqgourl.ErrUnsupportedScheme)
+}
+
+func TestFileURLToPath(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		fileURL string
+		path    string
+	}{
+		{"file:///C:/Program%20Files/x.exe", `C:\Program Files\x.exe`},
+		{"file:///c|/Windows/win.ini", `c:\Windows\win.ini`},
+		{"file:C:/dir/a.txt", `C:\dir\a.txt`},
+		{"file:///D:", `D:\`},
+		{"FILE://localhost/C:/a", `C:\a`},
+		{"file://server/share/a%20b", `\\server\share\a b`},
+		{"file:///etc/hosts", "/etc/hosts"},
+		{"file://localhost/var/log/a%25.log", "/var/log/a%.log"},
+		{"file:///Cdrive/a", "/Cdrive/a"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.fileURL, func(t *testing.T) {
+			t.Parallel()
+
+			path, err := hqgourl.FileURLToPath(tt.fileURL)
+
+			require.NoError(t, err)
+
+			assert.Equal(t, tt.path, path)
+		})
+	}
+
+	_, err := hqgourl.FileURLToPath("")
+
+	require.ErrorIs(t, err, hqgourl.ErrEmptyInput)
+
+	_, err = hqgourl.FileURLToPath("ftp://example.com/a")
+
+	require.ErrorIs(t, err, hqgourl.ErrUnsupportedScheme)
+}
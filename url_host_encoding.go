@@ -0,0 +1,62 @@
+package url
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+	"unicode/utf8"
+)
+
+// decodeHost decodes the host of the authority of unparsed if it is percent-encoded (e.g.,
+// "http://%65xample.com/"), which net/url rejects for ASCII characters but browsers accept,
+// and validates the decoded host, which must be a known host (see hostLength). Bracketed
+// IPv6 hosts are left as they are, their zones being percent-encoded by design.
+//
+// Parameters:
+//   - unparsed (string): The URL, with its scheme if any.
+//   - emoji (bool): Whether the labels of domains may contain emoji.
+//
+// Returns:
+//   - decoded (string): The URL with its host decoded, or unparsed if it is not encoded.
+//   - encoded (string): The encoded host, or an empty string if it is not encoded.
+//   - err (error): ErrInvalidURL (wrapped) if the decoded host is not a valid host.
+func decodeHost(unparsed string, emoji bool) (decoded, encoded string, err error) {
+	decoded = unparsed
+
+	i := strings.Index(unparsed, "//")
+	if i < 0 || i > 0 && unparsed[i-1] != ':' || strings.ContainsAny(unparsed[:i], "?#") {
+		return
+	}
+
+	start, end := i+2, len(unparsed)
+
+	if j := strings.IndexAny(unparsed[start:], "/?#"); j >= 0 {
+		end = start + j
+	}
+
+	if at := strings.LastIndexByte(unparsed[start:end], '@'); at >= 0 {
+		start += at + 1
+	}
+
+	host := unparsed[start:end]
+
+	if colon := strings.IndexByte(host, ':'); colon >= 0 {
+		host = host[:colon]
+	}
+
+	if strings.HasPrefix(host, "[") || !strings.Contains(host, "%") {
+		return
+	}
+
+	unescaped, err := url.PathUnescape(host)
+	if err != nil || !utf8.ValidString(unescaped) || hostLength(unescaped, emoji) != len(strings.TrimRight(unescaped, ".")) {
+		err = fmt.Errorf("%w: invalid percent-encoded host %q", ErrInvalidURL, host)
+
+		return
+	}
+
+	decoded = unparsed[:start] + unescaped + unparsed[start+len(host):]
+	encoded = host
+
+	return
+}
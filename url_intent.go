@@ -0,0 +1,150 @@
+package url
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+)
+
+// Intent holds the components of an Android intent URI (e.g.,
+// "intent://scan/#Intent;scheme=zxing;package=com.google.zxing.client.android;end"), as
+// produced by Intent.toUri(URI_INTENT_SCHEME) and launched by browsers, decoded.
+type Intent struct {
+	Data        string            // The data URI, "scheme://" followed by the part before "#Intent", if any.
+	Scheme      string            // The scheme of the data URI ("scheme="), if any.
+	Package     string            // The package of the app to launch ("package="), if any.
+	Action      string            // The action ("action="), if any.
+	Categories  []string          // The categories ("category="), if any.
+	Component   string            // The component ("component="), if any.
+	MIMEType    string            // The MIME type of the data ("type="), if any.
+	FallbackURL string            // The URL browsers open if no app handles the intent ("S.browser_fallback_url="), if any.
+	Extras      map[string]string // The extras, keyed with their type prefix (e.g., "S.key" or "i.key"), if any.
+}
+
+// ParseIntent parses an Android intent URI. Its fragment holds ";"-separated "key=value"
+// pairs between "#Intent;" and "end", with percent-encoded values; unknown keys are
+// ignored, and the keys of the selector intent ("SEL") are skipped.
+//
+// Parameters:
+//   - raw (string): The intent URI.
+//
+// Returns:
+//   - intent (*Intent): The components of the intent.
+//   - err (error): ErrEmptyInput, ErrUnsupportedScheme (wrapped) if the URI is not an
+//     intent URI, or ErrInvalidURL (wrapped) if it is malformed.
+func ParseIntent(raw string) (intent *Intent, err error) {
+	if raw == "" {
+		err = fmt.Errorf("%w: intent URI", ErrEmptyInput)
+
+		return
+	}
+
+	scheme, rest, found := strings.Cut(raw, ":")
+	if !found || !strings.EqualFold(scheme, "intent") {
+		err = fmt.Errorf("%w: %q is not an intent URI", ErrUnsupportedScheme, raw)
+
+		return
+	}
+
+	data, fragment, found := strings.Cut(rest, "#Intent;")
+	if !found {
+		err = fmt.Errorf("%w: intent URI without \"#Intent;\": %q", ErrInvalidURL, raw)
+
+		return
+	}
+
+	fragment, found = strings.CutSuffix(fragment, "end")
+	if !found {
+		err = fmt.Errorf("%w: intent URI without \"end\": %q", ErrInvalidURL, raw)
+
+		return
+	}
+
+	intent = &Intent{}
+
+	for _, pair := range strings.Split(fragment, ";") {
+		if pair == "" {
+			continue
+		}
+
+		if pair == "SEL" {
+			break
+		}
+
+		key, value, _ := strings.Cut(pair, "=")
+
+		if value, err = url.PathUnescape(value); err != nil {
+			intent = nil
+
+			err = fmt.Errorf("%w: intent URI with malformed %q value: %w", ErrInvalidURL, key, err)
+
+			return
+		}
+
+		switch key {
+		case "scheme":
+			intent.Scheme = value
+		case "package":
+			intent.Package = value
+		case "action":
+			intent.Action = value
+		case "category":
+			intent.Categories = append(intent.Categories, value)
+		case "component":
+			intent.Component = value
+		case "type":
+			intent.MIMEType = value
+		default:
+			if len(key) < 3 || key[1] != '.' || !strings.ContainsRune("SBbcdfils", rune(key[0])) {
+				continue
+			}
+
+			if key == "S.browser_fallback_url" {
+				intent.FallbackURL = value
+			}
+
+			if intent.Extras == nil {
+				intent.Extras = map[string]string{}
+			}
+
+			intent.Extras[key] = value
+		}
+	}
+
+	if intent.Scheme != "" && data != "" {
+		intent.Data = intent.Scheme + ":" + data
+	}
+
+	return
+}
+
+// intentFallbackKey is the key of the extra holding the fallback URL of intent URIs.
+const intentFallbackKey = ";S.browser_fallback_url="
+
+// intentFallback returns the fallback URL embedded in match, if it is an intent URI, as a
+// match spanning the encoded fallback URL.
+func intentFallback(match Match) (fallback Match, ok bool) {
+	if match.Type != MatchTypeURL || len(match.Value) < 7 || !strings.EqualFold(match.Value[:7], "intent:") {
+		return
+	}
+
+	intent, err := ParseIntent(match.Value)
+	if err != nil || !strings.Contains(intent.FallbackURL, "://") {
+		return
+	}
+
+	start := strings.Index(match.Value, intentFallbackKey) + len(intentFallbackKey)
+	end := start + strings.IndexByte(match.Value[start:], ';')
+
+	fallback = Match{
+		Value:          intent.FallbackURL,
+		Start:          match.Start + start,
+		End:            match.Start + end,
+		Type:           MatchTypeURL,
+		IntentFallback: true,
+	}
+
+	ok = true
+
+	return
+}
@@ -0,0 +1,62 @@
+package url_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	hqgourl "go.source.hueristiq.com/url"
+)
+
+func TestParseIntent(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		raw    string
+		intent *hqgourl.Intent
+	}{
+		{
+			"intent://scan/#Intent;scheme=zxing;package=com.google.zxing.client.android;S.browser_fallback_url=https%3A%2F%2Fexample.com%2Fapp;end",
+			&hqgourl.Intent{
+				Data:        "zxing://scan/",
+				Scheme:      "zxing",
+				Package:     "com.google.zxing.client.android",
+				FallbackURL: "https://example.com/app",
+				Extras:      map[string]string{"S.browser_fallback_url": "https://example.com/app"},
+			},
+		},
+		{
+			"intent:#Intent;action=android.intent.action.VIEW;category=android.intent.category.BROWSABLE;component=com.example/.Main;i.count=3;SEL;package=com.other;end",
+			&hqgourl.Intent{
+				Action:     "android.intent.action.VIEW",
+				Categories: []string{"android.intent.category.BROWSABLE"},
+				Component:  "com.example/.Main",
+				Extras:     map[string]string{"i.count": "3"},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.raw, func(t *testing.T) {
+			t.Parallel()
+
+			intent, err := hqgourl.ParseIntent(tt.raw)
+
+			require.NoError(t, err)
+
+			assert.Equal(t, tt.intent, intent)
+		})
+	}
+
+	_, err := hqgourl.ParseIntent("")
+
+	require.ErrorIs(t, err, hqgourl.ErrEmptyInput)
+
+	_, err = hqgourl.ParseIntent("https://example.com/#Intent;end")
+
+	require.ErrorIs(t, err, hqgourl.ErrUnsupportedScheme)
+
+	_, err = hqgourl.ParseIntent("intent://scan/#Intent;package=com.example")
+
+	require.ErrorIs(t, err, hqgourl.ErrInvalidURL)
+}
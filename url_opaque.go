@@ -0,0 +1,74 @@
+package url
+
+import (
+	"net/url"
+	"strings"
+
+	"go.source.hueristiq.com/url/schemes"
+)
+
+// SchemeSpecificPart returns the part of the URL between its scheme and its query, decoded:
+// for opaque URLs, such as those of the no-authority schemes of schemes.NoAuthority (e.g.,
+// "user@example.com" for "mailto:user@example.com?subject=hi" or "+1-201-555-0123" for
+// "tel:+1-201-555-0123;ext=42"), the opaque part without its ";"-separated parameters,
+// which SchemeParams returns. For no-authority schemes without an opaque part, it is the
+// path (e.g., "/etc/hosts" for "file:///etc/hosts"), and for other URLs the authority and
+// the path (e.g., "//example.com/a").
+//
+// Returns:
+//   - part (string): The scheme-specific part.
+func (u *URL) SchemeSpecificPart() (part string) {
+	switch {
+	case u.Opaque != "":
+		part, _, _ = strings.Cut(u.Opaque, ";")
+
+		if unescaped, err := url.PathUnescape(part); err == nil {
+			part = unescaped
+		}
+	case schemes.IsNoAuthority(u.Scheme) && u.Host == "":
+		part = u.Path
+	default:
+		part = "//" + u.Host + u.Path
+	}
+
+	return
+}
+
+// SchemeParams returns the parameters of the URL, decoded: those of its query (e.g.,
+// "subject" for "mailto:user@example.com?subject=hi", or "amount" for
+// "bitcoin:1BvBMSEYstWetqTFn5Au4m4GFg7xJaNVN2?amount=0.5") and, for opaque URLs, the
+// ";"-separated ones following the scheme-specific part (e.g., "ext" for
+// "tel:+1-201-555-0123;ext=42", as in RFC 3966). Parameters without "=" have an empty
+// value.
+//
+// Returns:
+//   - params (url.Values): The parameters, empty if there are none.
+func (u *URL) SchemeParams() (params url.Values) {
+	params = u.Query()
+
+	if u.Opaque == "" {
+		return
+	}
+
+	_, rest, _ := strings.Cut(u.Opaque, ";")
+
+	for _, param := range strings.Split(rest, ";") {
+		if param == "" {
+			continue
+		}
+
+		key, value, _ := strings.Cut(param, "=")
+
+		if unescaped, err := url.PathUnescape(key); err == nil {
+			key = unescaped
+		}
+
+		if unescaped, err := url.PathUnescape(value); err == nil {
+			value = unescaped
+		}
+
+		params.Add(key, value)
+	}
+
+	return
+}
@@ -0,0 +1,40 @@
+package url_test
+
+import (
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	hqgourl "go.source.hueristiq.com/url"
+)
+
+func TestURL_SchemeSpecificPart(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		raw    string
+		part   string
+		params url.Values
+	}{
+		{"mailto:john%20doe@example.com?subject=hi&cc=jane@example.com", "john doe@example.com", url.Values{"subject": {"hi"}, "cc": {"jane@example.com"}}},
+		{"tel:+1-201-555-0123;ext=42;isub", "+1-201-555-0123", url.Values{"ext": {"42"}, "isub": {""}}},
+		{"bitcoin:1BvBMSEYstWetqTFn5Au4m4GFg7xJaNVN2?amount=0.5&label=Donation", "1BvBMSEYstWetqTFn5Au4m4GFg7xJaNVN2", url.Values{"amount": {"0.5"}, "label": {"Donation"}}},
+		{"magnet:?xt=urn:btih:c12fe1c06bba254a9dc9f519b335aa7c1367a88a&dn=file", "", url.Values{"xt": {"urn:btih:c12fe1c06bba254a9dc9f519b335aa7c1367a88a"}, "dn": {"file"}}},
+		{"file:///etc/hosts", "/etc/hosts", url.Values{}},
+		{"https://example.com/a?b=c", "//example.com/a", url.Values{"b": {"c"}}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.raw, func(t *testing.T) {
+			t.Parallel()
+
+			parsed, err := hqgourl.NewParser().Parse(tt.raw)
+
+			require.NoError(t, err)
+
+			assert.Equal(t, tt.part, parsed.SchemeSpecificPart())
+			assert.Equal(t, tt.params, parsed.SchemeParams())
+		})
+	}
+}
@@ -2,8 +2,12 @@ package url
 
 import (
 	"fmt"
+	"log/slog"
 	"net/url"
+	"regexp"
 	"strings"
+	"sync"
+	"time"
 )
 
 // Parser is responsible for parsing URLs while also handling domain-related parsing through
@@ -43,6 +47,18 @@ type Parser struct {
 	dp *DomainParser
 
 	scheme string
+
+	relativeSupport bool
+
+	emojiDomains bool
+
+	backslashes bool
+
+	cache *parseCache
+
+	metrics Metrics
+
+	logger *slog.Logger
 }
 
 // Parse takes a raw URL string and parses it into a custom URL struct that includes:
@@ -50,7 +66,9 @@ type Parser struct {
 //   - Domain-specific details such as subdomain, root domain, and TLD.
 //
 // If the URL does not specify a scheme, the default scheme (if any) is added.
-// The method also validates and parses the host and port (if specified).
+// The method also validates and parses the host and port (if specified). Percent-encoded
+// hosts (e.g., "http://%65xample.com/") are decoded, as browsers do, and reported in the
+// EncodedHost field.
 //
 // Parameters:
 //   - unparsed (string): The raw URL string to parse.
@@ -58,28 +76,99 @@ type Parser struct {
 // Returns:
 //   - parsed (*URL): A pointer to the parsed URL struct containing both standard URL components
 //     and domain-specific details.
-//   - err (error): An error if the URL cannot be parsed.
+//   - err (error): ErrEmptyInput, or ErrInvalidURL (wrapped) if the URL cannot be parsed or
+//     its host is percent-encoded but not a valid host once decoded.
 func (p *Parser) Parse(unparsed string) (parsed *URL, err error) {
-	parsed = &URL{}
+	if p.metrics != nil {
+		defer func(start time.Time) { p.observeParse(start, err) }(time.Now())
+	}
 
-	if p.scheme != "" {
+	if p.cache == nil {
+		parsed, err = p.parse(unparsed)
+
+		return
+	}
+
+	if cached, ok := p.cache.get(unparsed); ok {
+		parsed = cached
+
+		return
+	}
+
+	parsed, err = p.parse(unparsed)
+	if err == nil {
+		p.cache.put(unparsed, parsed)
+	}
+
+	return
+}
+
+// parse parses a raw URL string, without caching.
+func (p *Parser) parse(unparsed string) (parsed *URL, err error) {
+	parsed = &URL{
+		Raw: unparsed,
+	}
+
+	if unparsed == "" {
+		err = fmt.Errorf("%w: URL", ErrEmptyInput)
+
+		return
+	}
+
+	if p.backslashes {
+		unparsed = normalizeBackslashes(unparsed)
+	}
+
+	switch {
+	case p.relativeSupport:
+		unparsed = p.resolveSchemeless(unparsed)
+	case p.scheme != "":
 		unparsed = addScheme(unparsed, p.scheme)
 	}
 
+	unparsed, parsed.EncodedHost, err = decodeHost(unparsed, p.emojiDomains)
+	if err != nil {
+		return
+	}
+
+	if p.logger != nil && unparsed != parsed.Raw {
+		p.logger.Debug("rewrote URL before parsing", "url", parsed.Raw, "rewritten", unparsed)
+	}
+
 	parsed.URL, err = url.Parse(unparsed)
 	if err != nil {
-		err = fmt.Errorf("error parsing URL: %w", err)
+		err = fmt.Errorf("%w: %w", ErrInvalidURL, err)
 
 		return
 	}
 
-	if NewDomainExtractor().CompileRegex().MatchString(parsed.Hostname()) {
+	domainRegex := parserDomainRegex()
+
+	if p.emojiDomains {
+		domainRegex = parserEmojiDomainRegex()
+	}
+
+	if domainRegex.MatchString(parsed.Hostname()) {
 		parsed.Domain = p.dp.Parse(parsed.Hostname())
+	} else if p.logger != nil && parsed.Hostname() != "" {
+		p.logger.Debug("host is not a domain, domain left unparsed", "url", parsed.Raw, "host", parsed.Hostname())
 	}
 
 	return
 }
 
+// parserDomainRegex and parserEmojiDomainRegex return the regexes Parse checks hosts are
+// domains with, without and with emoji in their labels. They depend on no Parser option, so
+// they are compiled once, on first use, and shared by all Parsers.
+var (
+	parserDomainRegex = sync.OnceValue(func() *regexp.Regexp {
+		return NewDomainExtractor().CompileRegex()
+	})
+	parserEmojiDomainRegex = sync.OnceValue(func() *regexp.Regexp {
+		return NewDomainExtractor(DomainExtractorWithEmojiDomains()).CompileRegex()
+	})
+)
+
 // ParserOptionFunc defines a function type for configuring a Parser instance.
 // It is used to apply various options such as setting the default scheme.
 //
@@ -133,6 +222,151 @@ func ParserWithDefaultScheme(scheme string) ParserOptionFunc {
 	}
 }
 
+// ParserWithRelativeSupport returns a `ParserOptionFunc` that makes the Parser distinguish
+// relative references (e.g., "/path", "../path" or "path/to/file") from schemeless absolute
+// URLs (e.g., "example.com/path") using the extractor's host heuristics: an input whose
+// leading segment is a known host (a domain with a known TLD, localhost or an IP address,
+// with optional userinfo and port) is parsed as an absolute URL, with the default scheme
+// if one is set (or as a network-path reference, "//example.com/path", otherwise). Any
+// other input without a scheme is parsed as a relative reference and left unchanged,
+// instead of having the default scheme prepended.
+//
+// Returns:
+//   - A `ParserOptionFunc` that enables relative reference support.
+func ParserWithRelativeSupport() ParserOptionFunc {
+	return func(p *Parser) {
+		p.relativeSupport = true
+	}
+}
+
+// ParserWithEmojiDomains returns a `ParserOptionFunc` that makes the Parser recognize
+// domains with emoji in their labels (e.g., "i❤.ws"), so that their Domain is set and
+// they are taken for hosts by ParserWithRelativeSupport. IRIToURI converts such hosts
+// to punycode.
+//
+// Returns:
+//   - A `ParserOptionFunc` that enables emoji domain support.
+func ParserWithEmojiDomains() ParserOptionFunc {
+	return func(p *Parser) {
+		p.emojiDomains = true
+	}
+}
+
+// ParserWithBackslashNormalization returns a `ParserOptionFunc` that makes the Parser
+// tolerate backslashes as browsers do, following the WHATWG URL Standard: in URLs with a
+// special scheme (ftp, file, http, https, ws and wss), and in scheme-relative and
+// schemeless ones, backslashes before the query are taken for slashes, so that
+// "https:\\example.com\path", as found in Windows-generated content, is parsed as
+// "https://example.com/path". Raw keeps the input as given.
+//
+// Returns:
+//   - A `ParserOptionFunc` that enables backslash normalization.
+func ParserWithBackslashNormalization() ParserOptionFunc {
+	return func(p *Parser) {
+		p.backslashes = true
+	}
+}
+
+// ParserWithCache returns a `ParserOptionFunc` that makes the Parser memoize the results
+// of Parse for identical raw strings, keeping the most recently used size of them. This
+// pays off when the same URLs repeat many times, as in log processing. Parse returns a
+// copy of the cached URL on each call, so callers may modify the URLs they get. Errors
+// are not cached. A size below 1 disables caching.
+//
+// Parameters:
+//   - size (int): The maximum number of cached URLs.
+//
+// Returns:
+//   - A `ParserOptionFunc` that enables caching.
+func ParserWithCache(size int) ParserOptionFunc {
+	return func(p *Parser) {
+		p.cache = nil
+
+		if size > 0 {
+			p.cache = newParseCache(size)
+		}
+	}
+}
+
+// ParserWithMetrics returns a `ParserOptionFunc` that reports the duration of each call to
+// Parse, and its failures, to metrics.
+//
+// Parameters:
+//   - metrics (Metrics): The metrics to report to.
+//
+// Returns:
+//   - A `ParserOptionFunc` that enables metrics.
+func ParserWithMetrics(metrics Metrics) ParserOptionFunc {
+	return func(p *Parser) {
+		p.metrics = metrics
+	}
+}
+
+// ParserWithLogger returns a `ParserOptionFunc` that logs the fallbacks of Parse at debug
+// level to logger: inputs rewritten before parsing (e.g., with the default scheme added)
+// and hosts left without domain components because they are not domains.
+//
+// Parameters:
+//   - logger (*slog.Logger): The logger to log to.
+//
+// Returns:
+//   - A `ParserOptionFunc` that enables logging.
+func ParserWithLogger(logger *slog.Logger) ParserOptionFunc {
+	return func(p *Parser) {
+		p.logger = logger
+	}
+}
+
+// resolveSchemeless prepares an input for parsing with relative reference support.
+func (p *Parser) resolveSchemeless(unparsed string) (resolved string) {
+	resolved = unparsed
+
+	// Network-path references ("//example.com/path").
+	if strings.HasPrefix(unparsed, "//") {
+		if p.scheme != "" {
+			resolved = p.scheme + ":" + unparsed
+		}
+
+		return
+	}
+
+	head := unparsed
+
+	if i := strings.IndexAny(unparsed, "/?#"); i >= 0 {
+		head = unparsed[:i]
+	}
+
+	// Inputs with a scheme (e.g., "https:" followed by "//", or "mailto:user@example.com")
+	// and relative references are left unchanged.
+	if scheme, _, found := strings.Cut(head, ":"); found && ValidateScheme(scheme) == nil {
+		return
+	}
+
+	if head == "" || !isHostAuthority(head, p.emojiDomains) {
+		return
+	}
+
+	if p.scheme != "" {
+		resolved = p.scheme + "://" + unparsed
+	} else {
+		resolved = "//" + unparsed
+	}
+
+	return
+}
+
+// isHostAuthority reports whether authority is a known host with optional userinfo and
+// port, allowing emoji in the labels of domains with emoji.
+func isHostAuthority(authority string, emoji bool) bool {
+	if at := strings.LastIndexByte(authority, '@'); at > 0 && isUserinfo(authority[:at]) {
+		authority = authority[at+1:]
+	}
+
+	host := hostLength(authority, emoji)
+
+	return host > 0 && host+portLength(authority[host:]) == len(authority)
+}
+
 // addScheme is a helper function that adds a scheme to a URL string if it is missing.
 // This ensures that URLs without schemes are treated as absolute URLs instead of relative paths.
 //
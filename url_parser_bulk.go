@@ -0,0 +1,167 @@
+package url
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"slices"
+)
+
+// ParseNDJSONField reads newline-delimited JSON records from r, parses the URL held in the
+// given field of each record and writes the record to w, augmented with the URL's
+// components as "<field>_scheme", "<field>_host", "<field>_port", "<field>_path",
+// "<field>_subdomain", "<field>_sld" and "<field>_tld". Records whose field is missing,
+// not a string or not a valid URL are written with empty components, so that no record
+// is lost in the enrichment.
+//
+// Example:
+//
+//	// {"id":1,"url":"https://www.example.com/a"}
+//	// becomes
+//	// {"id":1,"url":"https://www.example.com/a","url_host":"www.example.com","url_path":"/a","url_port":"","url_scheme":"https","url_sld":"example","url_subdomain":"www","url_tld":"com"}
+//	err := hqgourl.NewParser().ParseNDJSONField(os.Stdin, "url", os.Stdout)
+//
+// Parameters:
+//   - r (io.Reader): The NDJSON input.
+//   - field (string): The name of the field holding the URL.
+//   - w (io.Writer): The NDJSON output.
+//
+// Returns:
+//   - err (error): An error if the input is not valid NDJSON or writing fails.
+func (p *Parser) ParseNDJSONField(r io.Reader, field string, w io.Writer) (err error) {
+	decoder := json.NewDecoder(r)
+
+	decoder.UseNumber()
+
+	encoder := json.NewEncoder(w)
+
+	encoder.SetEscapeHTML(false)
+
+	for {
+		record := map[string]any{}
+
+		if err = decoder.Decode(&record); err != nil {
+			if errors.Is(err, io.EOF) {
+				err = nil
+			} else {
+				err = fmt.Errorf("error reading input: %w", err)
+			}
+
+			return
+		}
+
+		raw, _ := record[field].(string)
+
+		for i, value := range p.components(raw) {
+			record[field+"_"+bulkComponents[i]] = value
+		}
+
+		if err = encoder.Encode(record); err != nil {
+			return
+		}
+	}
+}
+
+// ParseCSVColumn reads CSV records from r, parses the URL held in the given column of each
+// record and writes the record to w, with the URL's components appended as extra columns.
+// The first record is the header: the column is looked up by name in it, and the extra
+// columns are named "<column>_scheme", "<column>_host", "<column>_port", "<column>_path",
+// "<column>_subdomain", "<column>_sld" and "<column>_tld". Records whose column is not a
+// valid URL are written with empty components.
+//
+// Parameters:
+//   - r (io.Reader): The CSV input, starting with a header.
+//   - column (string): The name of the column holding the URL.
+//   - w (io.Writer): The CSV output.
+//
+// Returns:
+//   - err (error): ErrEmptyInput (wrapped) if the input has no header, an error if the
+//     column is not in the header or the input is not valid CSV, or if writing fails.
+func (p *Parser) ParseCSVColumn(r io.Reader, column string, w io.Writer) (err error) {
+	reader := csv.NewReader(r)
+	writer := csv.NewWriter(w)
+
+	header, err := reader.Read()
+	if err != nil {
+		if errors.Is(err, io.EOF) {
+			err = fmt.Errorf("%w: CSV header", ErrEmptyInput)
+		} else {
+			err = fmt.Errorf("error reading input: %w", err)
+		}
+
+		return
+	}
+
+	index := slices.Index(header, column)
+	if index < 0 {
+		err = fmt.Errorf("column %q not found in CSV header", column)
+
+		return
+	}
+
+	for _, component := range bulkComponents {
+		header = append(header, column+"_"+component)
+	}
+
+	if err = writer.Write(header); err != nil {
+		return
+	}
+
+	for {
+		var record []string
+
+		record, err = reader.Read()
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				err = nil
+
+				break
+			}
+
+			err = fmt.Errorf("error reading input: %w", err)
+
+			return
+		}
+
+		raw := ""
+
+		if index < len(record) {
+			raw = record[index]
+		}
+
+		components := p.components(raw)
+
+		if err = writer.Write(append(record, components[:]...)); err != nil {
+			return
+		}
+	}
+
+	writer.Flush()
+
+	err = writer.Error()
+
+	return
+}
+
+// components parses raw and returns its components in the order of bulkComponents.
+// All components are empty if raw cannot be parsed.
+func (p *Parser) components(raw string) (components [7]string) {
+	parsed, err := p.Parse(raw)
+	if err != nil {
+		return
+	}
+
+	components = [7]string{parsed.Scheme, parsed.Hostname(), parsed.Port(), parsed.Path}
+
+	if parsed.Domain != nil {
+		components[4], components[5], components[6] = parsed.Domain.Subdomain, parsed.Domain.SLD, parsed.Domain.TLD
+	}
+
+	return
+}
+
+// bulkComponents names the components added to records by ParseNDJSONField and
+// ParseCSVColumn, in order.
+var bulkComponents = [7]string{"scheme", "host", "port", "path", "subdomain", "sld", "tld"}
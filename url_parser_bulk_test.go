@@ -0,0 +1,71 @@
+package url_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	hqgourl "go.source.hueristiq.com/url"
+)
+
+func TestParser_ParseNDJSONField(t *testing.T) {
+	t.Parallel()
+
+	input := `{"id":1,"link":"https://www.example.co.uk:8443/a"}
+{"id":12345678901234567890,"link":"::not a url"}
+{"id":3}
+`
+
+	var b strings.Builder
+
+	err := hqgourl.NewParser().ParseNDJSONField(strings.NewReader(input), "link", &b)
+
+	require.NoError(t, err)
+
+	lines := strings.Split(strings.TrimSuffix(b.String(), "\n"), "\n")
+
+	require.Len(t, lines, 3)
+
+	assert.JSONEq(t, `{"id":1,"link":"https://www.example.co.uk:8443/a","link_scheme":"https","link_host":"www.example.co.uk","link_port":"8443","link_path":"/a","link_subdomain":"www","link_sld":"example","link_tld":"co.uk"}`, lines[0])
+	assert.JSONEq(t, `{"id":12345678901234567890,"link":"::not a url","link_scheme":"","link_host":"","link_port":"","link_path":"","link_subdomain":"","link_sld":"","link_tld":""}`, lines[1])
+	assert.JSONEq(t, `{"id":3,"link_scheme":"","link_host":"","link_port":"","link_path":"","link_subdomain":"","link_sld":"","link_tld":""}`, lines[2])
+}
+
+func TestParser_ParseNDJSONField_InvalidInput(t *testing.T) {
+	t.Parallel()
+
+	err := hqgourl.NewParser().ParseNDJSONField(strings.NewReader(`{"link":`), "link", &strings.Builder{})
+
+	require.Error(t, err)
+}
+
+func TestParser_ParseCSVColumn(t *testing.T) {
+	t.Parallel()
+
+	input := "id,link\n1,https://api.example.com/v1\n2,\n"
+
+	var b strings.Builder
+
+	err := hqgourl.NewParser().ParseCSVColumn(strings.NewReader(input), "link", &b)
+
+	require.NoError(t, err)
+
+	expected := "id,link,link_scheme,link_host,link_port,link_path,link_subdomain,link_sld,link_tld\n" +
+		"1,https://api.example.com/v1,https,api.example.com,,/v1,api,example,com\n" +
+		"2,,,,,,,,\n"
+
+	assert.Equal(t, expected, b.String())
+}
+
+func TestParser_ParseCSVColumn_Errors(t *testing.T) {
+	t.Parallel()
+
+	err := hqgourl.NewParser().ParseCSVColumn(strings.NewReader(""), "link", &strings.Builder{})
+
+	require.ErrorIs(t, err, hqgourl.ErrEmptyInput)
+
+	err = hqgourl.NewParser().ParseCSVColumn(strings.NewReader("id,url\n"), "link", &strings.Builder{})
+
+	require.Error(t, err)
+}
@@ -0,0 +1,95 @@
+package url
+
+import (
+	"container/list"
+	"sync"
+)
+
+// parseCache is a least-recently-used cache of parsed URLs, keyed by raw string. It is
+// safe for concurrent use.
+type parseCache struct {
+	mu      sync.Mutex
+	size    int
+	order   *list.List               // The cached entries, most recently used first.
+	entries map[string]*list.Element // The elements of order, by raw string.
+}
+
+// parseCacheEntry is an entry of a parseCache.
+type parseCacheEntry struct {
+	raw    string
+	parsed *URL
+}
+
+// newParseCache creates a parseCache holding up to size entries.
+func newParseCache(size int) (cache *parseCache) {
+	cache = &parseCache{
+		size:    size,
+		order:   list.New(),
+		entries: make(map[string]*list.Element, size),
+	}
+
+	return
+}
+
+// get returns a copy of the URL cached for raw, if any.
+func (c *parseCache) get(raw string) (parsed *URL, ok bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	element, ok := c.entries[raw]
+	if !ok {
+		return
+	}
+
+	c.order.MoveToFront(element)
+
+	parsed = copyURL(element.Value.(*parseCacheEntry).parsed)
+
+	return
+}
+
+// put caches a copy of parsed for raw, evicting the least recently used entry if the
+// cache is full.
+func (c *parseCache) put(raw string, parsed *URL) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if element, ok := c.entries[raw]; ok {
+		c.order.MoveToFront(element)
+
+		return
+	}
+
+	if c.order.Len() >= c.size {
+		oldest := c.order.Back()
+
+		c.order.Remove(oldest)
+
+		delete(c.entries, oldest.Value.(*parseCacheEntry).raw)
+	}
+
+	c.entries[raw] = c.order.PushFront(&parseCacheEntry{raw: raw, parsed: copyURL(parsed)})
+}
+
+// copyURL returns a deep copy of u, so that callers may modify the URLs returned from
+// (or stored in) the cache. The userinfo is shared, as url.Userinfo is immutable.
+func copyURL(u *URL) (copied *URL) {
+	// Copy the whole struct, so that fields added to URL are not missed, then the pointers.
+	shallow := *u
+
+	copied = &shallow
+
+	if u.URL != nil {
+		URL := *u.URL
+
+		copied.URL = &URL
+	}
+
+	if u.Domain != nil {
+		domain := *u.Domain
+
+		copied.Domain = &domain
+	}
+
+	return
+}
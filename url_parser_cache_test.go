@@ -0,0 +1,91 @@
+package url_test
+
+import (
+	"fmt"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	hqgourl "go.source.hueristiq.com/url"
+)
+
+func TestParser_Parse_Cache(t *testing.T) {
+	t.Parallel()
+
+	parser := hqgourl.NewParser(hqgourl.ParserWithCache(2))
+
+	first, err := parser.Parse("https://www.example.com/path?q=1")
+
+	require.NoError(t, err)
+
+	first.Path = "/modified"
+	first.Domain.Subdomain = "modified"
+
+	second, err := parser.Parse("https://www.example.com/path?q=1")
+
+	require.NoError(t, err)
+
+	assert.NotSame(t, first, second)
+	assert.Equal(t, "/path", second.Path)
+	assert.Equal(t, "www", second.Domain.Subdomain)
+	assert.Equal(t, "https://www.example.com/path?q=1", second.Raw)
+
+	for _, raw := range []string{"https://a.example.com", "https://b.example.com", "https://www.example.com/path?q=1"} {
+		parsed, err := parser.Parse(raw)
+
+		require.NoError(t, err)
+
+		assert.Equal(t, raw, parsed.String())
+	}
+
+	_, err = parser.Parse("")
+
+	require.ErrorIs(t, err, hqgourl.ErrEmptyInput)
+
+	_, err = parser.Parse("http://[::1")
+
+	require.ErrorIs(t, err, hqgourl.ErrInvalidURL)
+}
+
+func TestParser_Parse_CacheEncodedHost(t *testing.T) {
+	t.Parallel()
+
+	parser := hqgourl.NewParser(hqgourl.ParserWithCache(4))
+
+	for range 2 {
+		parsed, err := parser.Parse("http://%65xample.com/")
+
+		require.NoError(t, err)
+
+		assert.Equal(t, "example.com", parsed.Host)
+		assert.Equal(t, "%65xample.com", parsed.EncodedHost)
+	}
+}
+
+func TestParser_Parse_CacheConcurrent(t *testing.T) {
+	t.Parallel()
+
+	parser := hqgourl.NewParser(hqgourl.ParserWithCache(8))
+
+	var wg sync.WaitGroup
+
+	for i := range 8 {
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+
+			for j := range 100 {
+				raw := fmt.Sprintf("https://host%d.example.com/%d", (i+j)%16, j%4)
+
+				parsed, err := parser.Parse(raw)
+
+				assert.NoError(t, err)
+				assert.Equal(t, raw, parsed.String())
+			}
+		}()
+	}
+
+	wg.Wait()
+}
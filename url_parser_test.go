@@ -1,6 +1,8 @@
 package url_test
 
 import (
+	"bytes"
+	"log/slog"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -41,10 +43,22 @@ func TestParser_Parse_InvalidURL(t *testing.T) {
 	_, err := parser.Parse("://example.com")
 
 	require.Error(t, err)
+	require.ErrorIs(t, err, hqgourl.ErrInvalidURL)
 
 	assert.Contains(t, err.Error(), "error parsing URL")
 }
 
+// Test parsing an empty URL.
+func TestParser_Parse_EmptyInput(t *testing.T) {
+	t.Parallel()
+
+	parser := hqgourl.NewParser()
+
+	_, err := parser.Parse("")
+
+	require.ErrorIs(t, err, hqgourl.ErrEmptyInput)
+}
+
 // Test parsing a URL without a scheme and adding the default scheme.
 func TestParser_Parse_URLWithoutScheme(t *testing.T) {
 	t.Parallel()
@@ -57,10 +71,12 @@ func TestParser_Parse_URLWithoutScheme(t *testing.T) {
 
 	assert.NotNil(t, parsed)
 
-	// Verify that the default scheme has been added.
+	// Verify that the default scheme has been added, and the raw input preserved.
 	assert.Equal(t, "https", parsed.Scheme)
 	assert.Equal(t, "example.com", parsed.Host)
 	assert.Equal(t, "/path", parsed.Path)
+	assert.Equal(t, "example.com/path", parsed.Raw)
+	assert.Equal(t, "https://example.com/path", parsed.String())
 
 	// Verify domain components.
 	assert.NotNil(t, parsed.Domain)
@@ -202,3 +218,181 @@ func TestParser_Parse_URLWithIPv6Address(t *testing.T) {
 	// Ensure that the domain parsing doesn't apply to IP addresses.
 	assert.Nil(t, parsed.Domain)
 }
+
+func TestParser_Parse_RelativeSupport(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name          string
+		opts          []hqgourl.ParserOptionFunc
+		unparsed      string
+		expected      string
+		expectedHost  string
+		expectedIsAbs bool
+	}{
+		{"Schemeless absolute URL", []hqgourl.ParserOptionFunc{hqgourl.ParserWithDefaultScheme("https")}, "example.com/with/path?q=1", "https://example.com/with/path?q=1", "example.com", true},
+		{"Schemeless URL with port", []hqgourl.ParserOptionFunc{hqgourl.ParserWithDefaultScheme("https")}, "example.com:8080/x", "https://example.com:8080/x", "example.com:8080", true},
+		{"Schemeless URL without default scheme", nil, "www.example.co.uk/x", "//www.example.co.uk/x", "www.example.co.uk", false},
+		{"Absolute path", []hqgourl.ParserOptionFunc{hqgourl.ParserWithDefaultScheme("https")}, "/with/path?q=1", "/with/path?q=1", "", false},
+		{"Relative path", []hqgourl.ParserOptionFunc{hqgourl.ParserWithDefaultScheme("https")}, "path/to/file.txt", "path/to/file.txt", "", false},
+		{"Dot segments", []hqgourl.ParserOptionFunc{hqgourl.ParserWithDefaultScheme("https")}, "../up", "../up", "", false},
+		{"Network-path reference", []hqgourl.ParserOptionFunc{hqgourl.ParserWithDefaultScheme("https")}, "//cdn.example.com/a.js", "https://cdn.example.com/a.js", "cdn.example.com", true},
+		{"Absolute URL", []hqgourl.ParserOptionFunc{hqgourl.ParserWithDefaultScheme("https")}, "http://example.com", "http://example.com", "example.com", true},
+		{"No-authority scheme", []hqgourl.ParserOptionFunc{hqgourl.ParserWithDefaultScheme("https")}, "mailto:user@example.com", "mailto:user@example.com", "", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			parser := hqgourl.NewParser(append(tt.opts, hqgourl.ParserWithRelativeSupport())...)
+
+			parsed, err := parser.Parse(tt.unparsed)
+
+			require.NoError(t, err)
+
+			assert.Equal(t, tt.expected, parsed.String())
+			assert.Equal(t, tt.expectedHost, parsed.Host)
+			assert.Equal(t, tt.expectedIsAbs, parsed.IsAbs())
+			assert.Equal(t, tt.unparsed, parsed.Raw)
+		})
+	}
+}
+
+func TestParser_Parse_EmojiDomains(t *testing.T) {
+	t.Parallel()
+
+	parsed, err := hqgourl.NewParser().Parse("http://i❤.ws/x")
+
+	require.NoError(t, err)
+	assert.Nil(t, parsed.Domain)
+
+	parser := hqgourl.NewParser(hqgourl.ParserWithEmojiDomains(), hqgourl.ParserWithRelativeSupport())
+
+	parsed, err = parser.Parse("http://i❤.ws/x")
+
+	require.NoError(t, err)
+	require.NotNil(t, parsed.Domain)
+	assert.Equal(t, "i❤", parsed.Domain.SLD)
+	assert.Equal(t, "ws", parsed.Domain.TLD)
+
+	parsed, err = parser.Parse("👍.example.com/x")
+
+	require.NoError(t, err)
+	assert.Equal(t, "👍.example.com", parsed.Host)
+}
+
+func TestParser_Parse_EncodedHost(t *testing.T) {
+	t.Parallel()
+
+	parser := hqgourl.NewParser(hqgourl.ParserWithDefaultScheme("http"))
+
+	tests := []struct {
+		raw     string
+		host    string
+		encoded string
+		err     bool
+	}{
+		{"http://%65xample.com/", "example.com", "%65xample.com", false},
+		{"http://user@ex%61mple.com:8080/%65", "example.com:8080", "ex%61mple.com", false},
+		{"http://caf%C3%A9.com/", "café.com", "caf%C3%A9.com", false},
+		{"%31%32%37.0.0.1/admin", "127.0.0.1", "%31%32%37.0.0.1", false},
+		{"http://[fe80::1%25eth0]/", "[fe80::1%eth0]", "", false},
+		{"http://example.com/%65", "example.com", "", false},
+		{"http://example.com%2fevil.com/", "", "", true},
+		{"http://example.com%40evil.com/", "", "", true},
+		{"http://%2565xample.com/", "", "", true},
+		{"http://%zzexample.com/", "", "", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.raw, func(t *testing.T) {
+			t.Parallel()
+
+			parsed, err := parser.Parse(tt.raw)
+
+			if tt.err {
+				require.ErrorIs(t, err, hqgourl.ErrInvalidURL)
+
+				return
+			}
+
+			require.NoError(t, err)
+
+			assert.Equal(t, tt.host, parsed.Host)
+			assert.Equal(t, tt.encoded, parsed.EncodedHost)
+			assert.Equal(t, tt.raw, parsed.Raw)
+		})
+	}
+}
+
+func TestParserWithBackslashNormalization(t *testing.T) {
+	t.Parallel()
+
+	parser := hqgourl.NewParser(
+		hqgourl.ParserWithDefaultScheme("https"),
+		hqgourl.ParserWithRelativeSupport(),
+		hqgourl.ParserWithBackslashNormalization(),
+	)
+
+	tests := []struct {
+		raw      string
+		expected string
+	}{
+		{`https:\\example.com\path\to?q=a\b#c\d`, `https://example.com/path/to?q=a\b#c%5Cd`},
+		{`HTTP:/\example.com\`, "http://example.com/"},
+		{`\\example.com\a`, "https://example.com/a"},
+		{`example.com\a\b`, "https://example.com/a/b"},
+		{`\relative\path`, "/relative/path"},
+		{`mailto:user\name@example.com`, `mailto:user\name@example.com`},
+		{"https://example.com/a", "https://example.com/a"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.raw, func(t *testing.T) {
+			t.Parallel()
+
+			parsed, err := parser.Parse(tt.raw)
+
+			require.NoError(t, err)
+
+			assert.Equal(t, tt.expected, parsed.String())
+			assert.Equal(t, tt.raw, parsed.Raw)
+		})
+	}
+}
+
+func TestParserWithLogger(t *testing.T) {
+	t.Parallel()
+
+	var buf bytes.Buffer
+
+	logger := slog.New(slog.NewTextHandler(&buf, &slog.HandlerOptions{Level: slog.LevelDebug}))
+
+	parser := hqgourl.NewParser(hqgourl.ParserWithDefaultScheme("https"), hqgourl.ParserWithLogger(logger))
+
+	_, err := parser.Parse("example.com/a")
+
+	require.NoError(t, err)
+
+	_, err = parser.Parse("https://[::1]:8080/a")
+
+	require.NoError(t, err)
+
+	assert.Contains(t, buf.String(), `level=DEBUG msg="rewrote URL before parsing" url=example.com/a rewritten=https://example.com/a`)
+	assert.Contains(t, buf.String(), `level=DEBUG msg="host is not a domain, domain left unparsed" url=https://[::1]:8080/a host=::1`)
+}
+
+func BenchmarkNewParser(b *testing.B) {
+	for range b.N {
+		hqgourl.NewParser()
+	}
+}
+
+func BenchmarkParser_Parse(b *testing.B) {
+	parser := hqgourl.NewParser()
+
+	for range b.N {
+		_, _ = parser.Parse("https://www.example.com/path?q=1")
+	}
+}
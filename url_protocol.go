@@ -0,0 +1,103 @@
+package url
+
+import (
+	"net/url"
+	"slices"
+	"strings"
+)
+
+// ProtocolHint identifies the API protocol an endpoint likely speaks, as guessed from its
+// URL by URL.ProtocolHint and Match.ProtocolHint, for specialized downstream scanners.
+type ProtocolHint string
+
+const (
+	// ProtocolHintGraphQL identifies GraphQL endpoints (e.g., "/graphql" or "/graphiql").
+	ProtocolHintGraphQL ProtocolHint = "graphql"
+	// ProtocolHintSOAP identifies SOAP web services (e.g., "/service.asmx", "?wsdl" or
+	// "/soap").
+	ProtocolHintSOAP ProtocolHint = "soap"
+	// ProtocolHintNone identifies URLs without protocol hint.
+	ProtocolHintNone ProtocolHint = ""
+)
+
+var (
+	// graphQLSegments are the path segments (without extension) of GraphQL endpoints and
+	// their IDEs.
+	graphQLSegments = []string{"graphql", "graphiql", "gql", "graphql-explorer", "altair"}
+	// soapSegments are the path segments (without extension) of SOAP endpoints.
+	soapSegments = []string{"soap", "soap11", "soap12", "wsdl"}
+	// soapExtensions are the extensions of SOAP endpoints: ASP.NET web services (".asmx"),
+	// WCF services (".svc") and service descriptions (".wsdl").
+	soapExtensions = []string{".asmx", ".svc", ".wsdl"}
+	// soapQueryKeys are the query keys requesting the description of SOAP services.
+	soapQueryKeys = []string{"wsdl", "singlewsdl", "xsd"}
+)
+
+// ProtocolHint guesses the API protocol the URL speaks: ProtocolHintGraphQL for a
+// "graphql" (or "graphiql", "gql") path segment, ProtocolHintSOAP for a "soap" path
+// segment, an ".asmx", ".svc" or ".wsdl" extension, or a "wsdl" query key (e.g.,
+// "/Service.svc?singleWsdl").
+//
+// Returns:
+//   - hint (ProtocolHint): The protocol, or ProtocolHintNone if there is no hint.
+func (u *URL) ProtocolHint() (hint ProtocolHint) {
+	hint = protocolHint(u.Path, u.RawQuery)
+
+	return
+}
+
+// ProtocolHint guesses the API protocol the matched URL speaks. See URL.ProtocolHint.
+//
+// Returns:
+//   - hint (ProtocolHint): The protocol, or ProtocolHintNone if there is no hint (e.g.,
+//     for emails).
+func (m Match) ProtocolHint() (hint ProtocolHint) {
+	if m.Type == MatchTypeEmail {
+		return
+	}
+
+	raw := m.Value
+
+	if m.Type == MatchTypeHost {
+		raw = "//" + raw
+	}
+
+	parsed, err := url.Parse(raw)
+	if err != nil {
+		return
+	}
+
+	hint = protocolHint(parsed.Path, parsed.RawQuery)
+
+	return
+}
+
+// protocolHint guesses the API protocol of an endpoint from its path and raw query.
+func protocolHint(path, rawQuery string) (hint ProtocolHint) {
+	for _, segment := range strings.Split(strings.ToLower(path), "/") {
+		name := strings.TrimSuffix(segment, pathExtension(segment))
+
+		switch {
+		case slices.Contains(graphQLSegments, name):
+			hint = ProtocolHintGraphQL
+
+			return
+		case slices.Contains(soapSegments, name), slices.Contains(soapExtensions, pathExtension(segment)):
+			hint = ProtocolHintSOAP
+
+			return
+		}
+	}
+
+	for _, pair := range strings.Split(rawQuery, "&") {
+		key, _, _ := strings.Cut(pair, "=")
+
+		if slices.Contains(soapQueryKeys, strings.ToLower(key)) {
+			hint = ProtocolHintSOAP
+
+			return
+		}
+	}
+
+	return
+}
@@ -0,0 +1,59 @@
+package url_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	hqgourl "go.source.hueristiq.com/url"
+)
+
+func TestURL_ProtocolHint(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		raw  string
+		hint hqgourl.ProtocolHint
+	}{
+		{"https://example.com/graphql", hqgourl.ProtocolHintGraphQL},
+		{"https://example.com/api/GraphQL?query={me{id}}", hqgourl.ProtocolHintGraphQL},
+		{"https://example.com/graphiql.php", hqgourl.ProtocolHintGraphQL},
+		{"https://example.com/StockService?WSDL", hqgourl.ProtocolHintSOAP},
+		{"https://example.com/Service.svc?singleWsdl", hqgourl.ProtocolHintSOAP},
+		{"https://example.com/legacy/Orders.asmx", hqgourl.ProtocolHintSOAP},
+		{"https://example.com/soap/v1/orders", hqgourl.ProtocolHintSOAP},
+		{"https://example.com/api/v1/users", hqgourl.ProtocolHintNone},
+		{"https://example.com/graphql-guide.html?ref=wsdl-page", hqgourl.ProtocolHintNone},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.raw, func(t *testing.T) {
+			t.Parallel()
+
+			parsed, err := hqgourl.NewParser().Parse(tt.raw)
+
+			require.NoError(t, err)
+
+			assert.Equal(t, tt.hint, parsed.ProtocolHint())
+		})
+	}
+}
+
+func TestMatch_ProtocolHint(t *testing.T) {
+	t.Parallel()
+
+	matches := hqgourl.NewExtractor().Extract("see https://example.com/graphql, example.com/ws.asmx?wsdl and user@graphql.example.com")
+
+	require.Len(t, matches, 3)
+
+	assert.Equal(t, hqgourl.ProtocolHintGraphQL, matches[0].ProtocolHint())
+	assert.Equal(t, hqgourl.ProtocolHintSOAP, matches[1].ProtocolHint())
+	assert.Equal(t, hqgourl.ProtocolHintNone, matches[2].ProtocolHint())
+
+	data, err := json.Marshal(matches[0])
+
+	require.NoError(t, err)
+
+	assert.Contains(t, string(data), `"protocol":"graphql"`)
+}
@@ -0,0 +1,153 @@
+package url
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+	"unicode"
+)
+
+// SanitizePolicy controls how Sanitize screens URLs. Length limits are in bytes, of the
+// escaped components, and a zero limit disables its check.
+type SanitizePolicy struct {
+	// Clean strips control characters (including CR and LF) and, with EncodedNewlines,
+	// percent-encoded newlines, and trims surrounding spaces, instead of rejecting the URL.
+	// Overlong components are rejected either way, as truncating them would change the
+	// resource the URL points to.
+	Clean bool
+	// EncodedNewlines also treats percent-encoded CR and LF ("%0d" and "%0a") as embedded
+	// newlines, as servers decoding them before writing headers are open to CRLF injection.
+	EncodedNewlines bool
+
+	MaxLength         int
+	MaxHostLength     int
+	MaxPathLength     int
+	MaxQueryLength    int
+	MaxFragmentLength int
+}
+
+// DefaultSanitizePolicy rejects URLs with control characters or (encoded) newlines, longer
+// than 8192 bytes, the request line limit of most HTTP servers, or with hosts longer than
+// 253 bytes, the maximum length of a domain name.
+var DefaultSanitizePolicy = SanitizePolicy{
+	EncodedNewlines: true,
+	MaxLength:       8192,
+	MaxHostLength:   253,
+}
+
+// Sanitize screens a raw URL before it is passed to an HTTP client, rejecting or, if the
+// policy says so, cleaning raw control characters and embedded newlines, which are CRLF
+// injection vectors, and rejecting overlong components.
+//
+// Parameters:
+//   - raw (string): The raw URL to screen.
+//   - policy (SanitizePolicy): The policy to screen the URL with (e.g., DefaultSanitizePolicy).
+//
+// Returns:
+//   - sanitized (string): The URL, cleaned if policy.Clean is set.
+//   - err (error): ErrEmptyInput, ErrUnsafeURL or ErrInvalidURL (wrapped), or nil if the URL is safe.
+func Sanitize(raw string, policy SanitizePolicy) (sanitized string, err error) {
+	sanitized = raw
+
+	if policy.Clean {
+		sanitized = cleanURL(sanitized, policy.EncodedNewlines)
+	}
+
+	if sanitized == "" {
+		err = fmt.Errorf("%w: URL", ErrEmptyInput)
+
+		return
+	}
+
+	if i := strings.IndexFunc(sanitized, unicode.IsControl); i >= 0 {
+		err = fmt.Errorf("%w: control character at offset %d", ErrUnsafeURL, i)
+
+		return
+	}
+
+	if policy.EncodedNewlines {
+		if i := indexEncodedNewline(sanitized); i >= 0 {
+			err = fmt.Errorf("%w: encoded newline at offset %d", ErrUnsafeURL, i)
+
+			return
+		}
+	}
+
+	if err = checkLength("URL", len(sanitized), policy.MaxLength); err != nil {
+		return
+	}
+
+	parsed, err := url.Parse(sanitized)
+	if err != nil {
+		err = fmt.Errorf("%w: %w", ErrInvalidURL, err)
+
+		return
+	}
+
+	components := []struct {
+		name        string
+		length, max int
+	}{
+		{"host", len(parsed.Host), policy.MaxHostLength},
+		{"path", len(parsed.EscapedPath()), policy.MaxPathLength},
+		{"query", len(parsed.RawQuery), policy.MaxQueryLength},
+		{"fragment", len(parsed.EscapedFragment()), policy.MaxFragmentLength},
+	}
+
+	for _, component := range components {
+		if err = checkLength(component.name, component.length, component.max); err != nil {
+			return
+		}
+	}
+
+	return
+}
+
+// cleanURL strips the control characters and, if encoded is set, the percent-encoded
+// newlines of raw, and trims its surrounding spaces.
+func cleanURL(raw string, encoded bool) (cleaned string) {
+	cleaned = strings.Map(func(r rune) rune {
+		if unicode.IsControl(r) {
+			return -1
+		}
+
+		return r
+	}, raw)
+
+	// Stripping an encoded newline may join another one (e.g., "%%0a0a"), so strip until
+	// none is left.
+	for encoded {
+		i := indexEncodedNewline(cleaned)
+		if i < 0 {
+			break
+		}
+
+		cleaned = cleaned[:i] + cleaned[i+3:]
+	}
+
+	cleaned = strings.TrimSpace(cleaned)
+
+	return
+}
+
+// indexEncodedNewline returns the index of the first percent-encoded CR or LF in s, or -1.
+func indexEncodedNewline(s string) (index int) {
+	for index = 0; index+2 < len(s); index++ {
+		if s[index] == '%' && s[index+1] == '0' && (s[index+2]|0x20 == 'a' || s[index+2]|0x20 == 'd') {
+			return
+		}
+	}
+
+	index = -1
+
+	return
+}
+
+// checkLength returns ErrUnsafeURL (wrapped) if length exceeds a non-zero limit.
+func checkLength(component string, length, limit int) (err error) {
+	if limit > 0 && length > limit {
+		err = fmt.Errorf("%w: %s is %d bytes long, over %d", ErrUnsafeURL, component, length, limit)
+	}
+
+	return
+}
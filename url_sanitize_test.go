@@ -0,0 +1,59 @@
+package url_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	hqgourl "go.source.hueristiq.com/url"
+)
+
+func TestSanitize(t *testing.T) {
+	t.Parallel()
+
+	clean := hqgourl.DefaultSanitizePolicy
+
+	clean.Clean = true
+
+	tests := []struct {
+		name     string
+		raw      string
+		policy   hqgourl.SanitizePolicy
+		expected string
+		err      error
+	}{
+		{"Safe", "https://example.com/a?b=c#d", hqgourl.DefaultSanitizePolicy, "https://example.com/a?b=c#d", nil},
+		{"Empty", "", hqgourl.DefaultSanitizePolicy, "", hqgourl.ErrEmptyInput},
+		{"CRLF", "https://example.com/a\r\nSet-Cookie: x=1", hqgourl.DefaultSanitizePolicy, "", hqgourl.ErrUnsafeURL},
+		{"NUL", "https://example.com/a\x00.php", hqgourl.DefaultSanitizePolicy, "", hqgourl.ErrUnsafeURL},
+		{"C1 Control", "https://example.com/\u0085", hqgourl.DefaultSanitizePolicy, "", hqgourl.ErrUnsafeURL},
+		{"Encoded CRLF", "https://example.com/a%0D%0ASet-Cookie:%20x=1", hqgourl.DefaultSanitizePolicy, "", hqgourl.ErrUnsafeURL},
+		{"Encoded CRLF Allowed", "https://example.com/a%0d%0a", hqgourl.SanitizePolicy{}, "https://example.com/a%0d%0a", nil},
+		{"Overlong URL", "https://example.com/" + strings.Repeat("a", 8192), hqgourl.DefaultSanitizePolicy, "", hqgourl.ErrUnsafeURL},
+		{"Overlong Host", "https://" + strings.Repeat("a", 250) + ".com/", hqgourl.DefaultSanitizePolicy, "", hqgourl.ErrUnsafeURL},
+		{"Overlong Query", "https://example.com/?q=abcdef", hqgourl.SanitizePolicy{MaxQueryLength: 5}, "", hqgourl.ErrUnsafeURL},
+		{"Invalid", "https://example.com/%zz", hqgourl.DefaultSanitizePolicy, "", hqgourl.ErrInvalidURL},
+		{"Clean", " https://example.com/a\r\n%0d%%0a0aSet-Cookie\t ", clean, "https://example.com/aSet-Cookie", nil},
+		{"Clean Overlong", "https://" + strings.Repeat("a", 250) + ".com/\n", clean, "", hqgourl.ErrUnsafeURL},
+		{"Clean Empty", "\r\n", clean, "", hqgourl.ErrEmptyInput},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			sanitized, err := hqgourl.Sanitize(tt.raw, tt.policy)
+
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+
+				return
+			}
+
+			require.NoError(t, err)
+
+			assert.Equal(t, tt.expected, sanitized)
+		})
+	}
+}
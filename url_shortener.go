@@ -0,0 +1,62 @@
+package url
+
+import (
+	"context"
+	"fmt"
+)
+
+// Expander expands shortened links into the URLs they redirect to. The package makes no
+// network calls: callers implement Expander with the HTTP client, caching and rate
+// limiting policies of their choice (e.g., by issuing a HEAD request and reading the
+// Location header of the response).
+type Expander interface {
+	Expand(ctx context.Context, shortened *URL) (expanded *URL, err error)
+}
+
+// ExpanderFunc is an adapter allowing the use of ordinary functions as Expanders.
+type ExpanderFunc func(ctx context.Context, shortened *URL) (expanded *URL, err error)
+
+// Expand calls f(ctx, shortened).
+func (f ExpanderFunc) Expand(ctx context.Context, shortened *URL) (expanded *URL, err error) {
+	return f(ctx, shortened)
+}
+
+// maxShortenerHops is the maximum number of shortened links ExpandShortened expands in a
+// chain (e.g., a "t.co" link to a "bit.ly" link).
+const maxShortenerHops = 5
+
+// ExpandShortened expands u with expander if it is a shortened link (see URL.IsShortened),
+// and so on while the result is itself a shortened link, up to 5 times. Other URLs are
+// returned as they are, without calling expander.
+//
+// Parameters:
+//   - ctx (context.Context): The context passed to expander.
+//   - u (*URL): The URL to expand.
+//   - expander (Expander): The expander of shortened links.
+//
+// Returns:
+//   - expanded (*URL): The expanded URL, or u if it is not a shortened link.
+//   - err (error): The error returned by expander, if any, wrapped; expanded then holds
+//     the last URL expanded.
+func ExpandShortened(ctx context.Context, u *URL, expander Expander) (expanded *URL, err error) {
+	expanded = u
+
+	for hop := 0; hop < maxShortenerHops && expanded.IsShortened(); hop++ {
+		var next *URL
+
+		next, err = expander.Expand(ctx, expanded)
+		if err != nil {
+			err = fmt.Errorf("failed to expand %s: %w", expanded, err)
+
+			return
+		}
+
+		if next == nil {
+			return
+		}
+
+		expanded = next
+	}
+
+	return
+}
@@ -0,0 +1,111 @@
+package url_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	hqgourl "go.source.hueristiq.com/url"
+)
+
+func TestURL_IsShortened(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		raw       string
+		shortened bool
+	}{
+		{"https://bit.ly/3xYz", true},
+		{"https://T.CO/abc?amp=1", true},
+		{"https://www.tinyurl.com/y4bc", true},
+		{"https://bit.ly/", false},
+		{"https://bit.ly", false},
+		{"https://example.com/3xYz", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.raw, func(t *testing.T) {
+			t.Parallel()
+
+			parsed, err := hqgourl.NewParser().Parse(tt.raw)
+
+			require.NoError(t, err)
+
+			assert.Equal(t, tt.shortened, parsed.IsShortened())
+		})
+	}
+}
+
+func TestExpandShortened(t *testing.T) {
+	t.Parallel()
+
+	parser := hqgourl.NewParser()
+
+	redirects := map[string]string{
+		"https://t.co/abc":   "https://bit.ly/def",
+		"https://bit.ly/def": "https://example.com/landing",
+		"https://t.co/loop":  "https://t.co/loop",
+	}
+
+	calls := 0
+
+	expander := hqgourl.ExpanderFunc(func(_ context.Context, shortened *hqgourl.URL) (expanded *hqgourl.URL, err error) {
+		calls++
+
+		location, ok := redirects[shortened.String()]
+		if !ok {
+			err = errors.New("not found")
+
+			return
+		}
+
+		expanded, err = parser.Parse(location)
+
+		return
+	})
+
+	shortened, err := parser.Parse("https://t.co/abc")
+
+	require.NoError(t, err)
+
+	expanded, err := hqgourl.ExpandShortened(context.Background(), shortened, expander)
+
+	require.NoError(t, err)
+
+	assert.Equal(t, "https://example.com/landing", expanded.String())
+	assert.Equal(t, 2, calls)
+
+	plain, err := parser.Parse("https://example.com/page")
+
+	require.NoError(t, err)
+
+	expanded, err = hqgourl.ExpandShortened(context.Background(), plain, expander)
+
+	require.NoError(t, err)
+
+	assert.Same(t, plain, expanded)
+	assert.Equal(t, 2, calls)
+
+	loop, err := parser.Parse("https://t.co/loop")
+
+	require.NoError(t, err)
+
+	expanded, err = hqgourl.ExpandShortened(context.Background(), loop, expander)
+
+	require.NoError(t, err)
+
+	assert.Equal(t, "https://t.co/loop", expanded.String())
+	assert.Equal(t, 7, calls)
+
+	missing, err := parser.Parse("https://bit.ly/missing")
+
+	require.NoError(t, err)
+
+	expanded, err = hqgourl.ExpandShortened(context.Background(), missing, expander)
+
+	require.Error(t, err)
+
+	assert.Same(t, missing, expanded)
+}
@@ -0,0 +1,77 @@
+package url
+
+import (
+	"net/netip"
+	"net/url"
+	"strings"
+	"unicode"
+)
+
+// HostSpoofing reports whether the userinfo of the URL looks like a host (e.g.,
+// "accounts.google.com" in "https://accounts.google.com@phish.example/"), a phishing
+// trick to pass the URL off as one of the decoy host to readers and naive allowlists:
+// the username is a domain with a known TLD, an IP address (in any notation), or
+// "localhost".
+//
+// Returns:
+//   - decoy (string): The username posing as the host.
+//   - spoofed (bool): Whether the userinfo looks like a host.
+func (u *URL) HostSpoofing() (decoy string, spoofed bool) {
+	if u.URL == nil || u.User == nil {
+		return
+	}
+
+	decoy = u.User.Username()
+	spoofed = looksLikeHost(decoy)
+
+	return
+}
+
+// hostSpoofing reports whether the userinfo of the matched value looks like a host (see
+// URL.HostSpoofing). Emails never have userinfo.
+func (m Match) hostSpoofing() (spoofed bool) {
+	if m.Type == MatchTypeEmail || !strings.Contains(m.Value, "@") {
+		return
+	}
+
+	raw := m.Value
+
+	if m.Type == MatchTypeHost {
+		raw = "//" + raw
+	}
+
+	parsed, err := url.Parse(raw)
+	if err != nil || parsed.User == nil {
+		return
+	}
+
+	spoofed = looksLikeHost(parsed.User.Username())
+
+	return
+}
+
+// looksLikeHost reports whether s looks like a host: a domain with a known TLD, an IP
+// address (in any notation, bracketed or not), or "localhost".
+func looksLikeHost(s string) bool {
+	s = strings.TrimSuffix(strings.ToLower(s), ".")
+
+	if _, err := netip.ParseAddr(strings.Trim(s, "[]")); err == nil {
+		return true
+	}
+
+	if _, ok := ObfuscatedIPv4(s); ok || s == "localhost" {
+		return true
+	}
+
+	for _, label := range strings.Split(s, ".") {
+		if label == "" || strings.ContainsFunc(label, func(r rune) bool {
+			return r != '-' && !unicode.IsLetter(r) && !unicode.IsNumber(r)
+		}) {
+			return false
+		}
+	}
+
+	domain := sharedDomainParser().Parse(s)
+
+	return domain.SLD != "" && domain.TLD != ""
+}
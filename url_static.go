@@ -0,0 +1,122 @@
+package url
+
+import (
+	"slices"
+	"strings"
+)
+
+// DefaultStaticAssetExtensions are the extensions of static assets used by IsStaticAsset
+// unless StaticAssetWithExtensions is given: scripts, stylesheets, images, fonts and media.
+var DefaultStaticAssetExtensions = []string{
+	".js", ".mjs", ".map", ".css",
+	".png", ".jpg", ".jpeg", ".gif", ".svg", ".ico", ".webp", ".avif", ".bmp", ".tif", ".tiff",
+	".woff", ".woff2", ".ttf", ".otf", ".eot",
+	".mp3", ".mp4", ".webm", ".ogg", ".wav", ".flac", ".avi", ".mov",
+}
+
+// DefaultStaticAssetDirectories are the directories of static assets used by IsStaticAsset
+// unless StaticAssetWithDirectories is given.
+var DefaultStaticAssetDirectories = []string{
+	"assets", "static", "images", "img", "css", "js", "fonts", "media", "dist", "build",
+	"wp-content", "wp-includes", "_next", "node_modules", "bower_components",
+}
+
+// dynamicExtensions are the extensions of server-side scripts, which are never static
+// assets whatever their directory.
+var dynamicExtensions = []string{
+	".php", ".asp", ".aspx", ".jsp", ".jspx", ".cgi", ".pl", ".do", ".action", ".cfm",
+}
+
+// StaticAssetOptions holds the lists IsStaticAsset classifies URLs with.
+type StaticAssetOptions struct {
+	Extensions  []string
+	Directories []string
+}
+
+// StaticAssetOptionFunc defines a function type for configuring the StaticAssetOptions of
+// IsStaticAsset.
+type StaticAssetOptionFunc func(*StaticAssetOptions)
+
+// StaticAssetWithExtensions returns an option function that sets the extensions of static
+// assets (e.g., ".css"), replacing DefaultStaticAssetExtensions.
+func StaticAssetWithExtensions(extensions ...string) StaticAssetOptionFunc {
+	return func(o *StaticAssetOptions) {
+		o.Extensions = normalizeExtensions(extensions)
+	}
+}
+
+// StaticAssetWithDirectories returns an option function that sets the directories of
+// static assets (e.g., "assets"), replacing DefaultStaticAssetDirectories.
+func StaticAssetWithDirectories(directories ...string) StaticAssetOptionFunc {
+	return func(o *StaticAssetOptions) {
+		o.Directories = directories
+	}
+}
+
+// IsStaticAsset reports whether the URL likely points to a static asset (e.g., a script,
+// stylesheet, image or font), so that scanners can skip it early. A URL is taken for a
+// static asset if its path ends with a static asset extension, or, unless it ends with the
+// extension of a server-side script (e.g., ".php"), if it has an extension and either is
+// in a static asset directory (e.g., "/static/") or has a cache-busting hash in its file
+// name (e.g., "main.3f2a9c1b.chunk").
+//
+// Parameters:
+//   - opts (variadic StaticAssetOptionFunc): Optional lists replacing the defaults.
+//
+// Returns:
+//   - static (bool): Whether the URL likely points to a static asset.
+func (u *URL) IsStaticAsset(opts ...StaticAssetOptionFunc) (static bool) {
+	options := &StaticAssetOptions{
+		Extensions:  DefaultStaticAssetExtensions,
+		Directories: DefaultStaticAssetDirectories,
+	}
+
+	for _, opt := range opts {
+		opt(options)
+	}
+
+	extension := u.Extension()
+
+	if extension == "" || slices.Contains(dynamicExtensions, extension) {
+		return
+	}
+
+	if slices.Contains(options.Extensions, extension) {
+		static = true
+
+		return
+	}
+
+	segments := strings.Split(strings.Trim(u.Path, "/"), "/")
+
+	for _, directory := range segments[:len(segments)-1] {
+		if slices.ContainsFunc(options.Directories, func(d string) bool { return strings.EqualFold(d, directory) }) {
+			static = true
+
+			return
+		}
+	}
+
+	static = hasCacheBustingHash(segments[len(segments)-1])
+
+	return
+}
+
+// hasCacheBustingHash reports whether the file name has a part, between ".", "-", "_" or
+// "~", that looks like the content hash bundlers embed for cache busting: at least 8
+// hexadecimal digits, with both digits and letters.
+func hasCacheBustingHash(name string) bool {
+	parts := strings.FieldsFunc(name, func(r rune) bool {
+		return r == '.' || r == '-' || r == '_' || r == '~'
+	})
+
+	for _, part := range parts {
+		hexadecimal := len(part) >= 8 && strings.Trim(strings.ToLower(part), "0123456789abcdef") == ""
+
+		if hexadecimal && strings.ContainsAny(part, "0123456789") && strings.ContainsAny(strings.ToLower(part), "abcdef") {
+			return true
+		}
+	}
+
+	return false
+}
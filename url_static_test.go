@@ -0,0 +1,53 @@
+package url_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	hqgourl "go.source.hueristiq.com/url"
+)
+
+func TestURL_IsStaticAsset(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		raw    string
+		static bool
+	}{
+		{"https://example.com/logo.PNG", true},
+		{"https://example.com/css/site.css?v=3", true},
+		{"https://example.com/static/docs/manual.html", true},
+		{"https://example.com/wp-content/uploads/2024/report.pdf", true},
+		{"https://example.com/bundle.3f2a9c1b.chunk", true},
+		{"https://example.com/static/upload.php", false},
+		{"https://example.com/docs/manual.html", false},
+		{"https://example.com/reports/20240101.pdf", false},
+		{"https://example.com/static/", false},
+		{"https://example.com/api/users", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.raw, func(t *testing.T) {
+			t.Parallel()
+
+			parsed, err := hqgourl.NewParser().Parse(tt.raw)
+
+			require.NoError(t, err)
+
+			assert.Equal(t, tt.static, parsed.IsStaticAsset())
+		})
+	}
+}
+
+func TestURL_IsStaticAsset_Options(t *testing.T) {
+	t.Parallel()
+
+	parsed, err := hqgourl.NewParser().Parse("https://example.com/public/app.js")
+
+	require.NoError(t, err)
+
+	assert.True(t, parsed.IsStaticAsset())
+	assert.False(t, parsed.IsStaticAsset(hqgourl.StaticAssetWithExtensions("png")))
+	assert.True(t, parsed.IsStaticAsset(hqgourl.StaticAssetWithExtensions("png"), hqgourl.StaticAssetWithDirectories("public")))
+}
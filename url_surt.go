@@ -0,0 +1,134 @@
+package url
+
+import (
+	"fmt"
+	"net/netip"
+	"regexp"
+	"slices"
+	"strings"
+)
+
+// surtWWWRegex matches the "www" labels stripped from hosts by SURT.
+var surtWWWRegex = regexp.MustCompile(`^www\d*\.`)
+
+// SURT returns the Sort-friendly URI Reordering Transform of the URL, in the canonical
+// form used as key by web archive (CDX) indexes, e.g. "com,example)/path?a=1&b=2" for
+// "https://www.Example.com/path?b=2&a=1#top": the scheme, userinfo, fragment, default
+// port and leading "www" label are dropped, the labels of the host are reversed and
+// joined with commas (IP addresses are kept as they are), the query parameters are
+// sorted and the whole key is lowercased. URLs without host (e.g., "mailto:") are only
+// lowercased, without fragment.
+//
+// Returns:
+//   - surt (string): The SURT key of the URL.
+func (u *URL) SURT() (surt string) {
+	host := strings.TrimSuffix(strings.ToLower(u.Hostname()), ".")
+
+	if host == "" {
+		withoutFragment := *u.URL
+
+		withoutFragment.Fragment, withoutFragment.RawFragment = "", ""
+
+		surt = strings.ToLower(withoutFragment.String())
+
+		return
+	}
+
+	var b strings.Builder
+
+	if addr, err := netip.ParseAddr(host); err == nil {
+		if addr.Is6() {
+			host = "[" + host + "]"
+		}
+
+		b.WriteString(host)
+	} else {
+		labels := strings.Split(surtWWWRegex.ReplaceAllString(host, ""), ".")
+
+		slices.Reverse(labels)
+
+		b.WriteString(strings.Join(labels, ","))
+	}
+
+	if port := u.Port(); port != "" && !u.HasExplicitDefaultPort() {
+		b.WriteString(":" + port)
+	}
+
+	b.WriteString(")")
+
+	path := u.EscapedPath()
+	if path == "" {
+		path = "/"
+	}
+
+	b.WriteString(strings.ToLower(path))
+
+	if params := strings.FieldsFunc(u.RawQuery, func(r rune) bool { return r == '&' }); len(params) > 0 {
+		for i := range params {
+			params[i] = strings.ToLower(params[i])
+		}
+
+		slices.Sort(params)
+
+		b.WriteString("?" + strings.Join(params, "&"))
+	}
+
+	surt = b.String()
+
+	return
+}
+
+// ParseSURT parses a SURT key back into a URL: the labels of the host are put back in
+// order, and the scheme is "http" unless the key has one, as in the
+// "http://(com,example,)/path" form of SURT prefixes. SURT is lossy, so the URL is the
+// canonical one the key was computed from (e.g., without "www" label, fragment or
+// uppercase characters) rather than the original.
+//
+// Parameters:
+//   - surt (string): The SURT key (e.g., "com,example)/path?a=1").
+//
+// Returns:
+//   - parsed (*URL): The URL.
+//   - err (error): ErrEmptyInput, or ErrInvalidURL (wrapped) if the key is malformed.
+func ParseSURT(surt string) (parsed *URL, err error) {
+	if surt == "" {
+		err = fmt.Errorf("%w: SURT", ErrEmptyInput)
+
+		return
+	}
+
+	scheme, rest := "http", surt
+
+	if before, after, found := strings.Cut(surt, "://("); found {
+		scheme, rest = before, after
+	}
+
+	end := strings.IndexByte(rest, ')')
+	if end <= 0 {
+		err = fmt.Errorf("%w: SURT without host: %q", ErrInvalidURL, surt)
+
+		return
+	}
+
+	host, port := strings.TrimSuffix(rest[:end], ","), ""
+
+	if strings.HasPrefix(host, "[") {
+		if bracket := strings.IndexByte(host, ']'); bracket >= 0 {
+			host, port = host[:bracket+1], host[bracket+1:]
+		}
+	} else if colon := strings.LastIndexByte(host, ':'); colon >= 0 {
+		host, port = host[:colon], host[colon:]
+	}
+
+	if _, addrErr := netip.ParseAddr(host); addrErr != nil && !strings.HasPrefix(host, "[") {
+		labels := strings.Split(host, ",")
+
+		slices.Reverse(labels)
+
+		host = strings.Join(labels, ".")
+	}
+
+	parsed, err = NewParser().Parse(scheme + "://" + host + port + rest[end+1:])
+
+	return
+}
@@ -0,0 +1,68 @@
+package url_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	hqgourl "go.source.hueristiq.com/url"
+)
+
+func TestURL_PortOrDefault(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		raw             string
+		port            int
+		explicitDefault bool
+	}{
+		{"https://example.com", 443, false},
+		{"HTTP://example.com/path", 80, false},
+		{"ftp://example.com", 21, false},
+		{"https://example.com:443", 443, true},
+		{"http://example.com:8080", 8080, false},
+		{"ws://[::1]:80", 80, true},
+		{"unknown://example.com", 0, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.raw, func(t *testing.T) {
+			t.Parallel()
+
+			parsed, err := hqgourl.NewParser().Parse(tt.raw)
+
+			require.NoError(t, err)
+
+			assert.Equal(t, tt.port, parsed.PortOrDefault())
+			assert.Equal(t, tt.explicitDefault, parsed.HasExplicitDefaultPort())
+		})
+	}
+}
+
+func TestURL_IsOnion(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		raw   string
+		onion bool
+		i2p   bool
+	}{
+		{"http://duckduckgogg42xjoc72x3sjasowoarfbgcmvfimaftt6twagswzczad.onion/", true, false},
+		{"http://expyuzz4wqqyqhjn.ONION", true, false},
+		{"http://ukeu3k5oycgaauneqgtnvselmt4yemvoilkln7jpvamvfx7dnkdq.b32.i2p/", false, true},
+		{"https://example.com", false, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.raw, func(t *testing.T) {
+			t.Parallel()
+
+			parsed, err := hqgourl.NewParser().Parse(tt.raw)
+
+			require.NoError(t, err)
+
+			assert.Equal(t, tt.onion, parsed.IsOnion())
+			assert.Equal(t, tt.i2p, parsed.IsI2P())
+		})
+	}
+}
@@ -0,0 +1,64 @@
+package url
+
+import (
+	"net/url"
+	"strings"
+)
+
+// WellKnownKind identifies a well-known resource of a site. Its value is the path of
+// the resource.
+type WellKnownKind string
+
+const (
+	// WellKnownRobots identifies the robots exclusion file ("/robots.txt").
+	WellKnownRobots WellKnownKind = "/robots.txt"
+	// WellKnownSitemap identifies the default sitemap ("/sitemap.xml").
+	WellKnownSitemap WellKnownKind = "/sitemap.xml"
+	// WellKnownFavicon identifies the default favicon ("/favicon.ico").
+	WellKnownFavicon WellKnownKind = "/favicon.ico"
+	// WellKnownSecurityTxt identifies the security contact file (RFC 9116).
+	WellKnownSecurityTxt WellKnownKind = "/.well-known/security.txt"
+	// WellKnownOpenIDConfiguration identifies the OpenID Connect discovery document.
+	WellKnownOpenIDConfiguration WellKnownKind = "/.well-known/openid-configuration"
+	// WellKnownChangePassword identifies the change password redirect.
+	WellKnownChangePassword WellKnownKind = "/.well-known/change-password"
+	// WellKnownAppleAppSiteAssociation identifies the Apple app site association file.
+	WellKnownAppleAppSiteAssociation WellKnownKind = "/.well-known/apple-app-site-association"
+	// WellKnownAssetLinks identifies the Android digital asset links file.
+	WellKnownAssetLinks WellKnownKind = "/.well-known/assetlinks.json"
+)
+
+// WellKnown returns the URL of a well-known resource on the same origin (scheme, userinfo,
+// host and port) as u. Kinds that are not one of the WellKnown constants and don't start
+// with "/" are treated as names registered under "/.well-known/" (RFC 8615), e.g.
+// WellKnown("mta-sts.txt") returns "https://example.com/.well-known/mta-sts.txt".
+//
+// Example:
+//
+//	parsed, _ := hqgourl.NewParser().Parse("https://example.com/some/page?q=1")
+//	fmt.Println(parsed.WellKnown(hqgourl.WellKnownRobots)) // https://example.com/robots.txt
+//
+// Parameters:
+//   - kind (WellKnownKind): The well-known resource.
+//
+// Returns:
+//   - resource (*URL): The URL of the resource, with the Domain of u.
+func (u *URL) WellKnown(kind WellKnownKind) (resource *URL) {
+	path := string(kind)
+
+	if !strings.HasPrefix(path, "/") {
+		path = "/.well-known/" + path
+	}
+
+	resource = &URL{
+		URL: &url.URL{
+			Scheme: u.Scheme,
+			User:   u.User,
+			Host:   u.Host,
+			Path:   path,
+		},
+		Domain: u.Domain,
+	}
+
+	return
+}
@@ -0,0 +1,39 @@
+package url_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	hqgourl "go.source.hueristiq.com/url"
+)
+
+func TestURL_WellKnown(t *testing.T) {
+	t.Parallel()
+
+	parsed, err := hqgourl.NewParser().Parse("https://www.example.com:8443/some/page?q=1#top")
+
+	require.NoError(t, err)
+
+	tests := []struct {
+		kind     hqgourl.WellKnownKind
+		expected string
+	}{
+		{hqgourl.WellKnownRobots, "https://www.example.com:8443/robots.txt"},
+		{hqgourl.WellKnownSitemap, "https://www.example.com:8443/sitemap.xml"},
+		{hqgourl.WellKnownFavicon, "https://www.example.com:8443/favicon.ico"},
+		{hqgourl.WellKnownSecurityTxt, "https://www.example.com:8443/.well-known/security.txt"},
+		{"mta-sts.txt", "https://www.example.com:8443/.well-known/mta-sts.txt"},
+	}
+
+	for _, tt := range tests {
+		t.Run(string(tt.kind), func(t *testing.T) {
+			t.Parallel()
+
+			URL := parsed.WellKnown(tt.kind)
+
+			assert.Equal(t, tt.expected, URL.String())
+			assert.Equal(t, parsed.Domain, URL.Domain)
+		})
+	}
+}
@@ -0,0 +1,15 @@
+// Package urlcmp provides functions for comparing URLs with configurable equivalence
+// semantics. Two URLs that differ only in ways a caller considers insignificant (e.g.,
+// the scheme, a leading "www.", a trailing slash, the order of query parameters or the
+// fragment) can be treated as equal, and the components that do differ are reported
+// in a structured diff.
+//
+// Example:
+//
+//	equal, diff, err := urlcmp.Equal(
+//	    "https://www.example.com/path/?b=2&a=1",
+//	    "http://example.com/path?a=1&b=2#top",
+//	    urlcmp.IgnoreScheme(), urlcmp.IgnoreWWW(), urlcmp.IgnoreTrailingSlash(),
+//	    urlcmp.IgnoreQueryOrder(), urlcmp.IgnoreFragment(),
+//	)
+package urlcmp
@@ -0,0 +1,207 @@
+package urlcmp
+
+import (
+	"fmt"
+	"net/url"
+	"regexp"
+	"slices"
+	"strings"
+
+	hqgourl "go.source.hueristiq.com/url"
+)
+
+// EndpointGroup is a group of URLs sharing the same endpoint key.
+type EndpointGroup struct {
+	Key  string
+	URLs []string
+}
+
+// EndpointChange is an endpoint present in both lists whose set of concrete URLs changed.
+type EndpointChange struct {
+	Key     string
+	Added   []string // URLs only present in the new list.
+	Removed []string // URLs only present in the old list.
+}
+
+// SetDiff is the result of DiffSets. All groups are sorted by key, and the URLs within
+// each group are sorted and deduplicated.
+type SetDiff struct {
+	Added   []EndpointGroup  // Endpoints only present in the new list.
+	Removed []EndpointGroup  // Endpoints only present in the old list.
+	Changed []EndpointChange // Endpoints present in both lists, with different concrete URLs.
+}
+
+// DiffSets groups the URLs of two lists by endpoint key (see EndpointKey) and reports
+// which endpoints were added, removed or changed between the old and the new list. It
+// is meant for continuous monitoring workflows, where new endpoints are interesting but
+// new values for an already known endpoint (e.g., "/users/2" after "/users/1") are not.
+//
+// Parameters:
+//   - oldURLs ([]string): The previous list of URLs.
+//   - newURLs ([]string): The current list of URLs.
+//   - keyOpts (variadic OptionFunc): Options relaxing how endpoint keys are built.
+//
+// Returns:
+//   - diff (*SetDiff): The added, removed and changed endpoints.
+//   - err (error): hqgourl.ErrInvalidURL (wrapped) if a URL cannot be parsed.
+func DiffSets(oldURLs, newURLs []string, keyOpts ...OptionFunc) (diff *SetDiff, err error) {
+	oldGroups, err := groupByEndpoint(oldURLs, keyOpts...)
+	if err != nil {
+		return
+	}
+
+	newGroups, err := groupByEndpoint(newURLs, keyOpts...)
+	if err != nil {
+		return
+	}
+
+	diff = &SetDiff{}
+
+	for _, key := range sortedKeys(newGroups) {
+		previous, ok := oldGroups[key]
+		if !ok {
+			diff.Added = append(diff.Added, EndpointGroup{Key: key, URLs: newGroups[key]})
+
+			continue
+		}
+
+		added, removed := difference(newGroups[key], previous), difference(previous, newGroups[key])
+
+		if len(added) > 0 || len(removed) > 0 {
+			diff.Changed = append(diff.Changed, EndpointChange{Key: key, Added: added, Removed: removed})
+		}
+	}
+
+	for _, key := range sortedKeys(oldGroups) {
+		if _, ok := newGroups[key]; !ok {
+			diff.Removed = append(diff.Removed, EndpointGroup{Key: key, URLs: oldGroups[key]})
+		}
+	}
+
+	return
+}
+
+// EndpointKey returns the normalized endpoint template of a URL: its scheme, lowercased
+// host and port, its path with identifier-like segments (numbers, UUIDs and hex hashes)
+// replaced by "{id}", and its sorted, deduplicated query parameter names. The values of
+// query parameters and the fragment are dropped.
+//
+// For example, "https://Example.com/users/42/posts?page=2&sort=asc#top" has the key
+// "https://example.com/users/{id}/posts?page&sort".
+//
+// Parameters:
+//   - raw (string): The URL.
+//   - opts (variadic OptionFunc): Options relaxing how the key is built (IgnoreScheme,
+//     IgnoreWWW and IgnoreTrailingSlash apply).
+//
+// Returns:
+//   - key (string): The endpoint key.
+//   - err (error): hqgourl.ErrInvalidURL (wrapped) if the URL cannot be parsed.
+func EndpointKey(raw string, opts ...OptionFunc) (key string, err error) {
+	options := &Options{}
+
+	for _, opt := range opts {
+		opt(options)
+	}
+
+	parsed, err := url.Parse(raw)
+	if err != nil {
+		err = fmt.Errorf("%w: %w", hqgourl.ErrInvalidURL, err)
+
+		return
+	}
+
+	var b strings.Builder
+
+	if !options.IgnoreScheme && parsed.Scheme != "" {
+		b.WriteString(strings.ToLower(parsed.Scheme))
+		b.WriteByte(':')
+	}
+
+	if parsed.Host != "" {
+		host := strings.ToLower(parsed.Host)
+
+		if options.IgnoreWWW {
+			host = strings.TrimPrefix(host, "www.")
+		}
+
+		b.WriteString("//")
+		b.WriteString(host)
+	}
+
+	segments := strings.Split(parsed.EscapedPath(), "/")
+
+	for i, segment := range segments {
+		if identifierSegmentRegex.MatchString(segment) {
+			segments[i] = "{id}"
+		}
+	}
+
+	path := strings.Join(segments, "/")
+
+	if options.IgnoreTrailingSlash {
+		path = strings.TrimSuffix(path, "/")
+	}
+
+	b.WriteString(path)
+
+	if names := sortedKeys(parsed.Query()); len(names) > 0 {
+		b.WriteByte('?')
+		b.WriteString(strings.Join(names, "&"))
+	}
+
+	key = b.String()
+
+	return
+}
+
+// groupByEndpoint groups URLs by endpoint key, sorting and deduplicating each group.
+func groupByEndpoint(URLs []string, opts ...OptionFunc) (groups map[string][]string, err error) {
+	groups = map[string][]string{}
+
+	for _, URL := range URLs {
+		var key string
+
+		if key, err = EndpointKey(URL, opts...); err != nil {
+			return
+		}
+
+		groups[key] = append(groups[key], URL)
+	}
+
+	for key, group := range groups {
+		slices.Sort(group)
+
+		groups[key] = slices.Compact(group)
+	}
+
+	return
+}
+
+// difference returns the elements of the sorted slice a which are not in the sorted slice b.
+func difference(a, b []string) (diff []string) {
+	for _, element := range a {
+		if _, found := slices.BinarySearch(b, element); !found {
+			diff = append(diff, element)
+		}
+	}
+
+	return
+}
+
+// sortedKeys returns the keys of m, sorted.
+func sortedKeys[V any](m map[string]V) (keys []string) {
+	keys = make([]string, 0, len(m))
+
+	for key := range m {
+		keys = append(keys, key)
+	}
+
+	slices.Sort(keys)
+
+	return
+}
+
+// identifierSegmentRegex matches path segments that look like identifiers: numbers,
+// UUIDs and hexadecimal hashes.
+var identifierSegmentRegex = regexp.MustCompile(`^(?:[0-9]+|[0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12}|[0-9a-fA-F]{16,})$`)
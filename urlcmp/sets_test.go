@@ -0,0 +1,100 @@
+package urlcmp_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	hqgourl "go.source.hueristiq.com/url"
+	"go.source.hueristiq.com/url/urlcmp"
+)
+
+func TestEndpointKey(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		raw      string
+		opts     []urlcmp.OptionFunc
+		expected string
+	}{
+		{
+			name:     "Identifiers, query names and fragment",
+			raw:      "https://Example.com/users/42/posts?sort=asc&page=2&page=3#top",
+			expected: "https://example.com/users/{id}/posts?page&sort",
+		},
+		{
+			name:     "UUID and hash segments",
+			raw:      "https://example.com/files/123e4567-e89b-12d3-a456-426614174000/d41d8cd98f00b204e9800998ecf8427e",
+			expected: "https://example.com/files/{id}/{id}",
+		},
+		{
+			name:     "Words are kept",
+			raw:      "https://example.com/api/v2/users",
+			expected: "https://example.com/api/v2/users",
+		},
+		{
+			name:     "Relaxed",
+			raw:      "http://www.example.com:8080/users/",
+			opts:     []urlcmp.OptionFunc{urlcmp.IgnoreScheme(), urlcmp.IgnoreWWW(), urlcmp.IgnoreTrailingSlash()},
+			expected: "//example.com:8080/users",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			key, err := urlcmp.EndpointKey(tt.raw, tt.opts...)
+
+			require.NoError(t, err)
+
+			assert.Equal(t, tt.expected, key)
+		})
+	}
+}
+
+func TestDiffSets(t *testing.T) {
+	t.Parallel()
+
+	oldURLs := []string{
+		"https://example.com/users/1",
+		"https://example.com/users/2",
+		"https://example.com/search?q=a",
+		"https://example.com/legacy",
+	}
+
+	newURLs := []string{
+		"https://example.com/users/2",
+		"https://example.com/users/3",
+		"https://example.com/search?q=a",
+		"https://example.com/search?q=a&page=2",
+		"https://example.com/search?q=a",
+	}
+
+	diff, err := urlcmp.DiffSets(oldURLs, newURLs)
+
+	require.NoError(t, err)
+
+	assert.Equal(t, []urlcmp.EndpointGroup{
+		{Key: "https://example.com/search?page&q", URLs: []string{"https://example.com/search?q=a&page=2"}},
+	}, diff.Added)
+	assert.Equal(t, []urlcmp.EndpointGroup{
+		{Key: "https://example.com/legacy", URLs: []string{"https://example.com/legacy"}},
+	}, diff.Removed)
+	assert.Equal(t, []urlcmp.EndpointChange{
+		{
+			Key:     "https://example.com/users/{id}",
+			Added:   []string{"https://example.com/users/3"},
+			Removed: []string{"https://example.com/users/1"},
+		},
+	}, diff.Changed)
+}
+
+func TestDiffSets_InvalidURL(t *testing.T) {
+	t.Parallel()
+
+	_, err := urlcmp.DiffSets(nil, []string{"http://[::1"})
+
+	require.ErrorIs(t, err, hqgourl.ErrInvalidURL)
+}
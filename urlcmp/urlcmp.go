@@ -0,0 +1,240 @@
+package urlcmp
+
+import (
+	"fmt"
+	"net/url"
+	"slices"
+	"strings"
+
+	hqgourl "go.source.hueristiq.com/url"
+)
+
+// Component identifies a component of a URL.
+type Component string
+
+// The components of a URL, in URL order.
+const (
+	ComponentScheme   Component = "scheme"
+	ComponentUserinfo Component = "userinfo"
+	ComponentHost     Component = "host"
+	ComponentPort     Component = "port"
+	ComponentPath     Component = "path"
+	ComponentQuery    Component = "query"
+	ComponentFragment Component = "fragment"
+)
+
+// Difference describes a component that differs between two URLs, with the normalized
+// values that were compared. Passwords in userinfo values are redacted (e.g.,
+// "user:xxxxx"), as net/url.URL.Redacted does, unless Equal is given KeepPasswords: URLs
+// differing only by their passwords have a userinfo Difference with equal values.
+type Difference struct {
+	Component Component
+	A         string
+	B         string
+}
+
+// Diff lists the components that differ between two URLs, in URL order
+// (scheme, userinfo, host, port, path, query, fragment).
+type Diff struct {
+	Differences []Difference
+}
+
+// Components returns the components that differ.
+func (d *Diff) Components() (components []Component) {
+	for _, difference := range d.Differences {
+		components = append(components, difference.Component)
+	}
+
+	return
+}
+
+// Options holds the equivalence semantics used by Equal.
+type Options struct {
+	IgnoreScheme        bool // Ignore the scheme.
+	IgnoreWWW           bool // Ignore a leading "www." label on hosts.
+	IgnoreTrailingSlash bool // Ignore a trailing slash on paths ("" and "/" are equal).
+	IgnoreQueryOrder    bool // Ignore the order of query parameters (and of repeated values).
+	IgnoreFragment      bool // Ignore the fragment.
+	KeepPasswords       bool // Report passwords in userinfo differences, instead of redacting them.
+}
+
+// OptionFunc defines a function type for configuring the Options used by Equal.
+type OptionFunc func(*Options)
+
+// Equal parses a and b and compares them component by component. Scheme and host are
+// always compared case-insensitively; the other components are compared as written,
+// unless relaxed by the given options.
+//
+// Parameters:
+//   - a (string): The first URL.
+//   - b (string): The second URL.
+//   - opts (variadic OptionFunc): Options relaxing the comparison.
+//
+// Returns:
+//   - equal (bool): True if no component differs.
+//   - diff (*Diff): The components that differ.
+//   - err (error): hqgourl.ErrInvalidURL (wrapped) if either URL cannot be parsed.
+func Equal(a, b string, opts ...OptionFunc) (equal bool, diff *Diff, err error) {
+	options := &Options{}
+
+	for _, opt := range opts {
+		opt(options)
+	}
+
+	parsedA, err := url.Parse(a)
+	if err != nil {
+		err = fmt.Errorf("%w: %w", hqgourl.ErrInvalidURL, err)
+
+		return
+	}
+
+	parsedB, err := url.Parse(b)
+	if err != nil {
+		err = fmt.Errorf("%w: %w", hqgourl.ErrInvalidURL, err)
+
+		return
+	}
+
+	diff = &Diff{}
+
+	componentsA := components(parsedA, options)
+	componentsB := components(parsedB, options)
+
+	for i := range componentsA {
+		if componentsA[i].value != componentsB[i].value {
+			difference := Difference{
+				Component: componentsA[i].component,
+				A:         componentsA[i].value,
+				B:         componentsB[i].value,
+			}
+
+			if difference.Component == ComponentUserinfo && !options.KeepPasswords {
+				difference.A = redactedUserinfo(parsedA.User)
+				difference.B = redactedUserinfo(parsedB.User)
+			}
+
+			diff.Differences = append(diff.Differences, difference)
+		}
+	}
+
+	equal = len(diff.Differences) == 0
+
+	return
+}
+
+// componentValue is the normalized value of a URL component.
+type componentValue struct {
+	component Component
+	value     string
+}
+
+// components returns the normalized components of parsed, in URL order.
+func components(parsed *url.URL, options *Options) (normalized []componentValue) {
+	scheme := strings.ToLower(parsed.Scheme)
+
+	if options.IgnoreScheme {
+		scheme = ""
+	}
+
+	host := strings.ToLower(parsed.Hostname())
+
+	if options.IgnoreWWW {
+		host = strings.TrimPrefix(host, "www.")
+	}
+
+	path := parsed.EscapedPath()
+
+	if options.IgnoreTrailingSlash {
+		path = strings.TrimSuffix(path, "/")
+	}
+
+	query := parsed.RawQuery
+
+	if options.IgnoreQueryOrder {
+		query = sortedQuery(parsed.Query())
+	}
+
+	fragment := parsed.EscapedFragment()
+
+	if options.IgnoreFragment {
+		fragment = ""
+	}
+
+	normalized = []componentValue{
+		{ComponentScheme, scheme},
+		{ComponentUserinfo, parsed.User.String()},
+		{ComponentHost, host},
+		{ComponentPort, parsed.Port()},
+		{ComponentPath, path},
+		{ComponentQuery, query},
+		{ComponentFragment, fragment},
+	}
+
+	return
+}
+
+// redactedUserinfo returns the userinfo of a URL with its password, if any, replaced with
+// "xxxxx", as in net/url.URL.Redacted.
+func redactedUserinfo(user *url.Userinfo) (userinfo string) {
+	if _, hasPassword := user.Password(); hasPassword {
+		user = url.UserPassword(user.Username(), "xxxxx")
+	}
+
+	userinfo = user.String()
+
+	return
+}
+
+// sortedQuery encodes query with keys and repeated values sorted.
+func sortedQuery(query url.Values) (encoded string) {
+	for key := range query {
+		slices.Sort(query[key])
+	}
+
+	encoded = query.Encode()
+
+	return
+}
+
+// IgnoreScheme returns an option function that ignores the scheme.
+func IgnoreScheme() OptionFunc {
+	return func(o *Options) {
+		o.IgnoreScheme = true
+	}
+}
+
+// IgnoreWWW returns an option function that ignores a leading "www." label on hosts.
+func IgnoreWWW() OptionFunc {
+	return func(o *Options) {
+		o.IgnoreWWW = true
+	}
+}
+
+// IgnoreTrailingSlash returns an option function that ignores a trailing slash on paths.
+func IgnoreTrailingSlash() OptionFunc {
+	return func(o *Options) {
+		o.IgnoreTrailingSlash = true
+	}
+}
+
+// IgnoreQueryOrder returns an option function that ignores the order of query parameters.
+func IgnoreQueryOrder() OptionFunc {
+	return func(o *Options) {
+		o.IgnoreQueryOrder = true
+	}
+}
+
+// IgnoreFragment returns an option function that ignores the fragment.
+func IgnoreFragment() OptionFunc {
+	return func(o *Options) {
+		o.IgnoreFragment = true
+	}
+}
+
+// KeepPasswords returns an option function that reports passwords in userinfo
+// differences, instead of redacting them.
+func KeepPasswords() OptionFunc {
+	return func(o *Options) {
+		o.KeepPasswords = true
+	}
+}
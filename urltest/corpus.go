@@ -0,0 +1,139 @@
+package urltest
+
+import (
+	_ "embed"
+	"encoding/json"
+	"iter"
+	"testing"
+
+	hqgourl "go.source.hueristiq.com/url"
+)
+
+// Config identifies the Extractor configuration a corpus case is extracted with.
+type Config string
+
+const (
+	// ConfigDefault is the configuration of NewExtractor without options: URLs, hosts,
+	// emails and relative URLs are matched.
+	ConfigDefault Config = "default"
+	// ConfigScheme requires a scheme (ExtractorWithScheme).
+	ConfigScheme Config = "scheme"
+	// ConfigKnownTLDs requires a scheme and, for URLs with a host, a known TLD
+	// (ExtractorWithScheme and ExtractorWithKnownTLDOnly).
+	ConfigKnownTLDs Config = "known-tlds"
+	// ConfigHost requires a host (ExtractorWithHost).
+	ConfigHost Config = "host"
+)
+
+// Options returns the Extractor options of the configuration.
+//
+// Returns:
+//   - opts ([]hqgourl.ExtractorOptionFunc): The options.
+func (c Config) Options() (opts []hqgourl.ExtractorOptionFunc) {
+	switch c {
+	case ConfigScheme:
+		opts = []hqgourl.ExtractorOptionFunc{hqgourl.ExtractorWithScheme()}
+	case ConfigKnownTLDs:
+		opts = []hqgourl.ExtractorOptionFunc{hqgourl.ExtractorWithScheme(), hqgourl.ExtractorWithKnownTLDOnly()}
+	case ConfigHost:
+		opts = []hqgourl.ExtractorOptionFunc{hqgourl.ExtractorWithHost()}
+	case ConfigDefault:
+	}
+
+	return
+}
+
+// CorpusCase is a case of the golden corpus: an input and the matches expected from it
+// with a configuration.
+type CorpusCase struct {
+	Name    string        `json:"name"`    // A short description of the case.
+	Config  Config        `json:"config"`  // The configuration to extract with.
+	Input   string        `json:"input"`   // The text to extract from.
+	Matches []CorpusMatch `json:"matches"` // The expected matches, in order.
+}
+
+// CorpusMatch is a match expected from the input of a CorpusCase.
+type CorpusMatch struct {
+	Value string            `json:"value"` // The matched value.
+	Type  hqgourl.MatchType `json:"type"`  // The match type.
+	Start int               `json:"start"` // The byte offset of the start of the match in the input.
+	End   int               `json:"end"`   // The byte offset of the end of the match in the input.
+}
+
+// corpus is the golden corpus, in JSON.
+//
+//go:embed corpus.json
+var corpus []byte
+
+// Corpus returns an iterator over the cases of the golden corpus: inputs, with the matches
+// Extractors must find in them. The expected matches are those of RegexEngine, the
+// reference behavior, which ScannerEngine reproduces on the corpus.
+//
+// Returns:
+//   - cases (iter.Seq[CorpusCase]): The cases, in the order of the corpus.
+func Corpus() (cases iter.Seq[CorpusCase]) {
+	var decoded []CorpusCase
+
+	// The corpus is embedded and checked by the tests of the package.
+	if err := json.Unmarshal(corpus, &decoded); err != nil {
+		panic("urltest: invalid embedded corpus: " + err.Error())
+	}
+
+	cases = func(yield func(CorpusCase) bool) {
+		for _, c := range decoded {
+			if !yield(c) {
+				return
+			}
+		}
+	}
+
+	return
+}
+
+// RunConformance checks an extraction engine against the golden corpus, running a subtest
+// per case that compares the matches found (their values, types and offsets) with those
+// expected. newExtractor creates the extractor of each configuration, from its options;
+// it is called once per configuration.
+//
+// Example:
+//
+//	urltest.RunConformance(t, func(opts ...hqgourl.ExtractorOptionFunc) hqgourl.MatchExtractor {
+//	    return hqgourl.NewExtractor(append(opts, hqgourl.ExtractorWithEngine(hqgourl.ScannerEngine))...)
+//	})
+//
+// Parameters:
+//   - t (*testing.T): The test.
+//   - newExtractor (func(opts ...hqgourl.ExtractorOptionFunc) hqgourl.MatchExtractor): The
+//     constructor of the extractors under test.
+func RunConformance(t *testing.T, newExtractor func(opts ...hqgourl.ExtractorOptionFunc) hqgourl.MatchExtractor) {
+	t.Helper()
+
+	extractors := map[Config]hqgourl.MatchExtractor{}
+
+	for c := range Corpus() {
+		extractor, ok := extractors[c.Config]
+		if !ok {
+			extractor = newExtractor(c.Config.Options()...)
+
+			extractors[c.Config] = extractor
+		}
+
+		t.Run(c.Name, func(t *testing.T) {
+			matches := []CorpusMatch{}
+
+			for _, match := range extractor.Extract(c.Input) {
+				matches = append(matches, CorpusMatch{Value: match.Value, Type: match.Type, Start: match.Start, End: match.End})
+			}
+
+			if len(matches) != len(c.Matches) {
+				t.Fatalf("%q (%s): got %d matches %+v, want %d %+v", c.Input, c.Config, len(matches), matches, len(c.Matches), c.Matches)
+			}
+
+			for i := range matches {
+				if matches[i] != c.Matches[i] {
+					t.Errorf("%q (%s): match %d: got %+v, want %+v", c.Input, c.Config, i, matches[i], c.Matches[i])
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,23 @@
+// Package urltest generates URLs for property-based tests: valid URLs (with domain, IPv4,
+// IPv6 and internationalized hosts), invalid URLs (valid URLs with one corruption that
+// parsers must reject), internationalized hosts and IPv6 literals, along with a curated
+// list of tricky edge cases. It is the corpus this module validates its own parsers and
+// extractors against, exported so that downstream projects can property-test their own
+// handling of URLs with it.
+//
+// Generators are seeded, so that failures can be reproduced from the seed. The package
+// also embeds the golden corpus of extraction (inputs and the matches expected from them),
+// against which RunConformance checks alternative engines and forks for behavioral parity.
+//
+// Example:
+//
+//	g := urltest.NewGenerator(seed)
+//
+//	for range 1000 {
+//	    raw := g.ValidURL()
+//
+//	    if _, err := parser.Parse(raw); err != nil {
+//	        t.Errorf("seed %d: failed to parse %q: %v", seed, raw, err)
+//	    }
+//	}
+package urltest
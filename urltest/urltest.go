@@ -0,0 +1,332 @@
+package urltest
+
+import (
+	"math/rand/v2"
+	"net/netip"
+	"strconv"
+	"strings"
+)
+
+// Generator generates random URLs and hosts from a seeded source. A Generator is not safe
+// for concurrent use; each goroutine should use its own.
+type Generator struct {
+	rand *rand.Rand
+}
+
+// NewGenerator creates a Generator seeded with seed. Generators created with the same seed
+// generate the same values, in the same order.
+//
+// Parameters:
+//   - seed (uint64): The seed.
+//
+// Returns:
+//   - generator (*Generator): The generator.
+func NewGenerator(seed uint64) (generator *Generator) {
+	generator = &Generator{
+		rand: rand.New(rand.NewPCG(seed, seed)), //nolint:gosec // Test data, not secrets.
+	}
+
+	return
+}
+
+var (
+	// schemes are the schemes of generated URLs.
+	schemes = []string{"http", "https", "ftp", "ws", "wss"}
+	// suffixes are the public suffixes of generated domains.
+	suffixes = []string{"com", "org", "net", "io", "dev", "de", "jp", "co.uk", "com.br"}
+	// idnSuffixes are the public suffixes of generated internationalized domains.
+	idnSuffixes = []string{"com", "de", "jp", "рф", "中国", "ελ"}
+	// scripts are the ranges of lowercase letters internationalized labels are made of,
+	// one script per label. Characters that IDNA maps or disallows (e.g., "÷" or the final
+	// sigma) are left out.
+	scripts = [][]rune{
+		[]rune("àáâãäåæçèéêëìíîïðñòóôõöøùúûüýþÿ"),
+		[]rune("абвгдежзийклмнопрстуфхцчшщъыьэюя"),
+		[]rune("αβγδεζηθικλμνξοπρστυφχψω"),
+		[]rune("あいうえおかきくけこさしすせそたちつてとなにぬねの"),
+		[]rune("例子测试网络中文域名"),
+	}
+)
+
+const (
+	// alphanumerics are the characters labels, path segments and query parameters start
+	// and end with.
+	alphanumerics = "abcdefghijklmnopqrstuvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZ0123456789"
+	// unreserved are the characters, besides alphanumerics, used inside path segments and
+	// query parameters (RFC 3986, section 2.3).
+	unreserved = "-._~"
+)
+
+// ValidURL generates a valid absolute URL: a scheme, optional userinfo, a host (a domain,
+// an internationalized domain, an IPv4 address or a bracketed IPv6 literal), an optional
+// port, path, query and fragment. Its path, query and fragment are made of unreserved
+// characters and percent-encoded bytes, and end with an alphanumeric character, so that
+// the URL is also extracted whole from surrounding text.
+//
+// Returns:
+//   - URL (string): The URL.
+func (g *Generator) ValidURL() (URL string) {
+	var b strings.Builder
+
+	b.WriteString(pick(g, schemes))
+	b.WriteString("://")
+
+	if g.rand.IntN(8) == 0 {
+		b.WriteString(g.word(alphanumerics, 8))
+
+		if g.rand.IntN(2) == 0 {
+			b.WriteString(":" + g.word(alphanumerics, 8))
+		}
+
+		b.WriteString("@")
+	}
+
+	switch g.rand.IntN(8) {
+	case 0:
+		b.WriteString(g.IPv4())
+	case 1:
+		b.WriteString("[" + g.IPv6() + "]")
+	case 2:
+		b.WriteString(g.IDNHost())
+	default:
+		b.WriteString(g.Domain())
+	}
+
+	if g.rand.IntN(4) == 0 {
+		b.WriteString(":" + strconv.Itoa(1+g.rand.IntN(65535)))
+	}
+
+	for range g.rand.IntN(4) {
+		b.WriteString("/" + g.segment())
+	}
+
+	if g.rand.IntN(3) == 0 {
+		b.WriteString("?")
+
+		for i := range 1 + g.rand.IntN(3) {
+			if i > 0 {
+				b.WriteString("&")
+			}
+
+			b.WriteString(g.word(alphanumerics, 6) + "=" + g.segment())
+		}
+	}
+
+	if g.rand.IntN(6) == 0 {
+		b.WriteString("#" + g.word(alphanumerics, 8))
+	}
+
+	URL = b.String()
+
+	return
+}
+
+// corruptions turn a valid URL into one that net/url (and parsers built on it) reject.
+var corruptions = []func(g *Generator, URL string) string{
+	// An invalid percent-encoding, in the path (net/url doesn't validate queries).
+	func(_ *Generator, URL string) string {
+		scheme, rest, _ := strings.Cut(URL, "://")
+
+		if i := strings.IndexAny(rest, "/?#"); i >= 0 {
+			return scheme + "://" + rest[:i] + "/%zz" + rest[i:]
+		}
+
+		return URL + "/%zz"
+	},
+	// A control character, before the fragment (net/url doesn't validate fragments).
+	func(g *Generator, URL string) string {
+		end := len(URL)
+
+		if i := strings.IndexByte(URL, '#'); i >= 0 {
+			end = i
+		}
+
+		i := g.rand.IntN(end + 1)
+
+		return URL[:i] + string(rune(g.rand.IntN(0x20))) + URL[i:]
+	},
+	// A space in the host.
+	func(_ *Generator, URL string) string {
+		scheme, rest, _ := strings.Cut(URL, "://")
+
+		return scheme + "://exa mple." + rest
+	},
+	// An unterminated IPv6 literal.
+	func(g *Generator, URL string) string {
+		scheme, _, _ := strings.Cut(URL, "://")
+
+		return scheme + "://[" + g.IPv6() + "/"
+	},
+	// A port that is not a number.
+	func(g *Generator, URL string) string {
+		scheme, _, _ := strings.Cut(URL, "://")
+
+		return scheme + "://" + g.Domain() + ":" + g.word("abcdefghijklmnopqrstuvwxyz", 4) + "/"
+	},
+	// A missing scheme before "://".
+	func(_ *Generator, URL string) string {
+		_, rest, _ := strings.Cut(URL, "://")
+
+		return "://" + rest
+	},
+}
+
+// InvalidURL generates an invalid URL: a valid URL with one corruption (e.g., an invalid
+// percent-encoding, a control character, a space in the host, an unterminated IPv6 literal,
+// a port that is not a number or a missing scheme) that parsers must reject.
+//
+// Returns:
+//   - URL (string): The URL.
+func (g *Generator) InvalidURL() (URL string) {
+	URL = pick(g, corruptions)(g, g.ValidURL())
+
+	return
+}
+
+// Domain generates a lowercase domain: up to two subdomain labels, a second-level domain
+// label and a public suffix (e.g., "api.example.co.uk").
+//
+// Returns:
+//   - domain (string): The domain.
+func (g *Generator) Domain() (domain string) {
+	labels := make([]string, 0, 4)
+
+	for range 1 + g.rand.IntN(3) {
+		labels = append(labels, g.label())
+	}
+
+	domain = strings.Join(append(labels, pick(g, suffixes)), ".")
+
+	return
+}
+
+// IDNHost generates an internationalized domain, in Unicode: up to two labels, each made
+// of the lowercase letters of a single script (Latin with diacritics, Cyrillic, Greek,
+// Hiragana or Han), and a public suffix, possibly internationalized (e.g., "пример.рф").
+//
+// Returns:
+//   - host (string): The host.
+func (g *Generator) IDNHost() (host string) {
+	labels := make([]string, 0, 3)
+
+	for range 1 + g.rand.IntN(2) {
+		script := pick(g, scripts)
+
+		label := make([]rune, 1+g.rand.IntN(8))
+
+		for i := range label {
+			label[i] = pick(g, script)
+		}
+
+		labels = append(labels, string(label))
+	}
+
+	host = strings.Join(append(labels, pick(g, idnSuffixes)), ".")
+
+	return
+}
+
+// IPv4 generates an IPv4 address in dotted-decimal form.
+//
+// Returns:
+//   - address (string): The address.
+func (g *Generator) IPv4() (address string) {
+	var b [4]byte
+
+	for i := range b {
+		b[i] = byte(g.rand.IntN(256))
+	}
+
+	address = netip.AddrFrom4(b).String()
+
+	return
+}
+
+// IPv6 generates an IPv6 address, without brackets, in one of its textual forms: fully
+// expanded, compressed with "::" or with an embedded IPv4 address (e.g.,
+// "::ffff:192.0.2.1").
+//
+// Returns:
+//   - address (string): The address.
+func (g *Generator) IPv6() (address string) {
+	var b [16]byte
+
+	for i := range b {
+		b[i] = byte(g.rand.IntN(256))
+	}
+
+	switch g.rand.IntN(3) {
+	case 0:
+		address = netip.AddrFrom16(b).StringExpanded()
+	case 1:
+		// Zero a run of groups, which String compresses to "::".
+		start := g.rand.IntN(8)
+
+		for i := start * 2; i < min(16, (start+1+g.rand.IntN(4))*2); i++ {
+			b[i] = 0
+		}
+
+		address = netip.AddrFrom16(b).String()
+	default:
+		address = "::ffff:" + g.IPv4()
+	}
+
+	return
+}
+
+// label generates a domain label of up to 12 characters: lowercase letters, digits and
+// inner hyphens.
+func (g *Generator) label() (label string) {
+	const characters = "abcdefghijklmnopqrstuvwxyz0123456789"
+
+	label = g.word(characters, 12)
+
+	if len(label) > 2 && g.rand.IntN(4) == 0 {
+		i := 1 + g.rand.IntN(len(label)-2)
+
+		label = label[:i] + "-" + label[i+1:]
+	}
+
+	return
+}
+
+// segment generates a path segment or query value of up to 12 characters: alphanumerics,
+// with unreserved characters and percent-encoded bytes inside.
+func (g *Generator) segment() (segment string) {
+	var b strings.Builder
+
+	b.WriteString(g.word(alphanumerics, 4))
+
+	for range g.rand.IntN(3) {
+		switch g.rand.IntN(2) {
+		case 0:
+			b.WriteByte(unreserved[g.rand.IntN(len(unreserved))])
+		default:
+			b.WriteString("%" + strings.ToUpper(strconv.FormatInt(int64(0x10+g.rand.IntN(0xf0)), 16)))
+		}
+
+		b.WriteString(g.word(alphanumerics, 4))
+	}
+
+	segment = b.String()
+
+	return
+}
+
+// word generates a string of 1 to n characters picked from characters.
+func (g *Generator) word(characters string, n int) (word string) {
+	b := make([]byte, 1+g.rand.IntN(n))
+
+	for i := range b {
+		b[i] = characters[g.rand.IntN(len(characters))]
+	}
+
+	word = string(b)
+
+	return
+}
+
+// pick returns a random element of elements.
+func pick[T any](g *Generator, elements []T) T {
+	return elements[g.rand.IntN(len(elements))]
+}
@@ -0,0 +1,130 @@
+package urltest_test
+
+import (
+	"net/netip"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	hqgourl "go.source.hueristiq.com/url"
+	"go.source.hueristiq.com/url/urltest"
+	"golang.org/x/net/idna"
+)
+
+func TestGenerator_Deterministic(t *testing.T) {
+	t.Parallel()
+
+	a, b := urltest.NewGenerator(42), urltest.NewGenerator(42)
+
+	for range 100 {
+		assert.Equal(t, a.ValidURL(), b.ValidURL())
+	}
+}
+
+func TestGenerator_ValidURL(t *testing.T) {
+	t.Parallel()
+
+	g := urltest.NewGenerator(1)
+
+	parser := hqgourl.NewParser()
+	extractor := hqgourl.NewExtractor(hqgourl.ExtractorWithScheme())
+
+	for range 100 {
+		raw := g.ValidURL()
+
+		_, err := parser.Parse(raw)
+
+		require.NoError(t, err, raw)
+
+		matches := extractor.Extract("see " + raw + " now")
+
+		require.Len(t, matches, 1, raw)
+		assert.Equal(t, raw, matches[0].Value)
+	}
+}
+
+func TestGenerator_InvalidURL(t *testing.T) {
+	t.Parallel()
+
+	g := urltest.NewGenerator(2)
+
+	parser := hqgourl.NewParser()
+
+	for range 1000 {
+		raw := g.InvalidURL()
+
+		_, err := parser.Parse(raw)
+
+		require.ErrorIs(t, err, hqgourl.ErrInvalidURL, "%q", raw)
+	}
+}
+
+func TestGenerator_IDNHost(t *testing.T) {
+	t.Parallel()
+
+	g := urltest.NewGenerator(3)
+
+	for range 1000 {
+		host := g.IDNHost()
+
+		_, err := idna.Lookup.ToASCII(host)
+
+		require.NoError(t, err, host)
+	}
+}
+
+func TestGenerator_IPv6(t *testing.T) {
+	t.Parallel()
+
+	g := urltest.NewGenerator(4)
+
+	compressed := false
+
+	for range 1000 {
+		address := g.IPv6()
+
+		addr, err := netip.ParseAddr(address)
+
+		require.NoError(t, err, address)
+		assert.True(t, addr.Is6(), address)
+
+		compressed = compressed || strings.Contains(address, "::")
+	}
+
+	assert.True(t, compressed)
+}
+
+func TestEdgeCases(t *testing.T) {
+	t.Parallel()
+
+	parser := hqgourl.NewParser()
+
+	for _, tc := range urltest.EdgeCases() {
+		_, err := parser.Parse(tc.Input)
+
+		if tc.Valid {
+			assert.NoError(t, err, tc.Name)
+		} else {
+			assert.Error(t, err, tc.Name)
+		}
+	}
+}
+
+func TestCorpus(t *testing.T) {
+	t.Parallel()
+
+	count := 0
+
+	for c := range urltest.Corpus() {
+		count++
+
+		assert.Contains(t, []urltest.Config{urltest.ConfigDefault, urltest.ConfigScheme, urltest.ConfigKnownTLDs, urltest.ConfigHost}, c.Config, c.Name)
+
+		for _, match := range c.Matches {
+			assert.Equal(t, match.Value, c.Input[match.Start:match.End], c.Name)
+		}
+	}
+
+	assert.Positive(t, count)
+}
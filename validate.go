@@ -0,0 +1,32 @@
+package url
+
+import (
+	"fmt"
+
+	"go.source.hueristiq.com/url/schemes"
+)
+
+// ValidateScheme checks that scheme is a known URL scheme, i.e. one of the official,
+// unofficial (including registered) or no-authority schemes listed in the schemes package.
+// The comparison is case-insensitive.
+//
+// Parameters:
+//   - scheme (string): The scheme to validate (e.g., "https"), without "://" or ":".
+//
+// Returns:
+//   - err (error): ErrEmptyInput or ErrUnsupportedScheme (wrapped), or nil if the scheme is known.
+func ValidateScheme(scheme string) (err error) {
+	if scheme == "" {
+		err = fmt.Errorf("%w: scheme", ErrEmptyInput)
+
+		return
+	}
+
+	if schemes.IsOfficial(scheme) || schemes.IsUnofficial(scheme) || schemes.IsNoAuthority(scheme) {
+		return
+	}
+
+	err = fmt.Errorf("%w: %q", ErrUnsupportedScheme, scheme)
+
+	return
+}
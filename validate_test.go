@@ -0,0 +1,31 @@
+package url_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	hqgourl "go.source.hueristiq.com/url"
+	"go.source.hueristiq.com/url/schemes"
+)
+
+func TestValidateScheme(t *testing.T) {
+	t.Parallel()
+
+	for _, scheme := range []string{"https", "HTTP", "ftp", "mailto", "slack", "zoommtg"} {
+		require.NoErrorf(t, hqgourl.ValidateScheme(scheme), "failed on scheme: %s", scheme)
+	}
+
+	require.ErrorIs(t, hqgourl.ValidateScheme(""), hqgourl.ErrEmptyInput)
+	require.ErrorIs(t, hqgourl.ValidateScheme("notascheme"), hqgourl.ErrUnsupportedScheme)
+}
+
+func TestValidateScheme_RegisteredUnofficial(t *testing.T) {
+	t.Parallel()
+
+	require.ErrorIs(t, hqgourl.ValidateScheme("validateschemetestapp"), hqgourl.ErrUnsupportedScheme)
+
+	schemes.RegisterUnofficial("ValidateSchemeTestApp")
+
+	require.NoError(t, hqgourl.ValidateScheme("validateschemetestapp"))
+	require.Contains(t, schemes.UnofficialSchemes(), "validateschemetestapp")
+}